@@ -0,0 +1,215 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Default values of the AnomalyConfig fields.
+const (
+	DefaultAnomalyWindow     = 30
+	DefaultAnomalyMinSamples = 5
+	DefaultAnomalyThreshold  = 4
+)
+
+// AnomalyConfig configures the detection of anomalous changes by the
+// AnomalyMonitor. Zero values are replaced by defaults.
+type AnomalyConfig struct {
+	// Number of previous samples that form the baseline of velocities.
+	Window int
+	// Minimal number of previous samples before anomalies are detected.
+	MinSamples int
+	// Number of standard deviations from the baseline mean above which the
+	// velocity is anomalous.
+	Threshold float64
+}
+
+// Anomaly is an anomalous velocity of the number of ballots that prefer one
+// choice over another.
+type Anomaly[C comparable] struct {
+	Time time.Time `json:"time"`
+	From C         `json:"from"`
+	To   C         `json:"to"`
+	// Velocity in ballots per second since the previous sample.
+	Velocity float64 `json:"velocity"`
+	// Mean and the standard deviation of the baseline velocities.
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"stdDev"`
+	// Number of standard deviations of the velocity from the mean.
+	Score float64 `json:"score"`
+}
+
+// AnomalyMonitor tracks velocities of pairwise preferences of a Voting
+// between samples and detects statistically anomalous swings, such as a
+// sudden surge of ballots for one pair of choices, during live voting.
+type AnomalyMonitor[C comparable] struct {
+	voting   *Voting[C]
+	notifier Notifier
+	config   AnomalyConfig
+
+	mu          sync.Mutex
+	choices     []C
+	preferences []int
+	time        time.Time
+	// velocities of every ordered pair of choices in previous samples,
+	// oldest first
+	history [][]float64
+}
+
+// NewAnomalyMonitor creates a monitor of the voting that delivers detected
+// anomalies to the notifier, which can be nil.
+func NewAnomalyMonitor[C comparable](v *Voting[C], notifier Notifier, config AnomalyConfig) *AnomalyMonitor[C] {
+	if config.Window <= 0 {
+		config.Window = DefaultAnomalyWindow
+	}
+	if config.MinSamples <= 0 {
+		config.MinSamples = DefaultAnomalyMinSamples
+	}
+	if config.MinSamples > config.Window {
+		config.MinSamples = config.Window
+	}
+	if config.Threshold <= 0 {
+		config.Threshold = DefaultAnomalyThreshold
+	}
+	return &AnomalyMonitor[C]{
+		voting:   v,
+		notifier: notifier,
+		config:   config,
+	}
+}
+
+// Sample records the preferences of the voting at the time and returns
+// anomalies of pairwise velocities since the previous sample, compared to
+// velocities of previous samples. Deviations are measured at least in the
+// units of one ballot per the sampling period, so that small fluctuations of
+// a steady voting are not anomalous. The history is reset when the choices
+// change. The voting must not be changed concurrently with Sample, and
+// anomalies are not delivered to the notifier.
+func (m *AnomalyMonitor[C]) Sample(now time.Time) []Anomaly[C] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	choices := m.voting.choices
+	preferences := m.voting.preferences
+	defer func() {
+		m.choices = append(m.choices[:0], choices...)
+		m.preferences = append(m.preferences[:0], preferences...)
+		m.time = now
+	}()
+
+	if !m.sameChoices(choices) {
+		m.history = nil
+		return nil
+	}
+	elapsed := now.Sub(m.time).Seconds()
+	if elapsed <= 0 {
+		return nil
+	}
+
+	n := len(choices)
+	velocities := make([]float64, 0, n*(n-1))
+	var anomalies []Anomaly[C]
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j {
+				continue
+			}
+			k := i*n + j
+			velocity := float64(preferences[k]-m.preferences[k]) / elapsed
+			pair := len(velocities)
+			velocities = append(velocities, velocity)
+
+			if len(m.history) < m.config.MinSamples {
+				continue
+			}
+			var mean float64
+			for _, h := range m.history {
+				mean += h[pair]
+			}
+			mean /= float64(len(m.history))
+			var variance float64
+			for _, h := range m.history {
+				variance += (h[pair] - mean) * (h[pair] - mean)
+			}
+			stdDev := math.Sqrt(variance / float64(len(m.history)))
+			score := (velocity - mean) / math.Max(stdDev, 1/elapsed)
+			if score < m.config.Threshold {
+				continue
+			}
+			anomalies = append(anomalies, Anomaly[C]{
+				Time:     now,
+				From:     choices[i],
+				To:       choices[j],
+				Velocity: velocity,
+				Mean:     mean,
+				StdDev:   stdDev,
+				Score:    score,
+			})
+		}
+	}
+
+	m.history = append(m.history, velocities)
+	if len(m.history) > m.config.Window {
+		m.history = m.history[len(m.history)-m.config.Window:]
+	}
+	return anomalies
+}
+
+// Run samples the voting every interval until the context is done and
+// delivers detected anomalies to the notifier as events of the EventAnomaly
+// type. As methods on the Voting type are not safe for concurrent calls, the
+// monitor holds the lock while it samples, which must be the same lock that
+// guards all other calls to the voting. Events are delivered without holding
+// the lock. Run blocks and it is intended to be called in a separate
+// goroutine.
+func (m *AnomalyMonitor[C]) Run(ctx context.Context, interval time.Duration, lock sync.Locker) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lock.Lock()
+	m.Sample(time.Now())
+	lock.Unlock()
+
+	for {
+		var now time.Time
+		select {
+		case now = <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		lock.Lock()
+		anomalies := m.Sample(now)
+		lock.Unlock()
+		if m.notifier == nil {
+			continue
+		}
+		for _, a := range anomalies {
+			m.notifier.Notify(Event{
+				Type:    EventAnomaly,
+				Time:    a.Time,
+				Message: fmt.Sprintf("anomalous preferences of %v over %v: %.2f ballots per second, %.1f standard deviations above the mean of %.2f", a.From, a.To, a.Velocity, a.Score, a.Mean),
+				Data:    a,
+			})
+		}
+	}
+}
+
+func (m *AnomalyMonitor[C]) sameChoices(choices []C) bool {
+	if m.time.IsZero() || len(choices) != len(m.choices) {
+		return false
+	}
+	for i, c := range choices {
+		if m.choices[i] != c {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestAnomalyMonitor_Sample(t *testing.T) {
+	v := schulze.NewVoting([]string{"A", "B", "C"})
+	m := schulze.NewAnomalyMonitor(v, nil, schulze.AnomalyConfig{MinSamples: 3})
+
+	vote := func(b schulze.Ballot[string], count int) {
+		t.Helper()
+		for i := 0; i < count; i++ {
+			if _, err := v.Vote(b); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	if got := m.Sample(now); len(got) != 0 {
+		t.Fatalf("got anomalies %+v in the first sample", got)
+	}
+
+	// steady voting with small fluctuations
+	for _, count := range []int{10, 12, 9, 11, 10} {
+		vote(schulze.Ballot[string]{"A": 1, "B": 2}, count/2)
+		vote(schulze.Ballot[string]{"B": 1, "A": 2}, count-count/2)
+		now = now.Add(time.Second)
+		if got := m.Sample(now); len(got) != 0 {
+			t.Fatalf("got anomalies %+v in steady voting", got)
+		}
+	}
+
+	vote(schulze.Ballot[string]{"C": 1}, 50)
+	vote(schulze.Ballot[string]{"A": 1, "B": 2}, 5)
+	vote(schulze.Ballot[string]{"B": 1, "A": 2}, 5)
+	now = now.Add(time.Second)
+	got := m.Sample(now)
+	if len(got) != 2 {
+		t.Fatalf("got anomalies %+v, want surges of C over A and B", got)
+	}
+	for i, to := range []string{"A", "B"} {
+		a := got[i]
+		if a.From != "C" || a.To != to {
+			t.Errorf("got anomaly of %v over %v, want C over %v", a.From, a.To, to)
+		}
+		if a.Velocity != 50 {
+			t.Errorf("got velocity %v, want %v", a.Velocity, 50)
+		}
+		if a.Mean != 0 || a.Score != 50 {
+			t.Errorf("got mean %v and score %v, want %v and %v", a.Mean, a.Score, 0, 50)
+		}
+	}
+
+	// history is reset on choices change
+	v.SetChoices([]string{"A", "B", "C", "D"})
+	vote(schulze.Ballot[string]{"D": 1}, 100)
+	now = now.Add(time.Second)
+	if got := m.Sample(now); len(got) != 0 {
+		t.Errorf("got anomalies %+v after choices change", got)
+	}
+}
+
+func TestAnomalyMonitor_Run(t *testing.T) {
+	v := schulze.NewVoting([]string{"A", "B"})
+
+	events := make(chan schulze.Event, 10)
+	m := schulze.NewAnomalyMonitor(v, schulze.NotifierFunc(func(e schulze.Event) {
+		events <- e
+	}), schulze.AnomalyConfig{MinSamples: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var lock sync.Mutex
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.Run(ctx, 10*time.Millisecond, &lock)
+	}()
+
+	// wait for the baseline samples
+	time.Sleep(50 * time.Millisecond)
+
+	lock.Lock()
+	for i := 0; i < 1000; i++ {
+		if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lock.Unlock()
+
+	select {
+	case e := <-events:
+		if e.Type != schulze.EventAnomaly {
+			t.Errorf("got event type %v, want %v", e.Type, schulze.EventAnomaly)
+		}
+		a, ok := e.Data.(schulze.Anomaly[string])
+		if !ok {
+			t.Fatalf("got event data %T", e.Data)
+		}
+		if a.From != "B" || a.To != "A" {
+			t.Errorf("got anomaly of %v over %v, want B over A", a.From, a.To)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("anomaly not notified")
+	}
+
+	cancel()
+	<-done
+}
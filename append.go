@@ -0,0 +1,102 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// AppendChoices updates the preferences passed as the first argument to
+// accommodate the added choices at the end of the current choices. The result
+// is the same as of the SetChoices function with the current and the added
+// choices as the updated choices, but current choices are not searched for,
+// and the matrix is extended in a single pass. Just as the built-in append
+// function, the preferences are updated in place if their capacity is
+// sufficient, otherwise new preferences are returned. Added choices must not
+// be in the current choices.
+func AppendChoices[C comparable](preferences []int, current, added []C) []int {
+	currentLength := len(current)
+	updatedLength := currentLength + len(added)
+	size := updatedLength * updatedLength
+	var updatedPreferences []int
+	if size <= cap(preferences) {
+		updatedPreferences = preferences[:size]
+	} else {
+		updatedPreferences = NewPreferences(updatedLength)
+	}
+	appendPreferences(updatedPreferences, preferences, currentLength, updatedLength)
+	return updatedPreferences
+}
+
+// AppendChoices updates the voting to accommodate the added choices at the
+// end of the current choices, just as the SetChoices method does with the
+// complete updated choices, but without remapping the whole preferences
+// matrix. Added choices must not be in the current choices.
+func (v *Voting[C]) AppendChoices(added ...C) {
+	span, end := v.startSpan("schulze.AppendChoices")
+	defer end(nil)
+	if span != nil {
+		span.SetAttributes(Attribute{Key: "schulze.added_choices", Value: len(added)})
+	}
+
+	v.invalidate()
+	currentLength := len(v.choices)
+	updatedLength := currentLength + len(added)
+	preferences := v.preferences
+	if size := updatedLength * updatedLength; size > cap(preferences) && v.options.choicesCapacity > 0 {
+		// grow to twice the number of choices, just as SetChoices does
+		preferences = newPreferencesWithCapacity(updatedLength, 2*updatedLength)[:len(v.preferences)]
+		copy(preferences, v.preferences)
+	}
+	v.preferences = AppendChoices(preferences, v.choices, added)
+	// the choices slice may be shared with the caller
+	v.choices = append(v.choices[:currentLength:currentLength], added...)
+}
+
+// AppendChoices updates the election to accommodate the added choices at the
+// end of the current choices, just as the Voting AppendChoices method does.
+func (e *Election[V, C]) AppendChoices(added ...C) {
+	e.voting.AppendChoices(added...)
+}
+
+// appendPreferences lays out the preferences for the current number of
+// choices in the updated preferences for the larger updated number of
+// choices, where the updated preferences are of the exact length and may
+// share the memory with the preferences.
+func appendPreferences(updatedPreferences, preferences []int, currentLength, updatedLength int) {
+	// rows are moved from the last one, as the updated position of every
+	// value is not lower than its current position, so no value is
+	// overwritten before it is moved
+	for i := updatedLength - 1; i >= 0; i-- {
+		row := updatedPreferences[i*updatedLength : (i+1)*updatedLength]
+		if i >= currentLength {
+			// nobody voted for the new choice
+			for j := range row {
+				row[j] = 0
+			}
+			continue
+		}
+		// set the columns of the new choices to the preferences' diagonal
+		// value, just as nobody voted for the new choices, as in SetChoices
+		diagonal := preferences[i*currentLength+i]
+		for j := updatedLength - 1; j >= currentLength; j-- {
+			row[j] = diagonal
+		}
+		for j := currentLength - 1; j >= 0; j-- {
+			row[j] = preferences[i*currentLength+j]
+		}
+	}
+}
+
+// isAppended returns true if the updated choices start with all current
+// choices in the same order.
+func isAppended[C comparable](current, updated []C) bool {
+	if len(updated) < len(current) {
+		return false
+	}
+	for i, c := range current {
+		if updated[i] != c {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestAppendChoices(t *testing.T) {
+	current := []string{"A", "B", "C"}
+	preferences := schulze.NewPreferences(len(current))
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"C": 1},
+		{"B": 1, "A": 1, "C": 2},
+	} {
+		if _, err := schulze.Vote(preferences, current, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := schulze.SetChoices(preferences, current, []string{"A", "B", "C", "D", "E"})
+
+	original := schulze.ClonePreferences(preferences)
+	got := schulze.AppendChoices(preferences, current, []string{"D", "E"})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(preferences, original) {
+		t.Errorf("preferences without sufficient capacity changed to %v", preferences)
+	}
+
+	// in place with sufficient capacity
+	inPlace := make([]int, len(preferences), len(want))
+	copy(inPlace, preferences)
+	got = schulze.AppendChoices(inPlace, current, []string{"D", "E"})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got in place preferences %v, want %v", got, want)
+	}
+	if &got[0] != &inPlace[:1][0] {
+		t.Error("preferences with sufficient capacity are not updated in place")
+	}
+}
+
+func TestVoting_AppendChoices(t *testing.T) {
+	seed := time.Now().UnixNano()
+	t.Logf("seed: %v", seed)
+	random := rand.New(rand.NewSource(seed))
+
+	all := newChoices(12)
+
+	for _, opts := range [][]schulze.Option[string]{
+		nil,
+		{schulze.WithChoicesCapacity[string](5)},
+	} {
+		initial := all[:2]
+		appended := schulze.NewVoting(initial, opts...)
+		v := schulze.NewVoting(initial)
+
+		for n := 2; n < len(all); {
+			added := all[n : n+1+random.Intn(len(all)-n)]
+			n += len(added)
+			appended.AppendChoices(added...)
+			v.SetChoices(all[:n])
+
+			choices := all[:n]
+			for i := 0; i < 5; i++ {
+				b := make(schulze.Ballot[string])
+				for j, count := 0, random.Intn(len(choices)); j < count; j++ {
+					b[choices[random.Intn(len(choices))]] = random.Intn(3)
+				}
+				if _, err := appended.Vote(b); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := v.Vote(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got, want := appended.Preferences(), v.Preferences(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%v choices: got preferences %v, want %v", n, got, want)
+			}
+		}
+		if !reflect.DeepEqual(initial, all[:2]) {
+			t.Errorf("initial choices changed to %v", initial)
+		}
+	}
+}
+
+func TestElection_AppendChoices(t *testing.T) {
+	choices := []string{"A", "B"}
+	e := schulze.NewElection[string](choices)
+	if _, err := e.Vote("v1", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	e.AppendChoices("C")
+
+	if got, want := e.Choices(), []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got choices %v, want %v", got, want)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want B", results[0].Choice)
+	}
+}
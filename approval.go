@@ -0,0 +1,49 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// ApprovalBallot returns a Ballot that approves the provided choices by
+// ranking all of them with the highest rank, leaving other choices unranked.
+// Such ballots allow approval polls to be ranked by the Schulze method, while
+// approval counts are available from the ApprovalCounts function.
+func ApprovalBallot[C comparable](approved ...C) Ballot[C] {
+	b := make(Ballot[C], len(approved))
+	for _, c := range approved {
+		b[c] = 1
+	}
+	return b
+}
+
+// ApprovalCounts returns the number of ballots that ranked each of the
+// choices, in the order of choices. For approval ballots, where all approved
+// choices are ranked with the same rank, it is the number of approvals. If a
+// ballot ranked all choices, every choice is counted as approved by it.
+func ApprovalCounts[C comparable](preferences []int, choices []C) []int {
+	choicesCount := len(choices)
+	counts := make([]int, choicesCount)
+	// diagonal values hold the number of ballots that ranked the choice
+	// above the unranked choices
+	for i := 0; i < choicesCount; i++ {
+		counts[i] = preferences[i*choicesCount+i]
+	}
+	return counts
+}
+
+// ApprovalCounts returns the number of ballots that ranked each of the
+// choices, in the order of choices.
+func (v *Voting[C]) ApprovalCounts() []int {
+	return ApprovalCounts(v.preferences, v.choices)
+}
+
+// ApprovalCounts returns the number of ballots that ranked each of the
+// choices, in the order of choices. ErrSealed is returned if the results of
+// the election are sealed.
+func (e *Election[V, C]) ApprovalCounts() ([]int, error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, err
+	}
+	return e.voting.ApprovalCounts(), nil
+}
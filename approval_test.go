@@ -0,0 +1,65 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestApprovalCounts(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+
+	ballots := []schulze.Ballot[string]{
+		schulze.ApprovalBallot("A", "B"),
+		schulze.ApprovalBallot("B"),
+		schulze.ApprovalBallot("B", "C"),
+		schulze.ApprovalBallot[string](),
+		schulze.ApprovalBallot("A", "B", "C", "D"),
+	}
+	want := []int{2, 4, 2, 1}
+
+	t.Run("functional", func(t *testing.T) {
+		preferences := schulze.NewPreferences(len(choices))
+		for _, b := range ballots {
+			if _, err := schulze.Vote(preferences, choices, b); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := schulze.ApprovalCounts(preferences, choices); !reflect.DeepEqual(got, want) {
+			t.Errorf("got approval counts %v, want %v", got, want)
+		}
+		results, _, _ := schulze.Compute(preferences, choices)
+		if results[0].Choice != "B" {
+			t.Errorf("got winner %v, want %v", results[0].Choice, "B")
+		}
+	})
+
+	t.Run("Voting", func(t *testing.T) {
+		v := schulze.NewVoting(choices)
+		var records []schulze.Record[string]
+		for _, b := range ballots {
+			r, err := v.Vote(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			records = append(records, r)
+		}
+		if got := v.ApprovalCounts(); !reflect.DeepEqual(got, want) {
+			t.Errorf("got approval counts %v, want %v", got, want)
+		}
+
+		if err := v.Unvote(records[0]); err != nil {
+			t.Fatal(err)
+		}
+		v.SetChoices([]string{"E", "D", "C", "B"})
+		if got, want := v.ApprovalCounts(), []int{0, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got approval counts %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"crypto"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// Archive stores certified results of recurring elections, such as a monthly
+// prioritization, in the order of their certification time, to query how the
+// ranks of choices change over time. It is encoded as a JSON array of its
+// entries, so that it can be kept in a single file. Methods on the Archive
+// type are not safe for concurrent calls.
+type Archive[C comparable] struct {
+	entries []ArchiveEntry[C]
+}
+
+// ArchiveEntry holds the certified results of a single archived election.
+type ArchiveEntry[C comparable] struct {
+	// Name of the election, unique in the Archive.
+	Name string `json:"name"`
+	// Time when the results were certified.
+	IssuedAt time.Time         `json:"issuedAt"`
+	Document ResultDocument[C] `json:"document"`
+}
+
+// TrendPoint is the position of a choice in a single archived election.
+type TrendPoint struct {
+	// Name of the election.
+	Name string
+	// Time when the results of the election were certified.
+	IssuedAt time.Time
+	// Rank of the choice, starting with one for the winner, or zero if the
+	// choice was not in the election.
+	Rank int
+	// Number of choices with ranked results in the election.
+	ChoicesCount int
+	// Number of wins of the choice.
+	Wins int
+	// Number of ballots that were counted in the election.
+	BallotsCount int
+}
+
+// NewArchive returns a new Archive without elections.
+func NewArchive[C comparable]() *Archive[C] {
+	return new(Archive[C])
+}
+
+// AddCertificate verifies the Certificate with the public key of the signer,
+// as VerifyCertificate does, and adds its results to the Archive.
+func (a *Archive[C]) AddCertificate(c *Certificate, pub crypto.PublicKey) error {
+	content, err := VerifyCertificate[C](c, pub)
+	if err != nil {
+		return err
+	}
+	return a.Add(ArchiveEntry[C]{
+		Name:     content.Document.Name,
+		IssuedAt: content.IssuedAt,
+		Document: content.Document,
+	})
+}
+
+// Add adds the entry to the Archive in the order of the certification time.
+// ErrUnnamedElection is returned if the entry has no name and
+// DuplicateElectionError if an election with the same name is archived.
+func (a *Archive[C]) Add(entry ArchiveEntry[C]) error {
+	if entry.Name == "" {
+		return ErrUnnamedElection
+	}
+	for _, e := range a.entries {
+		if e.Name == entry.Name {
+			return &DuplicateElectionError{Name: entry.Name}
+		}
+	}
+	i := sort.Search(len(a.entries), func(i int) bool {
+		return a.entries[i].IssuedAt.After(entry.IssuedAt)
+	})
+	a.entries = append(a.entries, ArchiveEntry[C]{})
+	copy(a.entries[i+1:], a.entries[i:])
+	a.entries[i] = entry
+	return nil
+}
+
+// Entries returns all archived entries in the order of the certification
+// time.
+func (a *Archive[C]) Entries() []ArchiveEntry[C] {
+	return append([]ArchiveEntry[C](nil), a.entries...)
+}
+
+// Range returns the archived entries certified in the time period, including
+// the start and excluding the end, in the order of the certification time.
+func (a *Archive[C]) Range(start, end time.Time) []ArchiveEntry[C] {
+	var entries []ArchiveEntry[C]
+	for _, e := range a.entries {
+		if !e.IssuedAt.Before(start) && e.IssuedAt.Before(end) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// Choices returns all choices of the archived elections in the order of
+// their first appearance.
+func (a *Archive[C]) Choices() []C {
+	var choices []C
+	seen := make(map[C]struct{})
+	for _, e := range a.entries {
+		for _, c := range e.Document.Choices {
+			if _, ok := seen[c]; !ok {
+				seen[c] = struct{}{}
+				choices = append(choices, c)
+			}
+		}
+	}
+	return choices
+}
+
+// Trend returns the rank trajectory of the choice with a point for every
+// archived election in the order of the certification time, including the
+// elections without the choice with the zero rank, so that points of
+// different choices are aligned.
+func (a *Archive[C]) Trend(c C) []TrendPoint {
+	points := make([]TrendPoint, 0, len(a.entries))
+	for _, e := range a.entries {
+		p := TrendPoint{
+			Name:         e.Name,
+			IssuedAt:     e.IssuedAt,
+			ChoicesCount: len(e.Document.Ranking),
+			BallotsCount: e.Document.BallotsCount,
+		}
+		for _, r := range e.Document.Ranking {
+			if r.Choice == c {
+				p.Rank = r.Rank
+				p.Wins = r.Wins
+				break
+			}
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// Trends returns rank trajectories of all choices of the archived elections,
+// as the Trend method does.
+func (a *Archive[C]) Trends() map[C][]TrendPoint {
+	choices := a.Choices()
+	trends := make(map[C][]TrendPoint, len(choices))
+	for _, c := range choices {
+		trends[c] = a.Trend(c)
+	}
+	return trends
+}
+
+// MarshalJSON encodes the Archive as a JSON array of its entries.
+func (a *Archive[C]) MarshalJSON() ([]byte, error) {
+	entries := a.entries
+	if entries == nil {
+		entries = make([]ArchiveEntry[C], 0)
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON decodes the Archive from the JSON array of its entries,
+// replacing the current entries.
+func (a *Archive[C]) UnmarshalJSON(data []byte) error {
+	var entries []ArchiveEntry[C]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	archive := NewArchive[C]()
+	for _, e := range entries {
+		if err := archive.Add(e); err != nil {
+			return err
+		}
+	}
+	*a = *archive
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestArchive(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certify := func(t *testing.T, name string, issuedAt time.Time, choices []string, ballots ...schulze.Ballot[string]) *schulze.Certificate {
+		t.Helper()
+		e, err := schulze.NewElectionFromConfig[int](schulze.ElectionConfig[string]{
+			Name:    name,
+			Choices: choices,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		e.SetNow(func() time.Time { return issuedAt })
+		for i, b := range ballots {
+			if _, err := e.Vote(i, b); err != nil {
+				t.Fatal(err)
+			}
+		}
+		c, err := schulze.Certify(e, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	month := func(m time.Month) time.Time {
+		return time.Date(2026, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	a := schulze.NewArchive[string]()
+	// added out of order
+	for _, c := range []*schulze.Certificate{
+		certify(t, "march", month(time.March), []string{"A", "B", "C"},
+			schulze.Ballot[string]{"C": 1, "A": 2},
+			schulze.Ballot[string]{"C": 1, "B": 2},
+		),
+		certify(t, "january", month(time.January), []string{"A", "B"},
+			schulze.Ballot[string]{"A": 1, "B": 2},
+		),
+		certify(t, "february", month(time.February), []string{"A", "B", "C"},
+			schulze.Ballot[string]{"B": 1, "A": 2, "C": 3},
+			schulze.Ballot[string]{"B": 1},
+		),
+	} {
+		if err := a.AddCertificate(c, pub); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+	for _, e := range a.Entries() {
+		names = append(names, e.Name)
+	}
+	if want := []string{"january", "february", "march"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got entries %v, want %v", names, want)
+	}
+	if got, want := a.Choices(), []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got choices %v, want %v", got, want)
+	}
+
+	var ranks []int
+	for _, p := range a.Trend("C") {
+		ranks = append(ranks, p.Rank)
+	}
+	if want := []int{0, 3, 1}; !reflect.DeepEqual(ranks, want) {
+		t.Errorf("got ranks of C %v, want %v", ranks, want)
+	}
+	if got, want := a.Trends()["B"][1], (schulze.TrendPoint{
+		Name:         "february",
+		IssuedAt:     month(time.February),
+		Rank:         1,
+		ChoicesCount: 3,
+		Wins:         2,
+		BallotsCount: 2,
+	}); got != want {
+		t.Errorf("got trend point %+v, want %+v", got, want)
+	}
+
+	if got := a.Range(month(time.February), month(time.March)); len(got) != 1 || got[0].Name != "february" {
+		t.Errorf("got range %+v, want february", got)
+	}
+
+	err = a.AddCertificate(certify(t, "march", month(time.April), []string{"A"}), pub)
+	var duplicateErr *schulze.DuplicateElectionError
+	if !errors.As(err, &duplicateErr) || duplicateErr.Name != "march" {
+		t.Errorf("got error %v, want duplicate election march", err)
+	}
+	if err := a.Add(schulze.ArchiveEntry[string]{}); !errors.Is(err, schulze.ErrUnnamedElection) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrUnnamedElection)
+	}
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded schulze.Archive[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := decoded.Trend("C"), a.Trend("C"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got decoded trend %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "sync/atomic"
+
+// AtomicPreferences holds pairwise preferences as atomic counters, so that
+// Vote and Unvote methods can be called concurrently from many goroutines
+// without locking. Every preference is an atomic.Int64 value, which is
+// slower to update than a plain integer, and it is intended for workloads with
+// extreme ingest rates, such as televised audience votes. Choices can not be
+// changed.
+type AtomicPreferences[C comparable] struct {
+	choices  []C
+	counters []atomic.Int64
+}
+
+// NewAtomicPreferences initializes atomic preferences for the provided
+// choices.
+func NewAtomicPreferences[C comparable](choices []C) *AtomicPreferences[C] {
+	return &AtomicPreferences[C]{
+		choices:  choices,
+		counters: make([]atomic.Int64, len(choices)*len(choices)),
+	}
+}
+
+// Vote adds the Ballot values to the preferences. A record of a complete and
+// normalized preferences is returned that can be used to unvote. It is safe to
+// call this method concurrently.
+func (p *AtomicPreferences[C]) Vote(b Ballot[C]) (Record[C], error) {
+	return voteFunc(p.choices, b, func(index int) {
+		p.counters[index].Add(1)
+	})
+}
+
+// Unvote removes the Record values from the preferences. It is safe to call
+// this method concurrently.
+func (p *AtomicPreferences[C]) Unvote(r Record[C]) error {
+	unvoteFunc(p.choices, r, func(index int) {
+		p.counters[index].Add(-1)
+	})
+	return nil
+}
+
+// Preferences returns a new preferences slice with the current values of the
+// counters. If ballots are voted concurrently, the returned preferences may
+// include only a part of the ballot that is being voted.
+func (p *AtomicPreferences[C]) Preferences() []int {
+	preferences := NewPreferences(len(p.choices))
+	for i := range p.counters {
+		preferences[i] = int(p.counters[i].Load())
+	}
+	return preferences
+}
+
+// Compute calculates a sorted list of choices with the total number of wins for
+// each of them from the current values of the counters. If there are multiple
+// winners, tie boolean parameter is true.
+func (p *AtomicPreferences[C]) Compute(opts ...Option[C]) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	return Compute(p.Preferences(), p.choices, opts...)
+}
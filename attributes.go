@@ -0,0 +1,78 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Attributes are audit key-value pairs, such as a hash of the IP address, a
+// session identifier or the user agent, attached to a recorded ballot to be
+// able to correlate ballots during a review. Unlike Tags, attributes are not
+// used to compute results and they are never included in exported results,
+// documents or records.
+type Attributes map[string]string
+
+// SetAttributes attaches the audit attributes to the recorded ballot of the
+// voter, replacing previously attached ones. Attributes are removed when the
+// voter votes again, unvotes or spoils the ballot. ErrNoBallot is returned
+// if the voter has no recorded ballot.
+func (e *Election[V, C]) SetAttributes(voter V, attrs Attributes) error {
+	if _, ok := e.records[voter]; !ok {
+		return ErrNoBallot
+	}
+	if len(attrs) == 0 {
+		delete(e.attributes, voter)
+		return nil
+	}
+	e.attributes[voter] = Attributes(copyTags(Tags(attrs)))
+	return nil
+}
+
+// Attributes returns the audit attributes attached to the voter's ballot.
+func (e *Election[V, C]) Attributes(voter V) Attributes {
+	return Attributes(copyTags(Tags(e.attributes[voter])))
+}
+
+// VotersByAttribute returns voters whose ballots have the attribute with the
+// key and the value, such as all ballots cast from the same session, ordered
+// by their default formatting.
+func (e *Election[V, C]) VotersByAttribute(key, value string) []V {
+	var voters []V
+	for voter, attrs := range e.attributes {
+		if v, ok := attrs[key]; ok && v == value {
+			voters = append(voters, voter)
+		}
+	}
+	sort.Slice(voters, func(i, j int) bool {
+		return fmt.Sprint(voters[i]) < fmt.Sprint(voters[j])
+	})
+	return voters
+}
+
+// SharedAttributes returns values of the attribute with the key that are
+// attached to ballots of more than one voter, mapped to those voters ordered
+// by their default formatting, to find ballots that may be cast by the same
+// person.
+func (e *Election[V, C]) SharedAttributes(key string) map[string][]V {
+	byValue := make(map[string][]V)
+	for voter, attrs := range e.attributes {
+		if v, ok := attrs[key]; ok {
+			byValue[v] = append(byValue[v], voter)
+		}
+	}
+	for v, voters := range byValue {
+		if len(voters) < 2 {
+			delete(byValue, v)
+			continue
+		}
+		sort.Slice(voters, func(i, j int) bool {
+			return fmt.Sprint(voters[i]) < fmt.Sprint(voters[j])
+		})
+	}
+	return byValue
+}
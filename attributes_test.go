@@ -0,0 +1,85 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_attributes(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+
+	if err := e.SetAttributes("alice", schulze.Attributes{"ip": "h1"}); !errors.Is(err, schulze.ErrNoBallot) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNoBallot)
+	}
+
+	for voter, ip := range map[string]string{
+		"alice": "h1",
+		"bob":   "h2",
+		"carol": "h1",
+		"dave":  "h1",
+	} {
+		if _, err := e.Vote(voter, schulze.Ballot[string]{"A": 1}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.SetAttributes(voter, schulze.Attributes{"ip": ip, "session": voter}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	attrs := e.Attributes("alice")
+	if want := (schulze.Attributes{"ip": "h1", "session": "alice"}); !reflect.DeepEqual(attrs, want) {
+		t.Errorf("got attributes %v, want %v", attrs, want)
+	}
+	attrs["ip"] = "h9"
+	if got := e.Attributes("alice")["ip"]; got != "h1" {
+		t.Errorf("attributes mutated through the returned value, got %v", got)
+	}
+
+	if got, want := e.VotersByAttribute("ip", "h1"), []string{"alice", "carol", "dave"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got voters %v, want %v", got, want)
+	}
+
+	// attributes are removed with the ballot that they are attached to
+	if _, err := e.Vote("carol", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("dave"); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.Attributes("carol"); got != nil {
+		t.Errorf("got attributes %v of the changed ballot", got)
+	}
+	if got := e.SharedAttributes("ip"); len(got) != 0 {
+		t.Errorf("got shared attributes %v, want none", got)
+	}
+
+	if err := e.SetAttributes("carol", schulze.Attributes{"ip": "h2"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.SharedAttributes("ip"), map[string][]string{"h2": {"bob", "carol"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got shared attributes %v, want %v", got, want)
+	}
+
+	// attributes are not exported with results
+	d, err := e.ResultDocument()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "h2") {
+		t.Errorf("result document %s contains attributes", data)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "time"
+
+// AuditAction identifies an administrative change of the election that is
+// recorded in the audit log.
+type AuditAction string
+
+// Audit actions recorded in the election audit log.
+const (
+	// AuditRedact is recorded when choices are redacted from ballots.
+	AuditRedact AuditAction = "redact"
+	// AuditRename is recorded when a choice is renamed.
+	AuditRename AuditAction = "rename"
+	// AuditSuspend is recorded when a choice is suspended.
+	AuditSuspend AuditAction = "suspend"
+	// AuditResume is recorded when a suspended choice is resumed.
+	AuditResume AuditAction = "resume"
+	// AuditWithdraw is recorded when a choice is withdrawn.
+	AuditWithdraw AuditAction = "withdraw"
+	// AuditAppointProxy is recorded when a proxy is appointed for a
+	// principal.
+	AuditAppointProxy AuditAction = "appoint-proxy"
+	// AuditRevokeProxy is recorded when a proxy appointment is revoked or
+	// ends as the principal votes personally.
+	AuditRevokeProxy AuditAction = "revoke-proxy"
+	// AuditAcceptProvisional is recorded when a provisional ballot is
+	// accepted and tallied.
+	AuditAcceptProvisional AuditAction = "accept-provisional"
+	// AuditRejectProvisional is recorded when a provisional ballot is
+	// rejected.
+	AuditRejectProvisional AuditAction = "reject-provisional"
+	// AuditAdmitNominations is recorded when the nominated choices are added
+	// to the ballot as the election opens.
+	AuditAdmitNominations AuditAction = "admit-nominations"
+	// AuditErase is recorded as a tombstone when all data of a voter is
+	// erased, without the voter identity.
+	AuditErase AuditAction = "erase"
+	// AuditImportBatch is recorded when a batch of ballots from an offline
+	// voting station is imported.
+	AuditImportBatch AuditAction = "import-batch"
+	// AuditReset is recorded when all ballots are removed from the election.
+	AuditReset AuditAction = "reset"
+)
+
+// AuditEntry describes a single administrative change of the election.
+type AuditEntry struct {
+	Time   time.Time   `json:"time"`
+	Action AuditAction `json:"action"`
+	Reason string      `json:"reason,omitempty"`
+	// Choices affected by the change, formatted by the fmt package.
+	Choices []string `json:"choices,omitempty"`
+	// Number of ballots changed.
+	BallotsCount int `json:"ballotsCount"`
+}
+
+// AuditLog returns all audit entries of the election in the order they were
+// recorded.
+func (e *Election[V, C]) AuditLog() []AuditEntry {
+	log := make([]AuditEntry, len(e.audit))
+	for i, entry := range e.audit {
+		entry.Choices = append([]string(nil), entry.Choices...)
+		log[i] = entry
+	}
+	return log
+}
+
+func (e *Election[V, C]) addAuditEntry(entry AuditEntry) {
+	entry.Time = e.now()
+	e.audit = append(e.audit, entry)
+}
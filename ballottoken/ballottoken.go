@@ -0,0 +1,155 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ballottoken casts ballots that are wrapped in signed tokens, such
+// as the ones in voting links sent by email. Token claims identify the
+// election, the voter and the expiry, and the Gateway validates the
+// signature, the election and the expiry before the ballot is tallied.
+//
+// Tokens are JSON Web Tokens signed with HS256 or EdDSA, or PASETO v4.public
+// tokens. Every token format verifies only the tokens of its own algorithm,
+// so a token can not select a weaker algorithm than the one of the key.
+//
+// The ballot is either in the "ballot" claim of the token, in the text format
+// of the schulze.ParseBallot function, such as "A > B = C", or the token only
+// authorizes the voter to cast a ballot that is submitted together with it,
+// for example from a form that is opened by the voting link.
+package ballottoken
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+var (
+	// ErrInvalidToken is returned when the token is malformed, its signature
+	// is not valid or it has no required claims.
+	ErrInvalidToken = errors.New("ballottoken: invalid token")
+	// ErrExpired is returned when the token is expired.
+	ErrExpired = errors.New("ballottoken: token expired")
+	// ErrNotValidYet is returned when the token is used before the time in
+	// its not before claim.
+	ErrNotValidYet = errors.New("ballottoken: token not valid yet")
+	// ErrElectionMismatch is returned when the token is issued for a
+	// different election.
+	ErrElectionMismatch = errors.New("ballottoken: token issued for a different election")
+	// ErrNoBallot is returned when the token has no ballot claim.
+	ErrNoBallot = errors.New("ballottoken: no ballot in the token")
+)
+
+// Claims are the claims of a ballot token.
+type Claims struct {
+	// Name of the election.
+	Election string
+	// Identifier of the voter.
+	Voter string
+	// Time after which the token is not accepted.
+	ExpiresAt time.Time
+	// Optional time before which the token is not accepted.
+	NotBefore time.Time
+	// Optional ballot in the text format of schulze.ParseBallot.
+	Ballot string
+}
+
+// Verifier verifies the signature of the token and returns its claims.
+type Verifier interface {
+	Verify(token string) (Claims, error)
+}
+
+// Signer issues signed tokens with the claims.
+type Signer interface {
+	Sign(c Claims) (string, error)
+}
+
+// Gateway casts ballots from tokens in the election. Methods on the Gateway
+// are safe for concurrent calls and all calls to the election are guarded by
+// the Gateway lock.
+type Gateway struct {
+	election *schulze.Election[string, string]
+	verifier Verifier
+	now      func() time.Time
+	mu       sync.Mutex
+}
+
+// NewGateway returns a new Gateway for the election that accepts tokens
+// verified by the verifier. Tokens must be issued for the name of the
+// election in its configuration.
+func NewGateway(e *schulze.Election[string, string], v Verifier) *Gateway {
+	return &Gateway{
+		election: e,
+		verifier: v,
+		now:      time.Now,
+	}
+}
+
+// Locker returns the lock that guards all calls to the election.
+func (g *Gateway) Locker() sync.Locker {
+	return &g.mu
+}
+
+// Vote validates the token and casts the ballot from its ballot claim for the
+// voter of the token. A later ballot of the same voter replaces the previous
+// one. ErrNoBallot is returned if the token has no ballot claim.
+func (g *Gateway) Vote(token string) (schulze.Record[string], error) {
+	c, err := g.Validate(token)
+	if err != nil {
+		return nil, err
+	}
+	if c.Ballot == "" {
+		return nil, ErrNoBallot
+	}
+	b, err := schulze.ParseBallot(c.Ballot)
+	if err != nil {
+		return nil, err
+	}
+	return g.vote(c.Voter, b)
+}
+
+// VoteWith validates the token and casts the ballot for the voter of the
+// token. The ballot claim of the token, if any, is ignored.
+func (g *Gateway) VoteWith(token string, b schulze.Ballot[string]) (schulze.Record[string], error) {
+	c, err := g.Validate(token)
+	if err != nil {
+		return nil, err
+	}
+	return g.vote(c.Voter, b)
+}
+
+// Validate verifies the signature of the token and validates its election
+// and validity period, returning its claims.
+func (g *Gateway) Validate(token string) (Claims, error) {
+	c, err := g.verifier.Verify(token)
+	if err != nil {
+		return Claims{}, err
+	}
+	if c.Voter == "" || c.ExpiresAt.IsZero() {
+		return Claims{}, fmt.Errorf("%w: missing voter or expiry", ErrInvalidToken)
+	}
+	g.mu.Lock()
+	name := g.election.Config().Name
+	g.mu.Unlock()
+	if c.Election != name {
+		return Claims{}, ErrElectionMismatch
+	}
+	now := g.now()
+	if !now.Before(c.ExpiresAt) {
+		return Claims{}, ErrExpired
+	}
+	if !c.NotBefore.IsZero() && now.Before(c.NotBefore) {
+		return Claims{}, ErrNotValidYet
+	}
+	return c, nil
+}
+
+func (g *Gateway) vote(voter string, b schulze.Ballot[string]) (schulze.Record[string], error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.election.Vote(voter, b)
+}
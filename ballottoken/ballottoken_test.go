@@ -0,0 +1,108 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ballottoken_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/ballottoken"
+)
+
+func TestGateway(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:    "board",
+		Choices: []string{"A", "B", "C"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := ballottoken.NewHS256([]byte("secret"))
+	g := ballottoken.NewGateway(e, key)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	ballottoken.SetNow(g, func() time.Time { return now })
+
+	sign := func(c ballottoken.Claims) string {
+		t.Helper()
+		token, err := key.Sign(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	r, err := g.Vote(sign(ballottoken.Claims{
+		Election:  "board",
+		Voter:     "alice",
+		ExpiresAt: now.Add(time.Hour),
+		Ballot:    "B > A > C",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"B"}, {"A"}, {"C"}, {}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+
+	authorization := sign(ballottoken.Claims{
+		Election:  "board",
+		Voter:     "bob",
+		ExpiresAt: now.Add(time.Hour),
+	})
+	if _, err := g.Vote(authorization); !errors.Is(err, ballottoken.ErrNoBallot) {
+		t.Errorf("got error %v, want %v", err, ballottoken.ErrNoBallot)
+	}
+	if _, err := g.VoteWith(authorization, schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.VotersCount(), 2; got != want {
+		t.Errorf("got voters count %v, want %v", got, want)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		claims ballottoken.Claims
+		err    error
+	}{
+		{
+			name:   "expired",
+			claims: ballottoken.Claims{Election: "board", Voter: "carol", ExpiresAt: now},
+			err:    ballottoken.ErrExpired,
+		},
+		{
+			name:   "not valid yet",
+			claims: ballottoken.Claims{Election: "board", Voter: "carol", ExpiresAt: now.Add(2 * time.Hour), NotBefore: now.Add(time.Hour)},
+			err:    ballottoken.ErrNotValidYet,
+		},
+		{
+			name:   "different election",
+			claims: ballottoken.Claims{Election: "budget", Voter: "carol", ExpiresAt: now.Add(time.Hour)},
+			err:    ballottoken.ErrElectionMismatch,
+		},
+		{
+			name:   "no expiry",
+			claims: ballottoken.Claims{Election: "board", Voter: "carol"},
+			err:    ballottoken.ErrInvalidToken,
+		},
+		{
+			name:   "no voter",
+			claims: ballottoken.Claims{Election: "board", ExpiresAt: now.Add(time.Hour)},
+			err:    ballottoken.ErrInvalidToken,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := g.VoteWith(sign(tc.claims), schulze.Ballot[string]{"A": 1}); !errors.Is(err, tc.err) {
+				t.Errorf("got error %v, want %v", err, tc.err)
+			}
+		})
+	}
+	if got, want := e.VotersCount(), 2; got != want {
+		t.Errorf("got voters count %v, want %v", got, want)
+	}
+}
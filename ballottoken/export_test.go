@@ -0,0 +1,12 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ballottoken
+
+import "time"
+
+func SetNow(g *Gateway, now func() time.Time) {
+	g.now = now
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ballottoken
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// JWT algorithms.
+const (
+	HS256 = "HS256"
+	EdDSA = "EdDSA"
+)
+
+// JWT signs and verifies JSON Web Tokens with compact serialization. The
+// election is in the "aud" claim, the voter in the "sub" claim, and the
+// "exp" and "nbf" claims are numeric dates.
+type JWT struct {
+	alg     string
+	secret  []byte
+	public  ed25519.PublicKey
+	private ed25519.PrivateKey
+}
+
+// NewHS256 returns the JWT with HMAC SHA-256 signatures with the secret.
+func NewHS256(secret []byte) *JWT {
+	return &JWT{
+		alg:    HS256,
+		secret: append([]byte(nil), secret...),
+	}
+}
+
+// NewEdDSA returns the JWT with Ed25519 signatures. The private key is
+// required only to sign tokens and it can be nil for verification only.
+func NewEdDSA(public ed25519.PublicKey, private ed25519.PrivateKey) *JWT {
+	return &JWT{
+		alg:     EdDSA,
+		public:  public,
+		private: private,
+	}
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+type jwtClaims struct {
+	Audience  string `json:"aud,omitempty"`
+	Subject   string `json:"sub,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	NotBefore int64  `json:"nbf,omitempty"`
+	Ballot    string `json:"ballot,omitempty"`
+}
+
+// Sign returns the signed token with the claims.
+func (j *JWT) Sign(c Claims) (string, error) {
+	if j.alg == EdDSA && j.private == nil {
+		return "", errors.New("ballottoken: no private key")
+	}
+	header, err := json.Marshal(jwtHeader{Alg: j.alg, Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims := jwtClaims{
+		Audience: c.Election,
+		Subject:  c.Voter,
+		Ballot:   c.Ballot,
+	}
+	if !c.ExpiresAt.IsZero() {
+		claims.ExpiresAt = c.ExpiresAt.Unix()
+	}
+	if !c.NotBefore.IsZero() {
+		claims.NotBefore = c.NotBefore.Unix()
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := encode(header) + "." + encode(payload)
+	return signingInput + "." + encode(j.sign([]byte(signingInput))), nil
+}
+
+// Verify verifies the signature of the token, which must be signed with the
+// algorithm of the JWT, and returns its claims.
+func (j *JWT) Verify(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+	var header jwtHeader
+	if err := decodeJSON(parts[0], &header); err != nil {
+		return Claims{}, err
+	}
+	if header.Alg != j.alg {
+		return Claims{}, fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidToken, header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+	if !j.verify([]byte(parts[0]+"."+parts[1]), signature) {
+		return Claims{}, fmt.Errorf("%w: invalid signature", ErrInvalidToken)
+	}
+	var claims jwtClaims
+	if err := decodeJSON(parts[1], &claims); err != nil {
+		return Claims{}, err
+	}
+	c := Claims{
+		Election: claims.Audience,
+		Voter:    claims.Subject,
+		Ballot:   claims.Ballot,
+	}
+	if claims.ExpiresAt != 0 {
+		c.ExpiresAt = time.Unix(claims.ExpiresAt, 0)
+	}
+	if claims.NotBefore != 0 {
+		c.NotBefore = time.Unix(claims.NotBefore, 0)
+	}
+	return c, nil
+}
+
+func (j *JWT) sign(data []byte) []byte {
+	if j.alg == EdDSA {
+		return ed25519.Sign(j.private, data)
+	}
+	h := hmac.New(sha256.New, j.secret)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func (j *JWT) verify(data, signature []byte) bool {
+	if j.alg == EdDSA {
+		return len(j.public) == ed25519.PublicKeySize && ed25519.Verify(j.public, data, signature)
+	}
+	return hmac.Equal(j.sign(data), signature)
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeJSON(s string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("%w: malformed encoding", ErrInvalidToken)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return nil
+}
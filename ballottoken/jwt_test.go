@@ -0,0 +1,69 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ballottoken_test
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"resenje.org/schulze/ballottoken"
+)
+
+func TestJWT(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := ballottoken.Claims{
+		Election:  "board",
+		Voter:     "alice",
+		ExpiresAt: time.Unix(1780000000, 0),
+		NotBefore: time.Unix(1770000000, 0),
+		Ballot:    "A > B",
+	}
+
+	for _, key := range []*ballottoken.JWT{
+		ballottoken.NewHS256([]byte("secret")),
+		ballottoken.NewEdDSA(public, private),
+	} {
+		token, err := key.Sign(claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := key.Verify(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != claims {
+			t.Errorf("got claims %+v, want %+v", got, claims)
+		}
+
+		parts := strings.Split(token, ".")
+		tampered := parts[0] + ".e30." + parts[2]
+		if _, err := key.Verify(tampered); !errors.Is(err, ballottoken.ErrInvalidToken) {
+			t.Errorf("got error %v for a tampered token, want %v", err, ballottoken.ErrInvalidToken)
+		}
+		// the header with the "none" algorithm
+		if _, err := key.Verify("eyJhbGciOiJub25lIn0." + parts[1] + "."); !errors.Is(err, ballottoken.ErrInvalidToken) {
+			t.Errorf("got error %v for an unsigned token, want %v", err, ballottoken.ErrInvalidToken)
+		}
+	}
+
+	// the token of one algorithm is not accepted by the key of another one
+	token, err := ballottoken.NewHS256(public).Sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ballottoken.NewEdDSA(public, nil).Verify(token); !errors.Is(err, ballottoken.ErrInvalidToken) {
+		t.Errorf("got error %v, want %v", err, ballottoken.ErrInvalidToken)
+	}
+	if _, err := ballottoken.NewEdDSA(public, nil).Sign(claims); err == nil {
+		t.Error("expected error signing without the private key")
+	}
+}
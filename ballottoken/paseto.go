@@ -0,0 +1,119 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ballottoken
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// pasetoHeader is the header of PASETO v4.public tokens.
+const pasetoHeader = "v4.public."
+
+// PASETO signs and verifies PASETO v4.public tokens with Ed25519 signatures,
+// without a footer and an implicit assertion. The election is in the "aud"
+// claim, the voter in the "sub" claim, and the "exp" and "nbf" claims are
+// RFC 3339 times.
+type PASETO struct {
+	public  ed25519.PublicKey
+	private ed25519.PrivateKey
+}
+
+// NewPASETO returns the PASETO with the Ed25519 keys. The private key is
+// required only to sign tokens and it can be nil for verification only.
+func NewPASETO(public ed25519.PublicKey, private ed25519.PrivateKey) *PASETO {
+	return &PASETO{
+		public:  public,
+		private: private,
+	}
+}
+
+type pasetoClaims struct {
+	Audience  string     `json:"aud,omitempty"`
+	Subject   string     `json:"sub,omitempty"`
+	ExpiresAt *time.Time `json:"exp,omitempty"`
+	NotBefore *time.Time `json:"nbf,omitempty"`
+	Ballot    string     `json:"ballot,omitempty"`
+}
+
+// Sign returns the signed token with the claims.
+func (p *PASETO) Sign(c Claims) (string, error) {
+	if p.private == nil {
+		return "", errors.New("ballottoken: no private key")
+	}
+	claims := pasetoClaims{
+		Audience: c.Election,
+		Subject:  c.Voter,
+		Ballot:   c.Ballot,
+	}
+	if !c.ExpiresAt.IsZero() {
+		t := c.ExpiresAt.UTC()
+		claims.ExpiresAt = &t
+	}
+	if !c.NotBefore.IsZero() {
+		t := c.NotBefore.UTC()
+		claims.NotBefore = &t
+	}
+	m, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signature := ed25519.Sign(p.private, pae([]byte(pasetoHeader), m, nil, nil))
+	return pasetoHeader + encode(append(m, signature...)), nil
+}
+
+// Verify verifies the signature of the token and returns its claims. Tokens
+// with a footer are not accepted.
+func (p *PASETO) Verify(token string) (Claims, error) {
+	if !strings.HasPrefix(token, pasetoHeader) {
+		return Claims{}, fmt.Errorf("%w: unexpected version or purpose", ErrInvalidToken)
+	}
+	body := strings.TrimPrefix(token, pasetoHeader)
+	if strings.Contains(body, ".") {
+		return Claims{}, fmt.Errorf("%w: unexpected footer", ErrInvalidToken)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil || len(data) < ed25519.SignatureSize {
+		return Claims{}, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+	m, signature := data[:len(data)-ed25519.SignatureSize], data[len(data)-ed25519.SignatureSize:]
+	if len(p.public) != ed25519.PublicKeySize || !ed25519.Verify(p.public, pae([]byte(pasetoHeader), m, nil, nil), signature) {
+		return Claims{}, fmt.Errorf("%w: invalid signature", ErrInvalidToken)
+	}
+	var claims pasetoClaims
+	if err := json.Unmarshal(m, &claims); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	c := Claims{
+		Election: claims.Audience,
+		Voter:    claims.Subject,
+		Ballot:   claims.Ballot,
+	}
+	if claims.ExpiresAt != nil {
+		c.ExpiresAt = *claims.ExpiresAt
+	}
+	if claims.NotBefore != nil {
+		c.NotBefore = *claims.NotBefore
+	}
+	return c, nil
+}
+
+// pae is the pre-authentication encoding of the pieces, with the number of
+// pieces and the length of every piece as little-endian 64-bit integers.
+func pae(pieces ...[]byte) []byte {
+	b := binary.LittleEndian.AppendUint64(nil, uint64(len(pieces)))
+	for _, p := range pieces {
+		b = binary.LittleEndian.AppendUint64(b, uint64(len(p)))
+		b = append(b, p...)
+	}
+	return b
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ballottoken_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"resenje.org/schulze/ballottoken"
+)
+
+func TestPASETO(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := ballottoken.NewPASETO(public, private)
+	claims := ballottoken.Claims{
+		Election:  "board",
+		Voter:     "alice",
+		ExpiresAt: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+		Ballot:    "A > B",
+	}
+
+	token, err := key.Sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ballottoken.NewPASETO(public, nil).Verify(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != claims {
+		t.Errorf("got claims %+v, want %+v", got, claims)
+	}
+
+	otherPublic, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name  string
+		key   *ballottoken.PASETO
+		token string
+	}{
+		{name: "other key", key: ballottoken.NewPASETO(otherPublic, nil), token: token},
+		{name: "footer", key: key, token: token + ".e30"},
+		{name: "local", key: key, token: "v4.local." + token[len("v4.public."):]},
+		{name: "short", key: key, token: "v4.public.e30"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.key.Verify(tc.token); !errors.Is(err, ballottoken.ErrInvalidToken) {
+				t.Errorf("got error %v, want %v", err, ballottoken.ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestPASETO_testVector(t *testing.T) {
+	// test vector 4-S-1 of the PASETO specification
+	public, err := hex.DecodeString("1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9bg_XBBzds8lTZShVlwwKSgeKpLT3yukTw6JUz3W4h_ExsQV-P0V54zemZDcAxFaSeef1QlXEFtkqxT1ciiQEDA"
+	c, err := ballottoken.NewPASETO(public, nil).Verify(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC); !c.ExpiresAt.Equal(want) {
+		t.Errorf("got expiry %v, want %v", c.ExpiresAt, want)
+	}
+}
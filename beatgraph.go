@@ -0,0 +1,212 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// BeatGraph is the graph of pairwise defeats between choices, with the
+// strongest paths between them, structured for visualization tools such as
+// D3 and Graphviz. Nodes and links have the JSON encoding of D3 force and
+// Sankey layouts, where links reference nodes by their indexes.
+type BeatGraph[C comparable] struct {
+	Nodes []BeatGraphNode[C] `json:"nodes"`
+	// Links from every choice to the choices that it directly defeats.
+	Links []BeatGraphLink `json:"links"`
+	// Strongest paths between every ordered pair of choices that are
+	// connected by a path.
+	Paths []BeatPath `json:"paths"`
+}
+
+// BeatGraphNode is a choice in the BeatGraph.
+type BeatGraphNode[C comparable] struct {
+	Index  int `json:"index"`
+	Choice C   `json:"choice"`
+	// 1-based position of the choice in the results.
+	Position int `json:"position"`
+	Wins     int `json:"wins"`
+}
+
+// BeatGraphLink is a direct pairwise defeat of the target choice by the
+// source choice.
+type BeatGraphLink struct {
+	Source int `json:"source"`
+	Target int `json:"target"`
+	// Number of ballots that prefer the source over the target.
+	Preferences int `json:"preferences"`
+	// Number of ballots that prefer the target over the source.
+	Opposition int `json:"opposition"`
+	// Strength of the link, in the measure of the strength variant.
+	Strength int `json:"strength"`
+	// OnStrongestPath is true if the link is a part of a strongest path
+	// between any two choices.
+	OnStrongestPath bool `json:"onStrongestPath"`
+}
+
+// BeatPath is a strongest path between two choices.
+type BeatPath struct {
+	Source int `json:"source"`
+	Target int `json:"target"`
+	// Strength of the path, the strength of its weakest link.
+	Strength int `json:"strength"`
+	// Indexes of choices on the path with the smallest number of links,
+	// from the source to the target.
+	Nodes []int `json:"nodes"`
+}
+
+// NewBeatGraph computes results from the preferences, just as Compute does,
+// and returns the graph of pairwise defeats and strongest paths.
+func NewBeatGraph[C comparable](preferences []int, choices []C, opts ...Option[C]) BeatGraph[C] {
+	o := newOptions(opts)
+	strengths := pathStrengths(choices, preferences, o)
+	results, _ := calculateResults(choices, strengths, o)
+	return newBeatGraph(choices, preferences, strengths, results, nil, o)
+}
+
+// BeatGraph returns the graph of pairwise defeats and strongest paths of the
+// current results. Suspended and disqualified choices have no links.
+func (v *Voting[C]) BeatGraph() BeatGraph[C] {
+	v.compute()
+	excluded := v.suspended
+	if len(v.disqualified) > 0 {
+		excluded = make(map[C]struct{}, len(v.suspended)+len(v.disqualified))
+		for c := range v.suspended {
+			excluded[c] = struct{}{}
+		}
+		for c := range v.disqualified {
+			excluded[c] = struct{}{}
+		}
+	}
+	return newBeatGraph(v.choices, v.preferences, v.strengths, v.results, excluded, v.options)
+}
+
+// BeatGraph returns the graph of pairwise defeats and strongest paths of the
+// current results of the election. ErrSealed is returned if the results of
+// the election are sealed.
+func (e *Election[V, C]) BeatGraph() (BeatGraph[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return BeatGraph[C]{}, err
+	}
+	return e.voting.BeatGraph(), nil
+}
+
+func newBeatGraph[C comparable](choices []C, preferences, strengths []int, results []Result[C], suspended map[C]struct{}, o options[C]) BeatGraph[C] {
+	n := len(choices)
+	g := BeatGraph[C]{
+		Nodes: make([]BeatGraphNode[C], n),
+		Links: make([]BeatGraphLink, 0),
+		Paths: make([]BeatPath, 0),
+	}
+	for position, r := range results {
+		g.Nodes[r.Index] = BeatGraphNode[C]{
+			Index:    r.Index,
+			Choice:   r.Choice,
+			Position: position + 1,
+			Wins:     r.Wins,
+		}
+	}
+	if n == 0 {
+		return g
+	}
+
+	links := preferences
+	if o.strengthVariant == StrengthMargins {
+		links = marginsPreferences(preferences, n)
+	}
+	links = initialStrengths(links, n)
+	for c := range suspended {
+		i := int(getChoiceIndex(choices, c))
+		for j := 0; j < n; j++ {
+			links[i*n+j] = 0
+			links[j*n+i] = 0
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			l := links[i*n+j]
+			if i == j || l <= 0 {
+				continue
+			}
+			g.Links = append(g.Links, BeatGraphLink{
+				Source:          i,
+				Target:          j,
+				Preferences:     preferences[i*n+j],
+				Opposition:      preferences[j*n+i],
+				Strength:        l,
+				OnStrongestPath: onStrongestPath(n, strengths, i, j, l),
+			})
+		}
+	}
+
+	for s := 0; s < n; s++ {
+		for t := 0; t < n; t++ {
+			if s == t || strengths[s*n+t] <= 0 {
+				continue
+			}
+			g.Paths = append(g.Paths, BeatPath{
+				Source:   s,
+				Target:   t,
+				Strength: strengths[s*n+t],
+				Nodes:    strongestPath(n, links, s, t, strengths[s*n+t]),
+			})
+		}
+	}
+	return g
+}
+
+// onStrongestPath returns true if the link from i to j with the strength l is
+// a part of a strongest path between any two choices.
+func onStrongestPath(n int, strengths []int, i, j, l int) bool {
+	for s := 0; s < n; s++ {
+		for t := 0; t < n; t++ {
+			p := strengths[s*n+t]
+			if s == t || p <= 0 || l < p {
+				continue
+			}
+			// the path from a choice to itself has no weak links
+			if s != i && strengths[s*n+i] < p {
+				continue
+			}
+			if j != t && strengths[j*n+t] < p {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// strongestPath returns the path with the smallest number of links from s to
+// t where all links have at least the strength of the strongest path.
+func strongestPath(n int, links []int, s, t, strength int) []int {
+	previous := make([]int, n)
+	for i := range previous {
+		previous[i] = -1
+	}
+	previous[s] = s
+	queue := []int{s}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		if i == t {
+			break
+		}
+		for j := 0; j < n; j++ {
+			if previous[j] >= 0 || links[i*n+j] < strength {
+				continue
+			}
+			previous[j] = i
+			queue = append(queue, j)
+		}
+	}
+	var path []int
+	for i := t; i != s; i = previous[i] {
+		path = append(path, i)
+	}
+	path = append(path, s)
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
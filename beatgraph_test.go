@@ -0,0 +1,114 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestNewBeatGraph(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	preferences, err := schulze.ImportPairwise([][]int{
+		{0, 6, 2, 1},
+		{3, 0, 5, 0},
+		{7, 4, 0, 8},
+		{0, 0, 0, 0},
+	}, choices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := schulze.NewBeatGraph(preferences, choices)
+
+	wantNodes := []schulze.BeatGraphNode[string]{
+		{Index: 0, Choice: "A", Position: 2, Wins: 2},
+		{Index: 1, Choice: "B", Position: 3, Wins: 1},
+		{Index: 2, Choice: "C", Position: 1, Wins: 3},
+		{Index: 3, Choice: "D", Position: 4, Wins: 0},
+	}
+	if !reflect.DeepEqual(g.Nodes, wantNodes) {
+		t.Errorf("got nodes %+v, want %+v", g.Nodes, wantNodes)
+	}
+
+	wantLinks := []schulze.BeatGraphLink{
+		{Source: 0, Target: 1, Preferences: 6, Opposition: 3, Strength: 6, OnStrongestPath: true},
+		// the path through B and C is stronger
+		{Source: 0, Target: 3, Preferences: 1, Opposition: 0, Strength: 1, OnStrongestPath: false},
+		{Source: 1, Target: 2, Preferences: 5, Opposition: 4, Strength: 5, OnStrongestPath: true},
+		{Source: 2, Target: 0, Preferences: 7, Opposition: 2, Strength: 7, OnStrongestPath: true},
+		{Source: 2, Target: 3, Preferences: 8, Opposition: 0, Strength: 8, OnStrongestPath: true},
+	}
+	if !reflect.DeepEqual(g.Links, wantLinks) {
+		t.Errorf("got links %+v, want %+v", g.Links, wantLinks)
+	}
+
+	paths := make(map[[2]int]schulze.BeatPath)
+	for _, p := range g.Paths {
+		paths[[2]int{p.Source, p.Target}] = p
+	}
+	if len(paths) != 9 {
+		t.Errorf("got %v paths, want %v", len(paths), 9)
+	}
+	for _, want := range []schulze.BeatPath{
+		{Source: 0, Target: 3, Strength: 5, Nodes: []int{0, 1, 2, 3}},
+		{Source: 2, Target: 1, Strength: 6, Nodes: []int{2, 0, 1}},
+		{Source: 2, Target: 3, Strength: 8, Nodes: []int{2, 3}},
+	} {
+		if got := paths[[2]int{want.Source, want.Target}]; !reflect.DeepEqual(got, want) {
+			t.Errorf("got path %+v, want %+v", got, want)
+		}
+	}
+	if _, ok := paths[[2]int{3, 0}]; ok {
+		t.Error("got path from the choice without links")
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"nodes":`, `"links":`, `"source":0`, `"onStrongestPath":false`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("json %s does not contain %s", data, want)
+		}
+	}
+}
+
+func TestVoting_BeatGraph(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	v := schulze.NewVoting(choices, schulze.WithStrengthVariant[string](schulze.StrengthMargins))
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"A": 1, "B": 2},
+		{"B": 1},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := v.SuspendChoice("B"); err != nil {
+		t.Fatal(err)
+	}
+
+	g := v.BeatGraph()
+
+	wantLinks := []schulze.BeatGraphLink{
+		{Source: 0, Target: 2, Preferences: 2, Opposition: 0, Strength: 2, OnStrongestPath: true},
+	}
+	if !reflect.DeepEqual(g.Links, wantLinks) {
+		t.Errorf("got links %+v, want %+v", g.Links, wantLinks)
+	}
+	wantPaths := []schulze.BeatPath{
+		{Source: 0, Target: 2, Strength: 2, Nodes: []int{0, 2}},
+	}
+	if !reflect.DeepEqual(g.Paths, wantPaths) {
+		t.Errorf("got paths %+v, want %+v", g.Paths, wantPaths)
+	}
+}
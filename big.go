@@ -0,0 +1,175 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"math/big"
+	"sort"
+)
+
+// BigVoting holds arbitrary-precision pairwise preferences of weighted
+// ballots, for use cases such as token-weighted governance where vote weights
+// are 256-bit quantities that would overflow the int preferences of the
+// Voting type. Methods on the BigVoting type are not safe for concurrent
+// calls.
+type BigVoting[C comparable] struct {
+	choices     []C
+	preferences []*big.Int
+}
+
+// BigResult represents a total number of wins for a single choice, with
+// arbitrary-precision strength and advantage.
+type BigResult[C comparable] struct {
+	// The choice value.
+	Choice C
+	// 0-based ordinal number of the choice in the choice slice.
+	Index int
+	// Number of wins in pairwise comparisons to other choices votings.
+	Wins int
+	// Total strength of the wins.
+	Strength *big.Int
+	// Total difference between the strengths of the wins and strengths of
+	// the opposite directions.
+	Advantage *big.Int
+}
+
+// NewBigVoting initializes a new arbitrary-precision voting state for the
+// provided choices.
+func NewBigVoting[C comparable](choices []C) *BigVoting[C] {
+	preferences := make([]*big.Int, len(choices)*len(choices))
+	for i := range preferences {
+		preferences[i] = new(big.Int)
+	}
+	return &BigVoting[C]{
+		choices:     choices,
+		preferences: preferences,
+	}
+}
+
+// Vote adds the ballot to preferences with the weight. A record of a complete
+// and normalized preferences is returned that can be used to unvote with the
+// same weight. ErrInvalidWeight is returned if the weight is not positive.
+func (v *BigVoting[C]) Vote(b Ballot[C], weight *big.Int) (Record[C], error) {
+	if weight == nil || weight.Sign() <= 0 {
+		return nil, ErrInvalidWeight
+	}
+	return voteFunc(v.choices, b, func(index int) {
+		v.preferences[index].Add(v.preferences[index], weight)
+	})
+}
+
+// Unvote removes the ballot Record from preferences with the weight that it
+// was voted with. ErrInvalidWeight is returned if the weight is not positive.
+func (v *BigVoting[C]) Unvote(r Record[C], weight *big.Int) error {
+	if weight == nil || weight.Sign() <= 0 {
+		return ErrInvalidWeight
+	}
+	unvoteFunc(v.choices, r, func(index int) {
+		v.preferences[index].Sub(v.preferences[index], weight)
+	})
+	return nil
+}
+
+// Preferences returns a copy of the pairwise preferences matrix with the same
+// layout as the preferences of the Vote function.
+func (v *BigVoting[C]) Preferences() []*big.Int {
+	p := make([]*big.Int, len(v.preferences))
+	for i, x := range v.preferences {
+		p[i] = new(big.Int).Set(x)
+	}
+	return p
+}
+
+// Compute calculates a sorted list of choices with the total number of wins
+// for each of them, just as the Voting Compute method does with the winning
+// votes strengths. If there are multiple winners, tie boolean parameter is
+// true.
+func (v *BigVoting[C]) Compute() (results []BigResult[C], tie bool) {
+	choicesCount := len(v.choices)
+	strengths := bigPathStrengths(v.preferences, choicesCount)
+
+	results = make([]BigResult[C], 0, choicesCount)
+	for i := 0; i < choicesCount; i++ {
+		r := BigResult[C]{
+			Choice:    v.choices[i],
+			Index:     i,
+			Strength:  new(big.Int),
+			Advantage: new(big.Int),
+		}
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			sij := strengths[i*choicesCount+j]
+			sji := strengths[j*choicesCount+i]
+			if sij.Cmp(sji) > 0 {
+				r.Wins++
+				r.Strength.Add(r.Strength, sij)
+				r.Advantage.Add(r.Advantage, sij)
+				r.Advantage.Sub(r.Advantage, sji)
+			}
+		}
+		results = append(results, r)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Wins != results[j].Wins {
+			return results[i].Wins > results[j].Wins
+		}
+		if c := results[i].Strength.Cmp(results[j].Strength); c != 0 {
+			return c > 0
+		}
+		return results[i].Index < results[j].Index
+	})
+
+	if len(results) >= 2 {
+		tie = results[0].Wins == results[1].Wins
+	}
+	return results, tie
+}
+
+// bigPathStrengths calculates the strongest paths strengths with the
+// Floyd–Warshall algorithm. Values are never modified in place, so the
+// strengths share the big.Int values with each other and with the
+// preferences.
+func bigPathStrengths(preferences []*big.Int, choicesCount int) []*big.Int {
+	zero := new(big.Int)
+	strengths := make([]*big.Int, choicesCount*choicesCount)
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			ij := i*choicesCount + j
+			if i != j && preferences[ij].Cmp(preferences[j*choicesCount+i]) > 0 {
+				strengths[ij] = preferences[ij]
+			} else {
+				strengths[ij] = zero
+			}
+		}
+	}
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			ji := strengths[j*choicesCount+i]
+			if ji.Sign() == 0 {
+				continue
+			}
+			for k := 0; k < choicesCount; k++ {
+				if i == k || j == k {
+					continue
+				}
+				s := strengths[i*choicesCount+k]
+				if ji.Cmp(s) < 0 {
+					s = ji
+				}
+				if s.Cmp(strengths[j*choicesCount+k]) > 0 {
+					strengths[j*choicesCount+k] = s
+				}
+			}
+		}
+	}
+	return strengths
+}
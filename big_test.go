@@ -0,0 +1,104 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestBigVoting(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for n := 0; n < 50; n++ {
+		choices := []string{"A", "B", "C", "D", "E"}[:r.Intn(5)+1]
+
+		v := schulze.NewVoting(choices)
+		bv := schulze.NewBigVoting(choices)
+
+		for i := r.Intn(20); i >= 0; i-- {
+			b := make(schulze.Ballot[string])
+			for _, c := range choices {
+				if r.Intn(3) > 0 {
+					b[c] = r.Intn(len(choices)) + 1
+				}
+			}
+			weight := r.Intn(5) + 1
+			for j := 0; j < weight; j++ {
+				if _, err := v.Vote(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+			record, err := bv.Vote(b, big.NewInt(int64(weight)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if i%4 == 0 {
+				for j := 0; j < weight; j++ {
+					if err := v.Unvote(record); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := bv.Unvote(record, big.NewInt(int64(weight))); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		for i, p := range bv.Preferences() {
+			if want := v.Preferences()[i]; p.Int64() != int64(want) {
+				t.Fatalf("got preference %v at %v, want %v", p, i, want)
+			}
+		}
+
+		want, _, wantTie := v.Compute()
+		got, tie := bv.Compute()
+		if tie != wantTie {
+			t.Errorf("got tie %v, want %v", tie, wantTie)
+		}
+		for i, r := range got {
+			w := want[i]
+			if r.Choice != w.Choice || r.Index != w.Index || r.Wins != w.Wins || r.Strength.Int64() != int64(w.Strength) || r.Advantage.Int64() != int64(w.Advantage) {
+				t.Errorf("got result %v %+v, want %+v", i, r, w)
+			}
+		}
+	}
+}
+
+func TestBigVoting_largeWeights(t *testing.T) {
+	v := schulze.NewBigVoting([]string{"A", "B"})
+
+	weight := new(big.Int).Lsh(big.NewInt(1), 255)
+	if _, err := v.Vote(schulze.Ballot[string]{"A": 1}, weight); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1}, new(big.Int).Add(weight, big.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+
+	results, tie := v.Compute()
+	if tie {
+		t.Fatal("got tie")
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "B")
+	}
+	// winning votes strength of the defeated direction is zero
+	want := new(big.Int).Add(weight, big.NewInt(1))
+	if results[0].Strength.Cmp(want) != 0 || results[0].Advantage.Cmp(want) != 0 {
+		t.Errorf("got strength %v and advantage %v, want %v", results[0].Strength, results[0].Advantage, want)
+	}
+
+	for _, weight := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+		if _, err := v.Vote(schulze.Ballot[string]{"A": 1}, weight); !errors.Is(err, schulze.ErrInvalidWeight) {
+			t.Errorf("got error %v, want %v", err, schulze.ErrInvalidWeight)
+		}
+	}
+}
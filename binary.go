@@ -0,0 +1,133 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+)
+
+// votingStateVersion is the version of the binary encoding of the voting
+// state.
+const votingStateVersion = 1
+
+// MarshalPreferences encodes the choices and the preferences in a compact
+// binary format, so that the state of a long-running voting can be persisted
+// and restored with UnmarshalPreferences without keeping every ballot. The
+// encoding starts with the version byte, followed by the JSON encoded
+// choices and the preferences matrix. ErrPreferencesLengthMismatch is
+// returned if the length of the preferences does not match the number of
+// choices.
+func MarshalPreferences[C comparable](preferences []int, choices []C) ([]byte, error) {
+	choicesCount := len(choices)
+	if len(preferences) != choicesCount*choicesCount {
+		return nil, fmt.Errorf("%w: got length %v for %v choices", ErrPreferencesLengthMismatch, len(preferences), choicesCount)
+	}
+	encodedChoices, err := json.Marshal(choices)
+	if err != nil {
+		return nil, fmt.Errorf("encode choices: %w", err)
+	}
+	data := make([]byte, 0, 1+2*binary.MaxVarintLen64+len(encodedChoices)+len(preferences)*2)
+	data = append(data, votingStateVersion)
+	data = binary.AppendUvarint(data, uint64(choicesCount))
+	data = binary.AppendUvarint(data, uint64(len(encodedChoices)))
+	data = append(data, encodedChoices...)
+	for _, p := range preferences {
+		data = binary.AppendVarint(data, int64(p))
+	}
+	return data, nil
+}
+
+// UnmarshalPreferences decodes the preferences and the choices encoded by
+// MarshalPreferences. ErrInvalidVotingState is returned if the data is not
+// valid.
+func UnmarshalPreferences[C comparable](data []byte) (preferences []int, choices []C, err error) {
+	if len(data) == 0 || data[0] != votingStateVersion {
+		return nil, nil, fmt.Errorf("%w: unsupported version", ErrInvalidVotingState)
+	}
+	data = data[1:]
+
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, fmt.Errorf("%w: malformed data", ErrInvalidVotingState)
+		}
+		data = data[n:]
+		return v, nil
+	}
+
+	choicesCount, err := readUvarint()
+	if err != nil {
+		return nil, nil, err
+	}
+	choicesLength, err := readUvarint()
+	if err != nil {
+		return nil, nil, err
+	}
+	if choicesLength > uint64(len(data)) {
+		return nil, nil, fmt.Errorf("%w: malformed data", ErrInvalidVotingState)
+	}
+	if err := json.Unmarshal(data[:choicesLength], &choices); err != nil {
+		return nil, nil, fmt.Errorf("%w: decode choices: %v", ErrInvalidVotingState, err)
+	}
+	data = data[choicesLength:]
+	// every preference is encoded with at least one byte
+	if uint64(len(choices)) != choicesCount || choicesCount > uint64(len(data)) || choicesCount*choicesCount > uint64(len(data)) {
+		return nil, nil, fmt.Errorf("%w: malformed data", ErrInvalidVotingState)
+	}
+
+	preferences = NewPreferences(int(choicesCount))
+	for i := range preferences {
+		v, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, nil, fmt.Errorf("%w: malformed data", ErrInvalidVotingState)
+		}
+		data = data[n:]
+		p, ok := intFromInt64(v)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: preference does not fit in int", ErrInvalidVotingState)
+		}
+		preferences[i] = p
+	}
+	if len(data) != 0 {
+		return nil, nil, fmt.Errorf("%w: unexpected trailing data", ErrInvalidVotingState)
+	}
+	return preferences, choices, nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, encoding
+// the choices and the preferences of the voting just as the
+// MarshalPreferences function does. Options and suspended, withdrawn and
+// disqualified choices are not encoded.
+func (v *Voting[C]) MarshalBinary() ([]byte, error) {
+	return MarshalPreferences(v.preferences, v.choices)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// replacing the choices and the preferences of the voting with the decoded
+// ones. ErrInvalidVotingState is returned if the data is not valid.
+func (v *Voting[C]) UnmarshalBinary(data []byte) error {
+	preferences, choices, err := UnmarshalPreferences[C](data)
+	if err != nil {
+		return err
+	}
+	v.restore(preferences, choices)
+	return nil
+}
+
+// restore replaces the choices and the preferences of the voting with the
+// decoded ones, keeping the choices capacity.
+func (v *Voting[C]) restore(preferences []int, choices []C) {
+	if v.options.choicesCapacity > len(choices) {
+		p := newPreferencesWithCapacity(len(choices), v.options.choicesCapacity)
+		copy(p, preferences)
+		preferences = p
+	}
+	v.invalidate()
+	v.preferences = preferences
+	v.choices = choices
+}
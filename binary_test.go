@@ -0,0 +1,132 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_MarshalBinary(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	v := schulze.NewVoting(choices)
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"C": 1, "A": 2, "B": 2},
+		{"D": 1},
+		{"B": 1, "A": 2},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var restored schulze.Voting[string]
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.StateHash(), v.StateHash()) {
+		t.Error("got different state hash")
+	}
+	if got, want := restored.Preferences(), v.Preferences(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+	gotResults, _, gotTie := restored.Compute()
+	wantResults, _, wantTie := v.Compute()
+	if !reflect.DeepEqual(gotResults, wantResults) || gotTie != wantTie {
+		t.Errorf("got results %v, tie %v, want %v, tie %v", gotResults, gotTie, wantResults, wantTie)
+	}
+
+	// the restored voting continues to accept ballots
+	if _, err := restored.Vote(schulze.Ballot[string]{"D": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"D": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.StateHash(), v.StateHash()) {
+		t.Error("restored voting diverged")
+	}
+}
+
+func TestMarshalPreferences(t *testing.T) {
+	choices := []int{10, 20}
+	preferences := []int{3, 2, 0, 1}
+
+	data, err := schulze.MarshalPreferences(preferences, choices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotPreferences, gotChoices, err := schulze.UnmarshalPreferences[int](data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotPreferences, preferences) || !reflect.DeepEqual(gotChoices, choices) {
+		t.Errorf("got preferences %v and choices %v, want %v and %v", gotPreferences, gotChoices, preferences, choices)
+	}
+
+	if _, err := schulze.MarshalPreferences(preferences[:3], choices); !errors.Is(err, schulze.ErrPreferencesLengthMismatch) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrPreferencesLengthMismatch)
+	}
+}
+
+func TestUnmarshalPreferences_invalid(t *testing.T) {
+	data, err := schulze.MarshalPreferences(schulze.NewPreferences(3), []string{"A", "B", "C"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "version", data: append([]byte{99}, data[1:]...)},
+		{name: "truncated", data: data[:len(data)-1]},
+		{name: "trailing", data: append(append([]byte{}, data...), 0)},
+		{name: "choices", data: []byte{1, 1, 3, '[', '1', ']'}},
+		{name: "choices count", data: []byte{1, 2, 5, '[', '"', 'A', '"', ']', 0, 0, 0, 0}},
+		{name: "huge count", data: []byte{1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 2, '[', ']'}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var v schulze.Voting[string]
+			if err := v.UnmarshalBinary(tc.data); !errors.Is(err, schulze.ErrInvalidVotingState) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidVotingState)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPreferences_platformInt(t *testing.T) {
+	// a preference that fits only in 64-bit int
+	const preference = math.MaxInt32 + 1
+	data := []byte{1, 1, 5, '[', '"', 'A', '"', ']'}
+	data = binary.AppendVarint(data, preference)
+
+	preferences, _, err := schulze.UnmarshalPreferences[string](data)
+	if strconv.IntSize == 32 {
+		if !errors.Is(err, schulze.ErrInvalidVotingState) {
+			t.Errorf("got error %v, want %v", err, schulze.ErrInvalidVotingState)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(preferences[0]) != preference {
+		t.Errorf("got preference %v, want %v", preferences[0], int64(preference))
+	}
+}
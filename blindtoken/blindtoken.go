@@ -0,0 +1,147 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package blindtoken issues anonymous one-time voting tokens with RSA blind
+// signatures, decoupling the eligibility check of a voter from the content of
+// the ballot.
+//
+// A voter generates a random token with NewToken and blinds it with Blind.
+// The election authority checks the eligibility of the voter and signs the
+// blinded token with the Issuer, without learning the token. The voter
+// unblinds the signature with Unblind and votes with the token and the
+// signature, which are verified by the Verifier, without revealing who the
+// voter is.
+//
+// Signatures are textbook RSA signatures over a full domain hash of the token
+// and the RSA key must be used only for issuing voting tokens.
+package blindtoken
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+)
+
+// TokenSize is the size of tokens generated by NewToken.
+const TokenSize = 32
+
+var (
+	// ErrInvalidSignature is returned when the token signature is not valid.
+	ErrInvalidSignature = errors.New("blindtoken: invalid signature")
+	// ErrInvalidBlindedToken is returned when the blinded token is not
+	// valid for the key.
+	ErrInvalidBlindedToken = errors.New("blindtoken: invalid blinded token")
+)
+
+// NewToken returns a new random token.
+func NewToken() ([]byte, error) {
+	token := make([]byte, TokenSize)
+	if _, err := io.ReadFull(rand.Reader, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// Unblinder holds the secret blinding factor required to unblind the signature
+// of the blinded token. It must be kept by the voter.
+type Unblinder struct {
+	inverse *big.Int
+}
+
+// Blind blinds the token for the public key of the issuer, returning the
+// blinded token to be signed and the Unblinder for its signature.
+func Blind(pub *rsa.PublicKey, token []byte) (blinded []byte, u *Unblinder, err error) {
+	e := big.NewInt(int64(pub.E))
+	for {
+		r, err := rand.Int(rand.Reader, pub.N)
+		if err != nil {
+			return nil, nil, err
+		}
+		inverse := new(big.Int).ModInverse(r, pub.N)
+		if r.Sign() == 0 || inverse == nil {
+			continue
+		}
+		m := fullDomainHash(pub, token)
+		m.Mul(m, new(big.Int).Exp(r, e, pub.N))
+		m.Mod(m, pub.N)
+		return m.FillBytes(make([]byte, pub.Size())), &Unblinder{inverse: inverse}, nil
+	}
+}
+
+// Unblind returns the signature of the token from the signature of the
+// blinded token.
+func (u *Unblinder) Unblind(pub *rsa.PublicKey, blindSignature []byte) []byte {
+	s := new(big.Int).SetBytes(blindSignature)
+	s.Mul(s, u.inverse)
+	s.Mod(s, pub.N)
+	return s.FillBytes(make([]byte, pub.Size()))
+}
+
+// Issuer signs blinded tokens of eligible voters.
+type Issuer struct {
+	key *rsa.PrivateKey
+}
+
+// NewIssuer returns a new Issuer that signs with the private key.
+func NewIssuer(key *rsa.PrivateKey) *Issuer {
+	return &Issuer{key: key}
+}
+
+// Sign signs the blinded token. It is the responsibility of the caller to
+// check the eligibility of the voter and to sign only one token per voter.
+func (i *Issuer) Sign(blinded []byte) ([]byte, error) {
+	m := new(big.Int).SetBytes(blinded)
+	if m.Sign() == 0 || m.Cmp(i.key.N) >= 0 {
+		return nil, ErrInvalidBlindedToken
+	}
+	s := new(big.Int).Exp(m, i.key.D, i.key.N)
+	return s.FillBytes(make([]byte, i.key.Size())), nil
+}
+
+// Verifier verifies unblinded token signatures. It implements the
+// schulze.TokenVerifier interface.
+type Verifier struct {
+	key *rsa.PublicKey
+}
+
+// NewVerifier returns a new Verifier for the public key of the issuer.
+func NewVerifier(pub *rsa.PublicKey) *Verifier {
+	return &Verifier{key: pub}
+}
+
+// VerifyToken returns ErrInvalidSignature if the signature of the token is not
+// valid.
+func (v *Verifier) VerifyToken(token, signature []byte) error {
+	s := new(big.Int).SetBytes(signature)
+	if s.Cmp(v.key.N) >= 0 {
+		return ErrInvalidSignature
+	}
+	s.Exp(s, big.NewInt(int64(v.key.E)), v.key.N)
+	if s.Cmp(fullDomainHash(v.key, token)) != 0 {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// fullDomainHash hashes the token to a number in the range of the modulus of
+// the key by concatenating SHA-256 hashes of the token with a counter.
+func fullDomainHash(pub *rsa.PublicKey, token []byte) *big.Int {
+	size := pub.Size()
+	buf := make([]byte, 0, size+sha256.Size)
+	var counter [4]byte
+	for i := uint32(0); len(buf) < size; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		h := sha256.New()
+		_, _ = h.Write(counter[:])
+		_, _ = h.Write(token)
+		buf = h.Sum(buf)
+	}
+	m := new(big.Int).SetBytes(buf[:size])
+	return m.Mod(m, pub.N)
+}
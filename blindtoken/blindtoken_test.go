@@ -0,0 +1,63 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blindtoken_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+
+	"resenje.org/schulze/blindtoken"
+)
+
+func TestBlindToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := blindtoken.NewIssuer(key)
+	verifier := blindtoken.NewVerifier(&key.PublicKey)
+
+	token, err := blindtoken.NewToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blinded, unblinder, err := blindtoken.Blind(&key.PublicKey, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(blinded, token) {
+		t.Error("blinded token contains the token")
+	}
+
+	blindSignature, err := issuer.Sign(blinded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := unblinder.Unblind(&key.PublicKey, blindSignature)
+
+	if err := verifier.VerifyToken(token, signature); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifier.VerifyToken(token, blindSignature); !errors.Is(err, blindtoken.ErrInvalidSignature) {
+		t.Errorf("got error %v for blind signature, want %v", err, blindtoken.ErrInvalidSignature)
+	}
+
+	other, err := blindtoken.NewToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifier.VerifyToken(other, signature); !errors.Is(err, blindtoken.ErrInvalidSignature) {
+		t.Errorf("got error %v for other token, want %v", err, blindtoken.ErrInvalidSignature)
+	}
+
+	if _, err := issuer.Sign(key.N.Bytes()); !errors.Is(err, blindtoken.ErrInvalidBlindedToken) {
+		t.Errorf("got error %v, want %v", err, blindtoken.ErrInvalidBlindedToken)
+	}
+}
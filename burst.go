@@ -0,0 +1,164 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// BurstAnalysis configures the detection of bursts of identical or
+// near-identical ballots by the Election DetectBursts method.
+type BurstAnalysis struct {
+	// Maximal time between consecutive ballots of a burst.
+	Window time.Duration
+	// Minimal number of ballots in a burst to be reported.
+	MinBallots int
+	// Maximal number of pairs of choices that are ordered differently in a
+	// ballot than in the first ballot of the burst for the ballot to be
+	// considered near-identical. Zero reports only identical ballots.
+	MaxDistance int
+}
+
+// BallotBurst is a group of identical or near-identical ballots cast in a
+// short period of time.
+type BallotBurst[V, C comparable] struct {
+	// Record of the first ballot of the burst.
+	Record Record[C]
+	// Voters of ballots in the burst in the order of voting.
+	Voters []V
+	// Times of the first and the last ballot of the burst.
+	Start time.Time
+	End   time.Time
+}
+
+// DetectBursts returns bursts of identical or near-identical ballots where
+// every ballot is cast within the analysis window after the previous one, as
+// they may be cast by automated or coordinated voting. The analysis is only
+// advisory to assist the review and no ballots are changed. Only the last
+// ballot of every voter is analyzed, ordered by the time of voting, and bursts
+// are returned in the order of their start times.
+func (e *Election[V, C]) DetectBursts(a BurstAnalysis) []BallotBurst[V, C] {
+	type ballot struct {
+		voter V
+		time  time.Time
+		ranks map[C]int
+	}
+	ballots := make([]ballot, 0, len(e.voted))
+	for voter, t := range e.voted {
+		ballots = append(ballots, ballot{
+			voter: voter,
+			time:  t,
+			ranks: recordRanks(e.records[voter]),
+		})
+	}
+	sort.Slice(ballots, func(i, j int) bool {
+		if !ballots[i].time.Equal(ballots[j].time) {
+			return ballots[i].time.Before(ballots[j].time)
+		}
+		return fmt.Sprint(ballots[i].voter) < fmt.Sprint(ballots[j].voter)
+	})
+
+	type burst struct {
+		BallotBurst[V, C]
+		ranks map[C]int
+	}
+	var bursts, active []*burst
+	for _, b := range ballots {
+		// keep only bursts that can be extended at the time of the ballot
+		n := 0
+		for _, x := range active {
+			if b.time.Sub(x.End) <= a.Window {
+				active[n] = x
+				n++
+			}
+		}
+		active = active[:n]
+
+		var found *burst
+		for _, x := range active {
+			if ranksDistance(x.ranks, b.ranks) <= a.MaxDistance {
+				found = x
+				break
+			}
+		}
+		if found == nil {
+			found = &burst{
+				BallotBurst: BallotBurst[V, C]{
+					Record: copyRecord(e.records[b.voter]),
+					Start:  b.time,
+				},
+				ranks: b.ranks,
+			}
+			bursts = append(bursts, found)
+			active = append(active, found)
+		}
+		found.Voters = append(found.Voters, b.voter)
+		found.End = b.time
+	}
+
+	var result []BallotBurst[V, C]
+	for _, b := range bursts {
+		if len(b.Voters) < a.MinBallots || len(b.Voters) < 2 {
+			continue
+		}
+		result = append(result, b.BallotBurst)
+	}
+	return result
+}
+
+// recordRanks returns 0-based ranks of choices in the record, where unranked
+// choices have the rank of the last group.
+func recordRanks[C comparable](r Record[C]) map[C]int {
+	ranks := make(map[C]int)
+	for rank, group := range r {
+		for _, c := range group {
+			ranks[c] = rank
+		}
+	}
+	return ranks
+}
+
+// ranksDistance returns the number of pairs of choices that are ordered
+// differently by the ranks. Choices that are not in one of ranks are
+// considered unranked in it.
+func ranksDistance[C comparable](a, b map[C]int) int {
+	choices := make([]C, 0, len(a))
+	for c := range a {
+		choices = append(choices, c)
+	}
+	for c := range b {
+		if _, ok := a[c]; !ok {
+			choices = append(choices, c)
+		}
+	}
+	rank := func(ranks map[C]int, c C) int {
+		if r, ok := ranks[c]; ok {
+			return r
+		}
+		// after all ranked choices
+		return len(ranks) + 1
+	}
+	sign := func(x int) int {
+		switch {
+		case x < 0:
+			return -1
+		case x > 0:
+			return 1
+		}
+		return 0
+	}
+	var distance int
+	for i, c1 := range choices {
+		for _, c2 := range choices[i+1:] {
+			if sign(rank(a, c1)-rank(a, c2)) != sign(rank(b, c1)-rank(b, c2)) {
+				distance++
+			}
+		}
+	}
+	return distance
+}
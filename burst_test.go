@@ -0,0 +1,98 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_DetectBursts(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C", "D"})
+
+	start := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	e.SetNow(func() time.Time { return now })
+
+	for _, v := range []struct {
+		voter  string
+		ballot schulze.Ballot[string]
+		after  time.Duration
+	}{
+		{voter: "v1", ballot: schulze.Ballot[string]{"A": 1, "B": 2}},
+		{voter: "v2", ballot: schulze.Ballot[string]{"A": 1, "B": 2}, after: time.Second},
+		// near-identical, B and C swapped, ordering differently the pairs
+		// of B and C, B and D, and C and D
+		{voter: "v3", ballot: schulze.Ballot[string]{"A": 1, "C": 2}, after: time.Second},
+		{voter: "v4", ballot: schulze.Ballot[string]{"D": 1}, after: time.Second},
+		{voter: "v5", ballot: schulze.Ballot[string]{"A": 1, "B": 2}, after: time.Second},
+		// too late to be a part of the burst
+		{voter: "v6", ballot: schulze.Ballot[string]{"A": 1, "B": 2}, after: time.Minute},
+		{voter: "v7", ballot: schulze.Ballot[string]{"A": 1, "B": 2}, after: time.Second},
+	} {
+		now = now.Add(v.after)
+		if _, err := e.Vote(v.voter, v.ballot); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type burst struct {
+		voters     []string
+		start, end time.Duration
+	}
+	for _, tc := range []struct {
+		name     string
+		analysis schulze.BurstAnalysis
+		want     []burst
+	}{
+		{
+			name:     "identical",
+			analysis: schulze.BurstAnalysis{Window: 3 * time.Second, MinBallots: 2},
+			want: []burst{
+				{voters: []string{"v1", "v2", "v5"}, start: 0, end: 4 * time.Second},
+				{voters: []string{"v6", "v7"}, start: 64 * time.Second, end: 65 * time.Second},
+			},
+		},
+		{
+			name:     "near-identical",
+			analysis: schulze.BurstAnalysis{Window: 3 * time.Second, MinBallots: 3, MaxDistance: 3},
+			want: []burst{
+				{voters: []string{"v1", "v2", "v3", "v5"}, start: 0, end: 4 * time.Second},
+			},
+		},
+		{
+			name:     "short window",
+			analysis: schulze.BurstAnalysis{Window: time.Second, MinBallots: 2},
+			want: []burst{
+				{voters: []string{"v1", "v2"}, start: 0, end: time.Second},
+				{voters: []string{"v6", "v7"}, start: 64 * time.Second, end: 65 * time.Second},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bursts := e.DetectBursts(tc.analysis)
+			var got []burst
+			for _, b := range bursts {
+				got = append(got, burst{voters: b.Voters, start: b.Start.Sub(start), end: b.End.Sub(start)})
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got bursts %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+
+	// unvoted ballots are not analyzed
+	if err := e.Unvote("v2"); err != nil {
+		t.Fatal(err)
+	}
+	bursts := e.DetectBursts(schulze.BurstAnalysis{Window: 2 * time.Second, MinBallots: 3})
+	if len(bursts) != 0 {
+		t.Errorf("got bursts %+v, want none", bursts)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// WithChoicesCapacity pre-allocates the preferences matrix of the Voting for
+// up to the expected number of choices. When choices are appended to the end
+// of the current choices with the SetChoices method, the matrix is laid out
+// again in place without allocating and copying it, as long as the number of
+// choices does not exceed the capacity. This is useful for polls where
+// choices are added continuously. Other changes of choices, such as removals
+// or reordering, still use a temporary copy of the matrix, but the
+// pre-allocated memory is kept. Beyond the capacity, the matrix grows to
+// twice the number of choices, to amortize subsequent additions.
+func WithChoicesCapacity[C comparable](capacity int) Option[C] {
+	return func(o *options[C]) {
+		o.choicesCapacity = capacity
+	}
+}
+
+// newPreferencesWithCapacity returns the preferences matrix for the number
+// of choices, with the memory allocated for the capacity number of choices.
+func newPreferencesWithCapacity(choicesCount, capacity int) []int {
+	if capacity < choicesCount {
+		capacity = choicesCount
+	}
+	return make([]int, choicesCount*choicesCount, capacity*capacity)
+}
+
+// setChoicesWithCapacity updates the preferences just as the SetChoices
+// function does, reusing the memory of the preferences if the updated matrix
+// fits in it. If it does not fit and grow is true, the memory is allocated
+// for twice the number of updated choices.
+func setChoicesWithCapacity[C comparable](preferences []int, current, updated []C, grow bool) []int {
+	updatedLength := len(updated)
+	size := updatedLength * updatedLength
+	if size > cap(preferences) {
+		if !grow {
+			return SetChoices(preferences, current, updated)
+		}
+		grown := newPreferencesWithCapacity(updatedLength, 2*updatedLength)
+		copy(grown, SetChoices(preferences, current, updated))
+		return grown
+	}
+	if !isAppended(current, updated) {
+		s := SetChoices(preferences, current, updated)
+		preferences = preferences[:size]
+		copy(preferences, s)
+		return preferences
+	}
+
+	preferences = preferences[:size]
+	appendPreferences(preferences, preferences, len(current), updatedLength)
+	return preferences
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestWithChoicesCapacity(t *testing.T) {
+	seed := time.Now().UnixNano()
+	t.Logf("seed: %v", seed)
+	random := rand.New(rand.NewSource(seed))
+
+	all := newChoices(20)
+
+	preallocated := schulze.NewVoting(all[:2:2], schulze.WithChoicesCapacity[string](8))
+	v := schulze.NewVoting(all[:2:2])
+
+	vote := func(t *testing.T, choices []string) {
+		t.Helper()
+		b := make(schulze.Ballot[string])
+		for j, count := 0, random.Intn(len(choices)); j < count; j++ {
+			b[choices[random.Intn(len(choices))]] = random.Intn(3)
+		}
+		if _, err := preallocated.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for n := 2; n <= len(all); n++ {
+		choices := all[:n:n]
+		preallocated.SetChoices(choices)
+		v.SetChoices(choices)
+		for i := 0; i < 10; i++ {
+			vote(t, choices)
+		}
+		if got, want := preallocated.Preferences(), v.Preferences(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%v choices: got preferences %v, want %v", n, got, want)
+		}
+	}
+
+	// removal and reordering of choices
+	for _, choices := range [][]string{
+		{all[3], all[1], all[7], all[0]},
+		{all[7], all[3]},
+		{all[7], all[3], all[11], all[12]},
+	} {
+		preallocated.SetChoices(choices)
+		v.SetChoices(choices)
+		vote(t, choices)
+		if got, want := preallocated.Preferences(), v.Preferences(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("choices %v: got preferences %v, want %v", choices, got, want)
+		}
+	}
+
+	gotResults, _, gotTie := preallocated.Compute()
+	wantResults, _, wantTie := v.Compute()
+	if !reflect.DeepEqual(gotResults, wantResults) || gotTie != wantTie {
+		t.Errorf("got results %v, tie %v, want %v, tie %v", gotResults, gotTie, wantResults, wantTie)
+	}
+}
+
+func TestWithChoicesCapacity_allocations(t *testing.T) {
+	all := newChoices(10)
+	v := schulze.NewVoting(all[:1:1], schulze.WithChoicesCapacity[string](len(all)))
+
+	n := 1
+	allocs := testing.AllocsPerRun(5, func() {
+		n++
+		v.SetChoices(all[:n:n])
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocations, want 0", allocs)
+	}
+}
+
+func BenchmarkVoting_SetChoices_append(b *testing.B) {
+	all := newChoices(100)
+	for _, capacity := range []int{0, len(all)} {
+		name := "default"
+		if capacity > 0 {
+			name = "capacity"
+		}
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				v := schulze.NewVoting(all[:1:1], schulze.WithChoicesCapacity[string](capacity))
+				for n := 2; n <= len(all); n++ {
+					v.SetChoices(all[:n:n])
+				}
+			}
+		})
+	}
+}
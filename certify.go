@@ -0,0 +1,144 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Certificate is a signed artifact of the election outcome that can be
+// distributed with non-repudiation and verified with VerifyCertificate.
+type Certificate struct {
+	// JSON encoded CertificateContent.
+	Content json.RawMessage `json:"content"`
+	// Signature of the compacted Content JSON.
+	Signature []byte `json:"signature"`
+}
+
+// CertificateContent is the signed content of the Certificate.
+type CertificateContent[C comparable] struct {
+	Document ResultDocument[C] `json:"document"`
+	// Hex encoded state hash of the election, as returned by the StateHash
+	// method.
+	StateHash string            `json:"stateHash"`
+	Config    ElectionConfig[C] `json:"config"`
+	Stats     ElectionStats     `json:"stats"`
+	// Time when the certificate was issued.
+	IssuedAt time.Time `json:"issuedAt"`
+}
+
+// Certify issues the Certificate of the current election results, containing
+// the result document, state hash, configuration with the schedule, stats and
+// the time of the certification, signed by the signer. Ed25519, ECDSA and RSA
+// signers are supported. The election is checked with SelfCheck before it is
+// certified, returning the ConsistencyError if its state diverged from the
+// ballots. ErrChallengeWindowOpen is returned if the election has the
+// challenge window and it did not end, and ErrSealed if the results are
+// sealed. The EventCertified is delivered to the notifier of the election.
+func Certify[V, C comparable](e *Election[V, C], signer crypto.Signer) (*Certificate, error) {
+	if e.Phase() == PhaseChallenge {
+		return nil, ErrChallengeWindowOpen
+	}
+	if err := e.SelfCheck(); err != nil {
+		return nil, err
+	}
+	d, err := e.ResultDocument()
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(CertificateContent[C]{
+		Document:  d,
+		StateHash: hex.EncodeToString(e.StateHash()),
+		Config:    e.Config(),
+		Stats:     e.Stats(),
+		IssuedAt:  e.now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode certificate content: %w", err)
+	}
+	signature, err := sign(signer, content)
+	if err != nil {
+		return nil, err
+	}
+	c := &Certificate{
+		Content:   content,
+		Signature: signature,
+	}
+	e.notify(Event{Type: EventCertified, Time: e.now(), Message: "the election results are certified", Data: c})
+	return c, nil
+}
+
+// VerifyCertificate verifies the signature of the Certificate with the public
+// key of the signer and returns its decoded content. ErrInvalidCertificate is
+// returned if the signature is not valid and ErrUnsupportedKey if the public
+// key is not an Ed25519, ECDSA or RSA key.
+func VerifyCertificate[C comparable](c *Certificate, pub crypto.PublicKey) (*CertificateContent[C], error) {
+	var content bytes.Buffer
+	if err := json.Compact(&content, c.Content); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+	if err := verifySignature(pub, content.Bytes(), c.Signature); err != nil {
+		if err == errInvalidSignature {
+			err = ErrInvalidCertificate
+		}
+		return nil, err
+	}
+	var cc CertificateContent[C]
+	if err := json.Unmarshal(content.Bytes(), &cc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificate, err)
+	}
+	return &cc, nil
+}
+
+// errInvalidSignature is returned by verifySignature and it is replaced by
+// the error of the signed artifact.
+var errInvalidSignature = errors.New("invalid signature")
+
+// sign signs the content with the Ed25519 signer, or its SHA-256 digest with
+// ECDSA and RSA signers.
+func sign(signer crypto.Signer, content []byte) ([]byte, error) {
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		return signer.Sign(rand.Reader, content, crypto.Hash(0))
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		digest := sha256.Sum256(content)
+		return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, signer.Public())
+	}
+}
+
+// verifySignature verifies the signature created by the sign function.
+func verifySignature(pub crypto.PublicKey, content, signature []byte) error {
+	var valid bool
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(pub, content, signature)
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(content)
+		valid = ecdsa.VerifyASN1(pub, digest[:], signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(content)
+		valid = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature) == nil
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedKey, pub)
+	}
+	if !valid {
+		return errInvalidSignature
+	}
+	return nil
+}
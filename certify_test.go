@@ -0,0 +1,131 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestCertify(t *testing.T) {
+	closes := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:     "board",
+		Choices:  []string{"A", "B", "C"},
+		Schedule: schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.SetNow(func() time.Time { return closes.Add(-time.Hour) })
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"A": 1, "B": 2},
+		"bob":   {"B": 1},
+		"carol": {"A": 1},
+	} {
+		if _, err := e.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	issuedAt := closes.Add(time.Hour)
+	e.SetNow(func() time.Time { return issuedAt })
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantDocument, err := e.ResultDocument()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, signer := range map[string]crypto.Signer{
+		"ed25519": edKey,
+		"ecdsa":   ecKey,
+		"rsa":     rsaKey,
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := schulze.Certify(e, signer)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the certificate is verified after encoding with indentation
+			data, err := json.MarshalIndent(c, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded schulze.Certificate
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			content, err := schulze.VerifyCertificate[string](&decoded, signer.Public())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(content.Document, wantDocument) {
+				t.Errorf("got document %+v, want %+v", content.Document, wantDocument)
+			}
+			if content.StateHash != hex.EncodeToString(e.StateHash()) {
+				t.Errorf("got state hash %v", content.StateHash)
+			}
+			if content.Config.Name != "board" || !content.Config.Schedule.Closes.Equal(closes) {
+				t.Errorf("got config %+v", content.Config)
+			}
+			if content.Stats.Voters != 3 {
+				t.Errorf("got stats %+v", content.Stats)
+			}
+			if !content.IssuedAt.Equal(issuedAt) {
+				t.Errorf("got issued at %v, want %v", content.IssuedAt, issuedAt)
+			}
+
+			tampered := decoded
+			tampered.Content = bytes.Replace(decoded.Content, []byte(`"board"`), []byte(`"other"`), 1)
+			if _, err := schulze.VerifyCertificate[string](&tampered, signer.Public()); !errors.Is(err, schulze.ErrInvalidCertificate) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidCertificate)
+			}
+			if _, err := schulze.VerifyCertificate[string](&decoded, edKey.Public()); name != "ed25519" && !errors.Is(err, schulze.ErrInvalidCertificate) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidCertificate)
+			}
+		})
+	}
+
+	if _, err := schulze.VerifyCertificate[string](&schulze.Certificate{Content: []byte("{}")}, "key"); !errors.Is(err, schulze.ErrUnsupportedKey) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrUnsupportedKey)
+	}
+
+	// election state that diverges from the ballots is not certified
+	if _, err := e.Voting().Vote(schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+	var cerr *schulze.ConsistencyError
+	if _, err := schulze.Certify(e, edKey); !errors.As(err, &cerr) {
+		t.Errorf("got error %v, want ConsistencyError", err)
+	}
+}
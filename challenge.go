@@ -0,0 +1,220 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// ElectionPhase is the phase of the election by its schedule.
+type ElectionPhase int
+
+// Election phases.
+const (
+	// PhaseNotOpen is the phase before the election opens.
+	PhaseNotOpen ElectionPhase = iota
+	// PhaseOpen is the phase when votes are accepted.
+	PhaseOpen
+	// PhaseChallenge is the challenge window after the election is closed,
+	// when the results are provisional and provisional ballots are resolved.
+	PhaseChallenge
+	// PhaseFinal is the phase after the election is closed and the challenge
+	// window, if configured, ended, when the results are final.
+	PhaseFinal
+)
+
+// String returns the name of the phase.
+func (p ElectionPhase) String() string {
+	switch p {
+	case PhaseNotOpen:
+		return "not-open"
+	case PhaseOpen:
+		return "open"
+	case PhaseChallenge:
+		return "challenge"
+	case PhaseFinal:
+		return "final"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (p ElectionPhase) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// Phase returns the current phase of the election by its schedule.
+func (e *Election[V, C]) Phase() ElectionPhase {
+	now := e.now()
+	s := e.config.Schedule
+	switch {
+	case s.Opens != nil && now.Before(*s.Opens):
+		return PhaseNotOpen
+	case s.Closes == nil || now.Before(*s.Closes):
+		return PhaseOpen
+	case s.ChallengeEnds != nil && now.Before(*s.ChallengeEnds):
+		return PhaseChallenge
+	default:
+		return PhaseFinal
+	}
+}
+
+// VoteProvisional stores the voter's provisional ballot, such as a late
+// ballot or a ballot of a voter whose eligibility is disputed, that is not
+// tallied until it is accepted with AcceptProvisional. Provisional ballots
+// are accepted while the election is open and during the challenge window. A
+// previous provisional ballot of the voter is replaced.
+// ErrChallengeWindowClosed is returned after the challenge window ends.
+// Provisional ballots are not supported in elections that require voting
+// tokens or commitments.
+func (e *Election[V, C]) VoteProvisional(voter V, b Ballot[C]) error {
+	if e.tokenVerifier != nil {
+		return ErrTokenRequired
+	}
+	if e.config.CommitReveal {
+		return ErrCommitRevealRequired
+	}
+	if err := e.checkProvisional(); err != nil {
+		return err
+	}
+	if err := validateBallot(e.config.BallotPolicy, b); err != nil {
+		return err
+	}
+	if err := e.voting.checkSuspended(b); err != nil {
+		return err
+	}
+	if _, _, _, err := ballotRanks(e.voting.choices, b); err != nil {
+		return err
+	}
+	c := make(Ballot[C], len(b))
+	for choice, rank := range b {
+		c[choice] = rank
+	}
+	e.provisional[voter] = c
+	return nil
+}
+
+// AcceptProvisional tallies the voter's provisional ballot, replacing the
+// voter's previous ballot, if any. ErrNoProvisionalBallot is returned if the
+// voter has no provisional ballot and ErrChallengeWindowClosed if the
+// challenge window ended.
+func (e *Election[V, C]) AcceptProvisional(voter V) (Record[C], error) {
+	if err := e.checkProvisional(); err != nil {
+		return nil, err
+	}
+	b, ok := e.provisional[voter]
+	if !ok {
+		return nil, ErrNoProvisionalBallot
+	}
+	if e.Phase() == PhaseChallenge && e.closeTally == nil {
+		e.closeTally = e.voting.clone()
+		e.closeVoters = len(e.records)
+	}
+	r, err := e.vote(voter, b, nil)
+	if err != nil {
+		return nil, err
+	}
+	delete(e.provisional, voter)
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditAcceptProvisional,
+		BallotsCount: 1,
+	})
+	return r, nil
+}
+
+// RejectProvisional discards the voter's provisional ballot.
+// ErrNoProvisionalBallot is returned if the voter has no provisional ballot
+// and ErrChallengeWindowClosed if the challenge window ended.
+func (e *Election[V, C]) RejectProvisional(voter V, reason string) error {
+	if err := e.checkProvisional(); err != nil {
+		return err
+	}
+	if _, ok := e.provisional[voter]; !ok {
+		return ErrNoProvisionalBallot
+	}
+	delete(e.provisional, voter)
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditRejectProvisional,
+		Reason:       reason,
+		BallotsCount: 1,
+	})
+	return nil
+}
+
+// ProvisionalBallotsCount returns the number of provisional ballots that are
+// not resolved.
+func (e *Election[V, C]) ProvisionalBallotsCount() int {
+	return len(e.provisional)
+}
+
+// CloseResults returns both the provisional results of the ballots tallied
+// when the election closed and the results that also include the
+// provisional ballots accepted after the close, which are final once the
+// challenge window ends. ErrSealed is returned if the results of the election
+// are sealed.
+func (e *Election[V, C]) CloseResults() (provisional, final SegmentResult[C], err error) {
+	if err := e.checkSealed(); err != nil {
+		return provisional, final, err
+	}
+	final.Results, final.Duels, final.Tie = e.voting.Compute()
+	final.VotersCount = len(e.records)
+	if e.closeTally == nil {
+		provisional.Results, provisional.Duels, provisional.Tie = e.voting.Compute()
+		provisional.VotersCount = final.VotersCount
+		return provisional, final, nil
+	}
+	provisional.Results, provisional.Duels, provisional.Tie = e.closeTally.Compute()
+	provisional.VotersCount = e.closeVoters
+	return provisional, final, nil
+}
+
+// checkProvisional returns an error if provisional ballots are not accepted.
+func (e *Election[V, C]) checkProvisional() error {
+	phase := e.Phase()
+	if phase == PhaseNotOpen {
+		return ErrElectionNotOpen
+	}
+	e.admitNominations()
+	if phase == PhaseFinal {
+		if e.config.Schedule.ChallengeEnds == nil {
+			return ErrElectionClosed
+		}
+		return ErrChallengeWindowClosed
+	}
+	return nil
+}
+
+// clone returns a copy of the voting state without the cached computation.
+func (v *Voting[C]) clone() *Voting[C] {
+	return v.derive(append(make([]C, 0, len(v.choices)), v.choices...), ClonePreferences(v.preferences))
+}
+
+// derive returns a voting with the choices and preferences, and with the
+// options and the suspended, disqualified and withdrawn choices of the
+// voting, so that it is computed with the same exclusions.
+func (v *Voting[C]) derive(choices []C, preferences []int) *Voting[C] {
+	c := &Voting[C]{
+		choices:     choices,
+		preferences: preferences,
+		options:     v.options,
+	}
+	if len(v.suspended) > 0 {
+		c.suspended = make(map[C]struct{}, len(v.suspended))
+		for choice := range v.suspended {
+			c.suspended[choice] = struct{}{}
+		}
+	}
+	if len(v.disqualified) > 0 {
+		c.disqualified = make(map[C]struct{}, len(v.disqualified))
+		for choice := range v.disqualified {
+			c.disqualified[choice] = struct{}{}
+		}
+	}
+	if len(v.withdrawn) > 0 {
+		c.withdrawn = make(map[C]WithdrawalMode, len(v.withdrawn))
+		for choice, mode := range v.withdrawn {
+			c.withdrawn[choice] = mode
+		}
+	}
+	return c
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_challengeWindow(t *testing.T) {
+	opens := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	closes := opens.Add(24 * time.Hour)
+	challengeEnds := closes.Add(72 * time.Hour)
+
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B"},
+		Schedule: schulze.Schedule{
+			Opens:         &opens,
+			Closes:        &closes,
+			ChallengeEnds: &challengeEnds,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := opens.Add(-time.Hour)
+	e.SetNow(func() time.Time { return now })
+
+	if got := e.Phase(); got != schulze.PhaseNotOpen {
+		t.Errorf("got phase %v, want %v", got, schulze.PhaseNotOpen)
+	}
+	if err := e.VoteProvisional("alice", schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrElectionNotOpen) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrElectionNotOpen)
+	}
+
+	now = opens
+	if got := e.Phase(); got != schulze.PhaseOpen {
+		t.Errorf("got phase %v, want %v", got, schulze.PhaseOpen)
+	}
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"A": 1},
+		"bob":   {"A": 1},
+		"carol": {"B": 1},
+	} {
+		if _, err := e.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.VoteProvisional("dave", schulze.Ballot[string]{"X": 1}); err == nil {
+		t.Error("expected unknown choice error")
+	}
+	// disputed eligibility
+	if err := e.VoteProvisional("dave", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	now = closes
+	if got := e.Phase(); got != schulze.PhaseChallenge {
+		t.Errorf("got phase %v, want %v", got, schulze.PhaseChallenge)
+	}
+	if _, err := e.Vote("erin", schulze.Ballot[string]{"B": 1}); !errors.Is(err, schulze.ErrElectionClosed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrElectionClosed)
+	}
+	// late ballots
+	for _, voter := range []string{"erin", "frank"} {
+		if err := e.VoteProvisional(voter, schulze.Ballot[string]{"B": 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := e.ProvisionalBallotsCount(); got != 3 {
+		t.Errorf("got %v provisional ballots, want %v", got, 3)
+	}
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := schulze.Certify(e, key); !errors.Is(err, schulze.ErrChallengeWindowOpen) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrChallengeWindowOpen)
+	}
+
+	for _, voter := range []string{"dave", "erin"} {
+		if _, err := e.AcceptProvisional(voter); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.RejectProvisional("frank", "not eligible"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.AcceptProvisional("frank"); !errors.Is(err, schulze.ErrNoProvisionalBallot) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNoProvisionalBallot)
+	}
+
+	provisional, final, err := e.CloseResults()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if provisional.VotersCount != 3 || provisional.Results[0].Choice != "A" || provisional.Tie {
+		t.Errorf("got provisional results %+v", provisional)
+	}
+	if final.VotersCount != 5 || final.Results[0].Choice != "B" || final.Tie {
+		t.Errorf("got final results %+v", final)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(results, final.Results) {
+		t.Errorf("got results %+v, want %+v", results, final.Results)
+	}
+
+	now = challengeEnds
+	if got := e.Phase(); got != schulze.PhaseFinal {
+		t.Errorf("got phase %v, want %v", got, schulze.PhaseFinal)
+	}
+	if err := e.VoteProvisional("grace", schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrChallengeWindowClosed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrChallengeWindowClosed)
+	}
+	if _, err := schulze.Certify(e, key); err != nil {
+		t.Fatal(err)
+	}
+
+	var actions []schulze.AuditAction
+	for _, entry := range e.AuditLog() {
+		actions = append(actions, entry.Action)
+	}
+	if want := []schulze.AuditAction{schulze.AuditAcceptProvisional, schulze.AuditAcceptProvisional, schulze.AuditRejectProvisional}; !reflect.DeepEqual(actions, want) {
+		t.Errorf("got audit actions %v, want %v", actions, want)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package client is the Go client of the poll API that is served by the
+// resenje.org/schulze/httpd package and specified by its OpenAPI
+// specification, so that services can vote in and read results of remote
+// polls without hand-written HTTP calls.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/httpd"
+)
+
+// maxErrorSize is the maximal size of the error message read from the
+// response body.
+const maxErrorSize = 4 << 10
+
+// Error is returned when the server responds with an unexpected status.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("client: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Option configures the Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client of API calls. Voters of the polls that
+// identify them by a cookie are identified by the cookie jar of the HTTP
+// client, and voters of the polls with other authentication methods can be
+// identified, for example, by a transport that sets authentication headers.
+// By default, a new HTTP client with its own cookie jar is used, so that
+// the Client votes as a single voter.
+func WithHTTPClient(c *http.Client) Option {
+	return func(cl *Client) {
+		cl.client = c
+	}
+}
+
+// Client calls the API of a single poll.
+type Client struct {
+	baseURL string
+	client  *http.Client
+}
+
+// New returns a new Client of the poll with the base URL, where the poll
+// handler is mounted.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.client == nil {
+		// the error is always nil without options
+		jar, _ := cookiejar.New(nil)
+		c.client = &http.Client{Jar: jar}
+	}
+	return c
+}
+
+// Poll returns the name, choices and phase of the poll.
+func (c *Client) Poll(ctx context.Context) (*httpd.Poll, error) {
+	var p httpd.Poll
+	if err := c.do(ctx, http.MethodGet, "/api/poll", nil, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Vote casts or replaces the ballot of the voter and returns the Record of
+// the ballot.
+func (c *Client) Vote(ctx context.Context, b schulze.Ballot[string]) (schulze.Record[string], error) {
+	var r httpd.VoteResponse
+	if err := c.do(ctx, http.MethodPost, "/api/vote", httpd.VoteRequest{Ballot: b}, &r); err != nil {
+		return nil, err
+	}
+	return r.Record, nil
+}
+
+// Unvote removes the ballot of the voter.
+func (c *Client) Unvote(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, "/api/vote", nil, nil)
+}
+
+// Results returns the current results of the poll.
+func (c *Client) Results(ctx context.Context) (*schulze.ResultDocument[string], error) {
+	var d schulze.ResultDocument[string]
+	if err := c.do(ctx, http.MethodGet, "/api/results", nil, &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, request, result any) error {
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil
+	default:
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorSize))
+		return &Error{
+			StatusCode: resp.StatusCode,
+			Message:    strings.TrimSpace(string(data)),
+		}
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("client: decode response: %w", err)
+	}
+	return nil
+}
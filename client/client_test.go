@@ -0,0 +1,75 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/client"
+	"resenje.org/schulze/httpd"
+)
+
+func TestClient(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:    "Lunch",
+		Choices: []string{"Pizza", "Sushi", "Tacos"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/lunch/", http.StripPrefix("/lunch", httpd.NewHandler(e)))
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	ctx := context.Background()
+	alice, bob := client.New(s.URL+"/lunch/"), client.New(s.URL+"/lunch")
+
+	p, err := alice.Poll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&httpd.Poll{Name: "Lunch", Choices: []string{"Pizza", "Sushi", "Tacos"}, Phase: "open"}); !reflect.DeepEqual(p, want) {
+		t.Errorf("got poll %+v, want %+v", p, want)
+	}
+
+	r, err := alice.Vote(ctx, schulze.Ballot[string]{"Sushi": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"Sushi"}, {"Pizza", "Tacos"}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+	if _, err := bob.Vote(ctx, schulze.Ballot[string]{"Tacos": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bob.Unvote(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := bob.Results(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.BallotsCount != 1 || d.Ranking[0].Choice != "Sushi" {
+		t.Errorf("got results %+v", d)
+	}
+
+	_, err = alice.Vote(ctx, schulze.Ballot[string]{"Pasta": 1})
+	var cerr *client.Error
+	if !errors.As(err, &cerr) {
+		t.Fatalf("got error %v, want client error", err)
+	}
+	if cerr.StatusCode != http.StatusBadRequest {
+		t.Errorf("got status code %v, want %v", cerr.StatusCode, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command schulze-site generates a static HTML site with election results
+// from a JSON encoded result document, as returned by the Election
+// ResultDocument method, read from the file or the standard input.
+//
+// Usage:
+//
+//	schulze-site [-o directory] [results.json]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/site"
+)
+
+func main() {
+	dir := flag.String("o", "site", "output directory")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-o directory] [results.json]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var r io.Reader = os.Stdin
+	switch flag.NArg() {
+	case 0:
+	case 1:
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		r = f
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var d schulze.ResultDocument[string]
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		fmt.Fprintln(os.Stderr, "decode result document:", err)
+		os.Exit(1)
+	}
+	if err := site.Write(*dir, d); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
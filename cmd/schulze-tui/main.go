@@ -0,0 +1,321 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command schulze-tui is an interactive terminal interface for exploring the
+// results of a Schulze method election from a ballot file, without the need
+// for spreadsheets or code.
+//
+// The ballot file has one ballot per line in the text format of the
+// ParseBallot function, such as "A>B=C>D", optionally prefixed with the
+// number of identical ballots and the * character, as in "12*A>B". Empty
+// lines and lines starting with the # character are ignored. Choices are
+// ordered as they first appear in the file, unless they are listed with the
+// -choices flag.
+//
+// Usage:
+//
+//	schulze-tui [-choices A,B,C] ballots.txt
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"resenje.org/schulze"
+)
+
+func main() {
+	choicesFlag := flag.String("choices", "", "comma separated list of all choices in order")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-choices A,B,C] ballots.txt\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var choices []string
+	if *choicesFlag != "" {
+		for _, c := range strings.Split(*choicesFlag, ",") {
+			choices = append(choices, strings.TrimSpace(c))
+		}
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	e, err := load(f, choices)
+	f.Close()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := e.run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// explorer holds the computed election and answers the commands.
+type explorer struct {
+	choices      []string
+	preferences  []int
+	ballotsCount int
+	results      []schulze.Result[string]
+	tie          bool
+	graph        schulze.BeatGraph[string]
+}
+
+// load reads all ballots and computes the results. If choices are not
+// provided, they are collected from ballots in the order of appearance.
+func load(r io.Reader, choices []string) (*explorer, error) {
+	type entry struct {
+		ballot schulze.Ballot[string]
+		count  int
+	}
+	var entries []entry
+	known := make(map[string]struct{})
+	for _, c := range choices {
+		known[c] = struct{}{}
+	}
+	fixed := len(choices) > 0
+
+	s := bufio.NewScanner(r)
+	line := 0
+	for s.Scan() {
+		line++
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		count := 1
+		if prefix, rest, ok := strings.Cut(text, "*"); ok {
+			n, err := strconv.Atoi(strings.TrimSpace(prefix))
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("line %v: invalid number of ballots %q", line, prefix)
+			}
+			count, text = n, rest
+		}
+		b, err := schulze.ParseBallot(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %w", line, err)
+		}
+		// collect new choices in the order of ranks on the line
+		for _, group := range strings.Split(text, ">") {
+			for _, c := range strings.Split(group, "=") {
+				c = strings.TrimSpace(c)
+				if _, ok := known[c]; ok || c == "" {
+					continue
+				}
+				if fixed {
+					return nil, fmt.Errorf("line %v: unknown choice %q", line, c)
+				}
+				known[c] = struct{}{}
+				choices = append(choices, c)
+			}
+		}
+		entries = append(entries, entry{ballot: b, count: count})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(choices) == 0 {
+		return nil, errors.New("no choices")
+	}
+
+	e := &explorer{
+		choices:     choices,
+		preferences: schulze.NewPreferences(len(choices)),
+	}
+	for _, en := range entries {
+		for i := 0; i < en.count; i++ {
+			if _, err := schulze.Vote(e.preferences, choices, en.ballot); err != nil {
+				return nil, err
+			}
+		}
+		e.ballotsCount += en.count
+	}
+	e.results, _, e.tie = schulze.Compute(e.preferences, choices)
+	e.graph = schulze.NewBeatGraph(e.preferences, choices)
+	return e, nil
+}
+
+const help = `Commands:
+  ranking, r              show the ranking of all choices
+  choices, c              list choices with their numbers
+  duel, d A B             inspect the head-to-head duel between two choices
+  path, p A B             show the strongest paths between two choices
+  help, h                 show this help
+  quit, q                 exit
+Choices are given by their names or numbers from the choices list, and
+choices with spaces in their names only by numbers.
+`
+
+// run reads commands from the input until it ends or the quit command.
+func (e *explorer) run(in io.Reader, out io.Writer) error {
+	fmt.Fprintf(out, "%v ballots, %v choices.\n\n", e.ballotsCount, len(e.choices))
+	e.ranking(out)
+	fmt.Fprint(out, "\nType help for the list of commands.\n")
+
+	s := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "> ")
+		if !s.Scan() {
+			fmt.Fprintln(out)
+			return s.Err()
+		}
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		args := fields[1:]
+		var err error
+		switch strings.ToLower(fields[0]) {
+		case "ranking", "r":
+			e.ranking(out)
+		case "choices", "c":
+			e.list(out)
+		case "duel", "d":
+			err = e.withPair(args, func(a, b int) { e.duel(out, a, b) })
+		case "path", "p":
+			err = e.withPair(args, func(a, b int) { e.paths(out, a, b) })
+		case "help", "h", "?":
+			fmt.Fprint(out, help)
+		case "quit", "q", "exit":
+			return nil
+		default:
+			err = fmt.Errorf("unknown command %q, type help for the list of commands", fields[0])
+		}
+		if err != nil {
+			fmt.Fprintln(out, err)
+		}
+	}
+}
+
+func (e *explorer) ranking(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "POSITION\tCHOICE\tWINS")
+	position := 0
+	for i, r := range e.results {
+		if i == 0 || r.Wins != e.results[i-1].Wins {
+			position = i + 1
+		}
+		fmt.Fprintf(w, "%v\t%s\t%v\n", position, r.Choice, r.Wins)
+	}
+	w.Flush()
+	if e.tie {
+		fmt.Fprintln(out, "The first place is tied.")
+	}
+}
+
+func (e *explorer) list(out io.Writer) {
+	for i, c := range e.choices {
+		fmt.Fprintf(out, "%3v  %s\n", i+1, c)
+	}
+}
+
+// withPair resolves two different choices from the arguments by their names
+// or numbers and calls the function with their indexes.
+func (e *explorer) withPair(args []string, f func(a, b int)) error {
+	if len(args) != 2 {
+		return errors.New("two choices are required")
+	}
+	a, err := e.choiceIndex(args[0])
+	if err != nil {
+		return err
+	}
+	b, err := e.choiceIndex(args[1])
+	if err != nil {
+		return err
+	}
+	if a == b {
+		return errors.New("choices must be different")
+	}
+	f(a, b)
+	return nil
+}
+
+func (e *explorer) choiceIndex(s string) (int, error) {
+	for i, c := range e.choices {
+		if strings.EqualFold(c, s) {
+			return i, nil
+		}
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= 1 && n <= len(e.choices) {
+		return n - 1, nil
+	}
+	return 0, fmt.Errorf("unknown choice %q", s)
+}
+
+func (e *explorer) duel(out io.Writer, a, b int) {
+	n := len(e.choices)
+	ca, cb := e.choices[a], e.choices[b]
+	ab, ba := e.preferences[a*n+b], e.preferences[b*n+a]
+	sab, sba := e.pathStrength(a, b), e.pathStrength(b, a)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tBALLOTS\tSTRONGEST PATH")
+	fmt.Fprintf(w, "%s over %s\t%v\t%v\n", ca, cb, ab, sab)
+	fmt.Fprintf(w, "%s over %s\t%v\t%v\n", cb, ca, ba, sba)
+	w.Flush()
+
+	switch {
+	case sab > sba:
+		fmt.Fprintf(out, "%s defeats %s.\n", ca, cb)
+	case sba > sab:
+		fmt.Fprintf(out, "%s defeats %s.\n", cb, ca)
+	default:
+		fmt.Fprintf(out, "%s and %s are tied.\n", ca, cb)
+	}
+	if (ab > ba) != (sab > sba) && ab != ba {
+		fmt.Fprintln(out, "The direct comparison is overturned by a stronger path.")
+	}
+}
+
+func (e *explorer) paths(out io.Writer, a, b int) {
+	e.path(out, a, b)
+	e.path(out, b, a)
+}
+
+func (e *explorer) path(out io.Writer, from, to int) {
+	for _, p := range e.graph.Paths {
+		if p.Source != from || p.Target != to {
+			continue
+		}
+		n := len(e.choices)
+		var s strings.Builder
+		for i, node := range p.Nodes {
+			if i > 0 {
+				previous := p.Nodes[i-1]
+				fmt.Fprintf(&s, " -(%v)-> ", e.preferences[previous*n+node])
+			}
+			s.WriteString(e.choices[node])
+		}
+		fmt.Fprintf(out, "%s, strength %v\n", s.String(), p.Strength)
+		return
+	}
+	fmt.Fprintf(out, "There is no path from %s to %s.\n", e.choices[from], e.choices[to])
+}
+
+func (e *explorer) pathStrength(from, to int) int {
+	for _, p := range e.graph.Paths {
+		if p.Source == from && p.Target == to {
+			return p.Strength
+		}
+	}
+	return 0
+}
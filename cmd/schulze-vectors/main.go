@@ -0,0 +1,32 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command schulze-vectors writes test vectors for implementations of the
+// Schulze method in other languages as JSON to the standard output.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"resenje.org/schulze/generator"
+)
+
+func main() {
+	seed := flag.Int64("seed", 1, "seed of random vectors")
+	count := flag.Int("count", 100, "number of random vectors")
+	flag.Parse()
+
+	vectors, err := generator.Generate(*seed, *count)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := generator.WriteJSON(os.Stdout, vectors); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// Coalition is a hypothetical union of segments of voters, identified by
+// values of the same tag, such as regions or parties that could vote
+// together.
+type Coalition struct {
+	Name string
+	// Tag values of the segments in the coalition.
+	Values []string
+}
+
+// CoalitionResult holds the results that the ballots of a coalition alone
+// would produce.
+type CoalitionResult[C comparable] struct {
+	Name string
+	// Preferences of ballots of the coalition in the layout of the election
+	// choices.
+	Preferences []int
+	Results     []Result[C]
+	Duels       DuelsIterator[C]
+	Tie         bool
+	// Number of voters in the coalition.
+	VotersCount int
+}
+
+// SegmentPreferences returns preferences of ballots of every segment of voters
+// that have the same value of the tag with the provided key, in the layout of
+// the election choices, which can be combined with MergePreferences and
+// SubtractPreferences for analysis of hypothetical coalitions. Ballots without
+// the tag are not included. ErrSealed is returned if the results of the
+// election are sealed.
+func (e *Election[V, C]) SegmentPreferences(key string) (map[string][]int, error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, err
+	}
+	votings, _ := e.segmentVotings(key)
+	preferences := make(map[string][]int, len(votings))
+	for value, v := range votings {
+		preferences[value] = v.preferences
+	}
+	return preferences, nil
+}
+
+// ComputeCoalitions calculates, for every coalition, the results of only the
+// ballots of voters in its segments by the tag with the provided key, just as
+// ComputeBy does for a single segment. Coalitions may overlap, a value that is
+// listed multiple times in a coalition is counted once, and values without
+// ballots contribute no preferences. Results are returned in the order of
+// coalitions. ErrSealed is returned if the results of the election are
+// sealed.
+func (e *Election[V, C]) ComputeCoalitions(key string, coalitions ...Coalition) ([]CoalitionResult[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, err
+	}
+	votings, counts := e.segmentVotings(key)
+	choices := e.voting.choices
+	results := make([]CoalitionResult[C], 0, len(coalitions))
+	for _, c := range coalitions {
+		v := e.voting.derive(choices, NewPreferences(len(choices)))
+		var votersCount int
+		seen := make(map[string]struct{}, len(c.Values))
+		for _, value := range c.Values {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+			segment, ok := votings[value]
+			if !ok {
+				continue
+			}
+			for i, p := range segment.preferences {
+				v.preferences[i] += p
+			}
+			votersCount += counts[value]
+		}
+		r, duels, tie := v.Compute()
+		results = append(results, CoalitionResult[C]{
+			Name:        c.Name,
+			Preferences: v.preferences,
+			Results:     r,
+			Duels:       duels,
+			Tie:         tie,
+			VotersCount: votersCount,
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_ComputeCoalitions(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	e := schulze.NewElection[string](choices)
+	for _, v := range []struct {
+		voter  string
+		ballot schulze.Ballot[string]
+		party  string
+	}{
+		{"v1", schulze.Ballot[string]{"A": 1, "B": 2}, "red"},
+		{"v2", schulze.Ballot[string]{"A": 1, "C": 2}, "red"},
+		{"v3", schulze.Ballot[string]{"B": 1, "A": 2}, "green"},
+		{"v4", schulze.Ballot[string]{"C": 1, "B": 2}, "blue"},
+		{"v5", schulze.Ballot[string]{"C": 1}, "blue"},
+		{"v6", schulze.Ballot[string]{"C": 1, "A": 2}, "blue"},
+		{"v7", schulze.Ballot[string]{"B": 1}, ""},
+	} {
+		var tags schulze.Tags
+		if v.party != "" {
+			tags = schulze.Tags{"party": v.party}
+		}
+		if _, err := e.VoteTagged(v.voter, v.ballot, tags); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	segments, err := e.SegmentPreferences("party")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("got %v segments, want 3", len(segments))
+	}
+
+	results, err := e.ComputeCoalitions("party",
+		schulze.Coalition{Name: "red-green", Values: []string{"red", "green", "red"}},
+		schulze.Coalition{Name: "green-blue", Values: []string{"green", "blue"}},
+		schulze.Coalition{Name: "none", Values: []string{"yellow"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %v results, want 3", len(results))
+	}
+
+	for i, tc := range []struct {
+		name        string
+		values      []string
+		votersCount int
+		winner      string
+	}{
+		{"red-green", []string{"red", "green"}, 3, "A"},
+		{"green-blue", []string{"green", "blue"}, 4, "C"},
+	} {
+		r := results[i]
+		if r.Name != tc.name {
+			t.Errorf("got coalition %q, want %q", r.Name, tc.name)
+		}
+		if r.VotersCount != tc.votersCount {
+			t.Errorf("%s: got voters count %v, want %v", tc.name, r.VotersCount, tc.votersCount)
+		}
+		var parts [][]int
+		for _, value := range tc.values {
+			parts = append(parts, segments[value])
+		}
+		want, err := schulze.MergePreferences(parts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(r.Preferences, want) {
+			t.Errorf("%s: got preferences %v, want %v", tc.name, r.Preferences, want)
+		}
+		wantResults, _, wantTie := schulze.Compute(want, choices)
+		if !reflect.DeepEqual(r.Results, wantResults) || r.Tie != wantTie {
+			t.Errorf("%s: got results %+v, want %+v", tc.name, r.Results, wantResults)
+		}
+		if r.Results[0].Choice != tc.winner {
+			t.Errorf("%s: got winner %v, want %v", tc.name, r.Results[0].Choice, tc.winner)
+		}
+	}
+
+	if r := results[2]; r.VotersCount != 0 || !reflect.DeepEqual(r.Preferences, schulze.NewPreferences(len(choices))) {
+		t.Errorf("got coalition without ballots %+v", r)
+	}
+}
+
+func TestElection_ComputeCoalitions_suspended(t *testing.T) {
+	e := newSuspendedElection(t)
+
+	results, err := e.ComputeCoalitions("region", schulze.Coalition{Name: "south", Values: []string{"south"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := resultChoices(results[0].Results), []string{"C", "B"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got results %v, want %v", got, want)
+	}
+}
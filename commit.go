@@ -0,0 +1,100 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+)
+
+// BallotCommitment returns the salted SHA-256 hash of the ballot Record that
+// a voter submits with the Election Commit method in the commit phase of a
+// commit-reveal election. The hash depends only on the order of the choices
+// in the ballot, not on the exact rank numbers, and it is bound to the
+// choices, which must not be changed between the commit and the reveal
+// phase. The salt should be a random value of at least 16 bytes, kept secret
+// by the voter until the reveal.
+func BallotCommitment[C comparable](choices []C, b Ballot[C], salt []byte) ([]byte, error) {
+	ranks, _, _, err := ballotRanks(choices, b)
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	buf := make([]byte, 8)
+	write := func(v uint64) {
+		binary.BigEndian.PutUint64(buf, v)
+		_, _ = h.Write(buf)
+	}
+
+	write(uint64(len(salt)))
+	_, _ = h.Write(salt)
+	write(uint64(len(choices)))
+	write(uint64(len(ranks)))
+	for _, rank := range ranks {
+		indexes := append(make([]choiceIndex, 0, len(rank)), rank...)
+		sort.Slice(indexes, func(i, j int) bool {
+			return indexes[i] < indexes[j]
+		})
+		write(uint64(len(indexes)))
+		for _, index := range indexes {
+			write(uint64(index))
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+// Commit stores the voter's commitment, returned by the BallotCommitment
+// function, in the commit phase of a commit-reveal election, while the
+// election is open. A previous commitment of the voter is replaced.
+func (e *Election[V, C]) Commit(voter V, commitment []byte) error {
+	if !e.config.CommitReveal {
+		return ErrNotCommitReveal
+	}
+	if err := e.checkSchedule(); err != nil {
+		return err
+	}
+	e.commitments[voter] = append(make([]byte, 0, len(commitment)), commitment...)
+	return nil
+}
+
+// CommitmentsCount returns the number of commitments that are not yet
+// revealed.
+func (e *Election[V, C]) CommitmentsCount() int {
+	return len(e.commitments)
+}
+
+// Reveal validates the voter's ballot and salt against the commitment and
+// tallies the ballot, in the reveal phase of a commit-reveal election, after
+// the election is closed. The commitment is removed after a successful
+// reveal.
+func (e *Election[V, C]) Reveal(voter V, b Ballot[C], salt []byte) (Record[C], error) {
+	if !e.config.CommitReveal {
+		return nil, ErrNotCommitReveal
+	}
+	if err := e.checkSchedule(); err != ErrElectionClosed {
+		return nil, ErrRevealNotOpen
+	}
+	commitment, ok := e.commitments[voter]
+	if !ok {
+		return nil, ErrNoCommitment
+	}
+	c, err := BallotCommitment(e.voting.choices, b, salt)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(c, commitment) {
+		return nil, ErrCommitmentMismatch
+	}
+	r, err := e.vote(voter, b, nil)
+	if err != nil {
+		return nil, err
+	}
+	delete(e.commitments, voter)
+	return r, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestBallotCommitment(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	salt := []byte("0123456789abcdef")
+
+	c1, err := schulze.BallotCommitment(choices, schulze.Ballot[string]{"A": 1, "B": 2, "C": 2}, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the same order with different rank numbers
+	c2, err := schulze.BallotCommitment(choices, schulze.Ballot[string]{"A": 3, "B": 10, "C": 10}, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(c1, c2) {
+		t.Error("got different commitments for equivalent ballots")
+	}
+
+	c3, err := schulze.BallotCommitment(choices, schulze.Ballot[string]{"A": 1, "B": 2}, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(c1, c3) {
+		t.Error("got the same commitments for different ballots")
+	}
+
+	c4, err := schulze.BallotCommitment(choices, schulze.Ballot[string]{"A": 1, "B": 2, "C": 2}, []byte("fedcba9876543210"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(c1, c4) {
+		t.Error("got the same commitments for different salts")
+	}
+
+	var uerr *schulze.UnknownChoiceError[string]
+	if _, err := schulze.BallotCommitment(choices, schulze.Ballot[string]{"E": 1}, salt); !errors.As(err, &uerr) {
+		t.Errorf("got error %v, want UnknownChoiceError", err)
+	}
+}
+
+func TestElection_commitReveal(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	closes := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:      choices,
+		CommitReveal: true,
+		Schedule:     schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := closes.Add(-time.Hour)
+	e.SetNow(func() time.Time { return now })
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrCommitRevealRequired) {
+		t.Errorf("got vote error %v, want %v", err, schulze.ErrCommitRevealRequired)
+	}
+
+	ballots := map[string]schulze.Ballot[string]{
+		"alice": {"A": 1, "B": 2},
+		"bob":   {"B": 1},
+		"carol": {"A": 1},
+	}
+	salts := map[string][]byte{
+		"alice": []byte("alice-salt-value"),
+		"bob":   []byte("bob-salt-value-x"),
+		"carol": []byte("carol-salt-value"),
+	}
+	for voter, b := range ballots {
+		c, err := schulze.BallotCommitment(choices, b, salts[voter])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Commit(voter, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := e.CommitmentsCount(); got != 3 {
+		t.Errorf("got commitments count %v, want %v", got, 3)
+	}
+
+	if _, err := e.Reveal("alice", ballots["alice"], salts["alice"]); !errors.Is(err, schulze.ErrRevealNotOpen) {
+		t.Errorf("got reveal error %v, want %v", err, schulze.ErrRevealNotOpen)
+	}
+
+	now = closes
+
+	if err := e.Commit("dave", []byte("late")); !errors.Is(err, schulze.ErrElectionClosed) {
+		t.Errorf("got commit error %v, want %v", err, schulze.ErrElectionClosed)
+	}
+	if _, err := e.Reveal("dave", schulze.Ballot[string]{"C": 1}, nil); !errors.Is(err, schulze.ErrNoCommitment) {
+		t.Errorf("got reveal error %v, want %v", err, schulze.ErrNoCommitment)
+	}
+	if _, err := e.Reveal("bob", schulze.Ballot[string]{"C": 1}, salts["bob"]); !errors.Is(err, schulze.ErrCommitmentMismatch) {
+		t.Errorf("got reveal error %v, want %v", err, schulze.ErrCommitmentMismatch)
+	}
+
+	for _, voter := range []string{"alice", "bob", "carol"} {
+		if _, err := e.Reveal(voter, ballots[voter], salts[voter]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := e.Reveal("alice", ballots["alice"], salts["alice"]); !errors.Is(err, schulze.ErrNoCommitment) {
+		t.Errorf("got second reveal error %v, want %v", err, schulze.ErrNoCommitment)
+	}
+
+	if got := e.VotersCount(); got != 3 {
+		t.Errorf("got voters count %v, want %v", got, 3)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "A" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+	}
+}
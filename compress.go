@@ -0,0 +1,44 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "sort"
+
+// CompressRanks returns the ballot with non-consecutive ranks compressed into
+// consecutive ranks starting from one, keeping the order and the equal ranks
+// of choices, so that ranks 1, 5, 9 become 1, 2, 3. The LastPlace rank is not
+// changed. Front ends can use it to echo the normalized ballot back to the
+// voter. The Record returned by Vote is always in this normalized form, as it
+// holds only the order of the rank groups, so compressing a ballot does not
+// change how it is counted.
+func CompressRanks[C comparable](b Ballot[C]) Ballot[C] {
+	ranks := make([]int, 0, len(b))
+	seen := make(map[int]struct{}, len(b))
+	for _, rank := range b {
+		if rank == LastPlace {
+			continue
+		}
+		if _, ok := seen[rank]; ok {
+			continue
+		}
+		seen[rank] = struct{}{}
+		ranks = append(ranks, rank)
+	}
+	sort.Ints(ranks)
+	dense := make(map[int]int, len(ranks))
+	for i, rank := range ranks {
+		dense[rank] = i + 1
+	}
+	compressed := make(Ballot[C], len(b))
+	for c, rank := range b {
+		if rank == LastPlace {
+			compressed[c] = rank
+			continue
+		}
+		compressed[c] = dense[rank]
+	}
+	return compressed
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestCompressRanks(t *testing.T) {
+	choices := []string{"A", "B", "C", "D", "E"}
+	for _, tc := range []struct {
+		name   string
+		ballot schulze.Ballot[string]
+		want   schulze.Ballot[string]
+	}{
+		{
+			name:   "empty",
+			ballot: schulze.Ballot[string]{},
+			want:   schulze.Ballot[string]{},
+		},
+		{
+			name:   "gaps",
+			ballot: schulze.Ballot[string]{"A": 1, "B": 5, "C": 9},
+			want:   schulze.Ballot[string]{"A": 1, "B": 2, "C": 3},
+		},
+		{
+			name:   "equal ranks",
+			ballot: schulze.Ballot[string]{"A": 10, "B": 3, "C": 10, "D": -2},
+			want:   schulze.Ballot[string]{"A": 3, "B": 2, "C": 3, "D": 1},
+		},
+		{
+			name:   "last place",
+			ballot: schulze.Ballot[string]{"A": 3, "B": schulze.LastPlace},
+			want:   schulze.Ballot[string]{"A": 1, "B": schulze.LastPlace},
+		},
+		{
+			name:   "consecutive",
+			ballot: schulze.Ballot[string]{"A": 1, "B": 2, "E": 2},
+			want:   schulze.Ballot[string]{"A": 1, "B": 2, "E": 2},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := schulze.CompressRanks(tc.ballot)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+
+			// compression does not change how the ballot is counted
+			want := schulze.NewVoting(choices)
+			if _, err := want.Vote(tc.ballot); err != nil {
+				t.Fatal(err)
+			}
+			v := schulze.NewVoting(choices)
+			if _, err := v.Vote(got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(v.Preferences(), want.Preferences()) {
+				t.Errorf("got preferences %v, want %v", v.Preferences(), want.Preferences())
+			}
+		})
+	}
+}
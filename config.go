@@ -0,0 +1,275 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ElectionConfig holds the complete setup of an election that can be stored,
+// reviewed and versioned as a configuration file. It can be encoded as JSON
+// and YAML, with camel case field names, and the election is constructed from
+// it by the NewElectionFromConfig function.
+type ElectionConfig[C comparable] struct {
+	// Name of the election.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Choices of the election.
+	Choices []C `json:"choices" yaml:"choices"`
+	// Rules that every ballot must satisfy.
+	BallotPolicy BallotPolicy `json:"ballotPolicy" yaml:"ballotPolicy"`
+	// Measure of the link strengths, winning votes by default.
+	StrengthVariant StrengthVariant `json:"strengthVariant" yaml:"strengthVariant"`
+	// Rule that resolves the tie for the first place, none by default.
+	TieBreak TieBreak `json:"tieBreak" yaml:"tieBreak"`
+	// Seed for all features that use randomness, such as the random tie-break.
+	RandomSeed int64 `json:"randomSeed,omitempty" yaml:"randomSeed,omitempty"`
+	// Minimal number of voters for the results to be valid, reported by the
+	// Election QuorumReached method.
+	Quorum int `json:"quorum,omitempty" yaml:"quorum,omitempty"`
+	// Number of eligible voters, used to report the number of voters that did
+	// not vote. Zero if it is not known.
+	Electorate int `json:"electorate,omitempty" yaml:"electorate,omitempty"`
+	// Number of voters, other than the nominator, that must second a
+	// nominated choice for it to be added to the ballot when the election
+	// opens.
+	NominationThreshold int `json:"nominationThreshold,omitempty" yaml:"nominationThreshold,omitempty"`
+	// Time period when votes are accepted.
+	Schedule Schedule `json:"schedule" yaml:"schedule"`
+	// Results are not available until the election is closed by the schedule
+	// or unsealed with the token.
+	Sealed bool `json:"sealed,omitempty" yaml:"sealed,omitempty"`
+	// Hex encoded SHA-256 hash of the token that unseals the results of a
+	// sealed election before it is closed.
+	UnsealTokenHash string `json:"unsealTokenHash,omitempty" yaml:"unsealTokenHash,omitempty"`
+	// Voters commit to a salted hash of their ballot while the election is
+	// open and reveal the ballot after it is closed.
+	CommitReveal bool `json:"commitReveal,omitempty" yaml:"commitReveal,omitempty"`
+	// Fraction of recorded ballots, between zero and one, that may veto a
+	// choice before it is disqualified from the results. Vetoes are not
+	// counted if it is zero.
+	VetoThreshold float64 `json:"vetoThreshold,omitempty" yaml:"vetoThreshold,omitempty"`
+	// Maximal differential privacy epsilon of the noisy preferences that are
+	// available while the results are sealed. Noisy preferences are sealed as
+	// the other results if it is zero.
+	SealedNoiseEpsilon float64 `json:"sealedNoiseEpsilon,omitempty" yaml:"sealedNoiseEpsilon,omitempty"`
+}
+
+// BallotPolicy defines rules that every ballot must satisfy. The zero value
+// accepts all ballots.
+type BallotPolicy struct {
+	// Minimal number of ranked choices.
+	MinRanked int `json:"minRanked,omitempty" yaml:"minRanked,omitempty"`
+	// Maximal number of ranked choices, not limited if zero.
+	MaxRanked int `json:"maxRanked,omitempty" yaml:"maxRanked,omitempty"`
+	// Reject ballots where multiple choices have the same rank.
+	DisallowEqualRanks bool `json:"disallowEqualRanks,omitempty" yaml:"disallowEqualRanks,omitempty"`
+}
+
+// Schedule defines the time period when votes are accepted. Nil times mean
+// that the period is not limited.
+type Schedule struct {
+	Opens  *time.Time `json:"opens,omitempty" yaml:"opens,omitempty"`
+	Closes *time.Time `json:"closes,omitempty" yaml:"closes,omitempty"`
+	// End of the challenge window after the election is closed, when
+	// provisional ballots are resolved before the results are certified.
+	ChallengeEnds *time.Time `json:"challengeEnds,omitempty" yaml:"challengeEnds,omitempty"`
+}
+
+// NewElectionFromConfig validates the configuration and constructs a new
+// election from it. Additional options are applied after the options from the
+// configuration.
+func NewElectionFromConfig[V, C comparable](config ElectionConfig[C], opts ...Option[C]) (*Election[V, C], error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	configOpts := []Option[C]{
+		WithStrengthVariant[C](config.StrengthVariant),
+		WithTieBreak[C](config.TieBreak),
+		WithRandomSeed[C](config.RandomSeed),
+	}
+	e := NewElection[V](config.Choices, append(configOpts, opts...)...)
+	config.Choices = nil
+	e.config = config
+	return e, nil
+}
+
+// Config returns the configuration of the election with the current
+// choices.
+func (e *Election[V, C]) Config() ElectionConfig[C] {
+	c := e.config
+	c.Choices = append([]C(nil), e.voting.choices...)
+	return c
+}
+
+// QuorumReached returns true if the number of voters, including voters that
+// abstained or spoiled their ballots, is at least the quorum from the
+// election configuration.
+func (e *Election[V, C]) QuorumReached() bool {
+	return e.VotersCount() >= e.config.Quorum
+}
+
+func (c ElectionConfig[C]) validate() error {
+	p := c.BallotPolicy
+	if p.MinRanked < 0 {
+		return fmt.Errorf("%w: negative minimal number of ranked choices", ErrInvalidElectionConfig)
+	}
+	if p.MaxRanked < 0 {
+		return fmt.Errorf("%w: negative maximal number of ranked choices", ErrInvalidElectionConfig)
+	}
+	if p.MaxRanked > 0 && p.MaxRanked < p.MinRanked {
+		return fmt.Errorf("%w: maximal number of ranked choices is less than the minimal", ErrInvalidElectionConfig)
+	}
+	if p.MinRanked > len(c.Choices) {
+		return fmt.Errorf("%w: minimal number of ranked choices is greater than the number of choices", ErrInvalidElectionConfig)
+	}
+	switch c.StrengthVariant {
+	case StrengthWinningVotes, StrengthMargins:
+	default:
+		return fmt.Errorf("%w: unknown strength variant %v", ErrInvalidElectionConfig, int(c.StrengthVariant))
+	}
+	switch c.TieBreak {
+	case TieBreakNone, TieBreakIndex, TieBreakRandom:
+	default:
+		return fmt.Errorf("%w: unknown tie-break %v", ErrInvalidElectionConfig, int(c.TieBreak))
+	}
+	if c.Quorum < 0 {
+		return fmt.Errorf("%w: negative quorum", ErrInvalidElectionConfig)
+	}
+	if c.Electorate < 0 {
+		return fmt.Errorf("%w: negative electorate", ErrInvalidElectionConfig)
+	}
+	if !(c.VetoThreshold >= 0 && c.VetoThreshold <= 1) {
+		return fmt.Errorf("%w: veto threshold is not between zero and one", ErrInvalidElectionConfig)
+	}
+	if !(c.SealedNoiseEpsilon >= 0) || math.IsInf(c.SealedNoiseEpsilon, 1) {
+		return fmt.Errorf("%w: sealed noise epsilon is not a non-negative number", ErrInvalidElectionConfig)
+	}
+	if c.NominationThreshold < 0 {
+		return fmt.Errorf("%w: negative nomination threshold", ErrInvalidElectionConfig)
+	}
+	if c.UnsealTokenHash != "" {
+		if h, err := hex.DecodeString(c.UnsealTokenHash); err != nil || len(h) != sha256.Size {
+			return fmt.Errorf("%w: unseal token hash is not a hex encoded SHA-256 hash", ErrInvalidElectionConfig)
+		}
+	}
+	if c.Sealed && c.Schedule.Closes == nil && c.UnsealTokenHash == "" {
+		return fmt.Errorf("%w: sealed election without closing time or unseal token hash", ErrInvalidElectionConfig)
+	}
+	if c.CommitReveal && c.Schedule.Closes == nil {
+		return fmt.Errorf("%w: commit-reveal election without closing time", ErrInvalidElectionConfig)
+	}
+	if s := c.Schedule; s.Opens != nil && s.Closes != nil && !s.Closes.After(*s.Opens) {
+		return fmt.Errorf("%w: schedule closes before it opens", ErrInvalidElectionConfig)
+	}
+	if s := c.Schedule; s.ChallengeEnds != nil && (s.Closes == nil || s.ChallengeEnds.Before(*s.Closes)) {
+		return fmt.Errorf("%w: challenge window ends before the election closes", ErrInvalidElectionConfig)
+	}
+	return nil
+}
+
+// validateBallot returns an error if the ballot does not satisfy the policy.
+// Empty ballots are always valid, as abstentions.
+func validateBallot[C comparable](p BallotPolicy, b Ballot[C]) error {
+	if len(b) > 0 && len(b) < p.MinRanked {
+		return fmt.Errorf("%w: got %v ranked choices, want at least %v", ErrBallotPolicyViolation, len(b), p.MinRanked)
+	}
+	if p.MaxRanked > 0 && len(b) > p.MaxRanked {
+		return fmt.Errorf("%w: got %v ranked choices, want at most %v", ErrBallotPolicyViolation, len(b), p.MaxRanked)
+	}
+	if p.DisallowEqualRanks {
+		ranks := make(map[int]struct{}, len(b))
+		for _, rank := range b {
+			if _, ok := ranks[rank]; ok {
+				return fmt.Errorf("%w: multiple choices with rank %v", ErrBallotPolicyViolation, rank)
+			}
+			ranks[rank] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// check returns an error if votes are not accepted at the time.
+func (s Schedule) check(t time.Time) error {
+	if s.Opens != nil && t.Before(*s.Opens) {
+		return ErrElectionNotOpen
+	}
+	if s.Closes != nil && !t.Before(*s.Closes) {
+		return ErrElectionClosed
+	}
+	return nil
+}
+
+var (
+	strengthVariantNames = map[StrengthVariant]string{
+		StrengthWinningVotes: "winning-votes",
+		StrengthMargins:      "margins",
+	}
+	tieBreakNames = map[TieBreak]string{
+		TieBreakNone:   "none",
+		TieBreakIndex:  "index",
+		TieBreakRandom: "random",
+	}
+)
+
+// String returns the name of the strength variant.
+func (v StrengthVariant) String() string {
+	if name, ok := strengthVariantNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("StrengthVariant(%d)", int(v))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v StrengthVariant) MarshalText() ([]byte, error) {
+	name, ok := strengthVariantNames[v]
+	if !ok {
+		return nil, fmt.Errorf("schulze: unknown strength variant %v", int(v))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *StrengthVariant) UnmarshalText(text []byte) error {
+	for variant, name := range strengthVariantNames {
+		if name == string(text) {
+			*v = variant
+			return nil
+		}
+	}
+	return fmt.Errorf("schulze: unknown strength variant %q", text)
+}
+
+// String returns the name of the tie-break rule.
+func (t TieBreak) String() string {
+	if name, ok := tieBreakNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("TieBreak(%d)", int(t))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t TieBreak) MarshalText() ([]byte, error) {
+	name, ok := tieBreakNames[t]
+	if !ok {
+		return nil, fmt.Errorf("schulze: unknown tie-break %v", int(t))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *TieBreak) UnmarshalText(text []byte) error {
+	for tieBreak, name := range tieBreakNames {
+		if name == string(text) {
+			*t = tieBreak
+			return nil
+		}
+	}
+	return fmt.Errorf("schulze: unknown tie-break %q", text)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElectionConfig_JSON(t *testing.T) {
+	opens := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	config := schulze.ElectionConfig[string]{
+		Name:    "board",
+		Choices: []string{"A", "B", "C"},
+		BallotPolicy: schulze.BallotPolicy{
+			MinRanked:          1,
+			MaxRanked:          2,
+			DisallowEqualRanks: true,
+		},
+		StrengthVariant: schulze.StrengthMargins,
+		TieBreak:        schulze.TieBreakRandom,
+		RandomSeed:      42,
+		Quorum:          10,
+		Schedule: schulze.Schedule{
+			Opens: &opens,
+		},
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"board","choices":["A","B","C"],"ballotPolicy":{"minRanked":1,"maxRanked":2,"disallowEqualRanks":true},"strengthVariant":"margins","tieBreak":"random","randomSeed":42,"quorum":10,"schedule":{"opens":"2026-01-01T00:00:00Z"}}`
+	if string(data) != want {
+		t.Errorf("got json %s, want %s", data, want)
+	}
+
+	var decoded schulze.ElectionConfig[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, config) {
+		t.Errorf("got config %+v, want %+v", decoded, config)
+	}
+
+	if err := json.Unmarshal([]byte(`{"tieBreak":"coin"}`), &decoded); err == nil {
+		t.Error("expected error for unknown tie-break")
+	}
+}
+
+func TestNewElectionFromConfig(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:    "board",
+		Choices: []string{"A", "B", "C"},
+		BallotPolicy: schulze.BallotPolicy{
+			MaxRanked:          2,
+			DisallowEqualRanks: true,
+		},
+		TieBreak: schulze.TieBreakIndex,
+		Quorum:   2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"A": 1, "B": 1},
+	} {
+		if _, err := e.Vote("alice", b); !errors.Is(err, schulze.ErrBallotPolicyViolation) {
+			t.Errorf("ballot %v: got error %v, want %v", b, err, schulze.ErrBallotPolicyViolation)
+		}
+	}
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if e.QuorumReached() {
+		t.Error("quorum reached with a single voter")
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if !e.QuorumReached() {
+		t.Error("quorum not reached")
+	}
+
+	results, _, tie, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tie {
+		t.Error("got tie with index tie-break")
+	}
+	if results[0].Choice != "A" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+	}
+
+	e.SetChoices([]string{"A", "B", "C", "D"})
+	config := e.Config()
+	if want := []string{"A", "B", "C", "D"}; !reflect.DeepEqual(config.Choices, want) {
+		t.Errorf("got config choices %v, want %v", config.Choices, want)
+	}
+	if config.Name != "board" || config.Quorum != 2 || config.TieBreak != schulze.TieBreakIndex {
+		t.Errorf("got config %+v", config)
+	}
+}
+
+func TestNewElectionFromConfig_schedule(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	for _, tc := range []struct {
+		name     string
+		schedule schulze.Schedule
+		want     error
+	}{
+		{name: "open", schedule: schulze.Schedule{Opens: &past, Closes: &future}},
+		{name: "not open", schedule: schulze.Schedule{Opens: &future}, want: schulze.ErrElectionNotOpen},
+		{name: "closed", schedule: schulze.Schedule{Closes: &past}, want: schulze.ErrElectionClosed},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+				Choices:  []string{"A", "B"},
+				Schedule: tc.schedule,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); !errors.Is(err, tc.want) {
+				t.Errorf("got error %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewElectionFromConfig_invalid(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	for _, tc := range []struct {
+		name   string
+		config schulze.ElectionConfig[string]
+	}{
+		{name: "negative min ranked", config: schulze.ElectionConfig[string]{BallotPolicy: schulze.BallotPolicy{MinRanked: -1}}},
+		{name: "max ranked less than min", config: schulze.ElectionConfig[string]{Choices: []string{"A", "B", "C"}, BallotPolicy: schulze.BallotPolicy{MinRanked: 2, MaxRanked: 1}}},
+		{name: "min ranked greater than choices", config: schulze.ElectionConfig[string]{Choices: []string{"A"}, BallotPolicy: schulze.BallotPolicy{MinRanked: 2}}},
+		{name: "unknown strength variant", config: schulze.ElectionConfig[string]{StrengthVariant: 5}},
+		{name: "unknown tie-break", config: schulze.ElectionConfig[string]{TieBreak: 5}},
+		{name: "negative quorum", config: schulze.ElectionConfig[string]{Quorum: -1}},
+		{name: "negative nomination threshold", config: schulze.ElectionConfig[string]{NominationThreshold: -1}},
+		{name: "closes before opens", config: schulze.ElectionConfig[string]{Schedule: schulze.Schedule{Opens: &future, Closes: &past}}},
+		{name: "challenge window without close", config: schulze.ElectionConfig[string]{Schedule: schulze.Schedule{ChallengeEnds: &future}}},
+		{name: "challenge window ends before close", config: schulze.ElectionConfig[string]{Schedule: schulze.Schedule{Closes: &future, ChallengeEnds: &past}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := schulze.NewElectionFromConfig[string](tc.config)
+			if !errors.Is(err, schulze.ErrInvalidElectionConfig) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidElectionConfig)
+			}
+		})
+	}
+}
+
+func TestWithTieBreak(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	preferences := make([]int, len(choices)*len(choices))
+
+	results, _, tie := schulze.Compute(preferences, choices)
+	if !tie {
+		t.Error("expected tie without tie-break")
+	}
+
+	results, _, tie = schulze.Compute(preferences, choices, schulze.WithTieBreak[string](schulze.TieBreakIndex))
+	if tie {
+		t.Error("got tie with index tie-break")
+	}
+	if results[0].Choice != "A" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+	}
+
+	want, _, _ := schulze.Compute(preferences, choices, schulze.WithTieBreak[string](schulze.TieBreakRandom), schulze.WithRandomSeed[string](7))
+	for i := 0; i < 10; i++ {
+		results, _, tie := schulze.Compute(preferences, choices, schulze.WithTieBreak[string](schulze.TieBreakRandom), schulze.WithRandomSeed[string](7))
+		if tie {
+			t.Error("got tie with random tie-break")
+		}
+		if !reflect.DeepEqual(results, want) {
+			t.Errorf("got results %+v, want %+v with the same seed", results, want)
+		}
+	}
+}
+
+func TestWithStrengthVariant(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	preferences := []int{
+		0, 8, 4,
+		2, 0, 10,
+		6, 0, 0,
+	}
+	// margins of the preferences above, where winning votes and margins
+	// strengths are the same
+	margins := []int{
+		0, 6, 0,
+		0, 0, 10,
+		2, 0, 0,
+	}
+
+	want, _, wantTie := schulze.Compute(margins, choices)
+
+	results, _, tie := schulze.Compute(preferences, choices, schulze.WithStrengthVariant[string](schulze.StrengthMargins))
+	if tie != wantTie {
+		t.Errorf("got tie %v, want %v", tie, wantTie)
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("got results %+v, want %+v", results, want)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package conformance provides the suite of canonical elections with the
+// SHA-256 digests of their serialized results, which must be byte-identical
+// on every architecture and with every Go version. It is intended to be run
+// in the continuous integration of applications before a production upgrade
+// of the Go toolchain or the schulze module, or when deploying to a new
+// architecture, with a test like:
+//
+//	func TestSchulzeConformance(t *testing.T) {
+//		conformance.Test(t)
+//	}
+//
+// Results of every case are serialized as JSON with the result document and
+// the state hash of the election, without the version of the module that
+// produced them.
+package conformance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+// ErrMismatch is returned, wrapped with the name of the case, when the
+// digest of the serialized results differs from the expected one.
+var ErrMismatch = errors.New("conformance: results mismatch")
+
+// Case is a canonical election with the expected digest of its serialized
+// results.
+type Case struct {
+	Name   string
+	Config schulze.ElectionConfig[string]
+	// Ballots in the compact text format of the schulze.ParseBallot function,
+	// cast by different voters in the order of the slice.
+	Ballots []string
+	// Hex encoded SHA-256 digest of the serialized results.
+	Digest string
+}
+
+// Cases returns all canonical elections of the suite.
+func Cases() []Case {
+	return append([]Case(nil), cases...)
+}
+
+// Serialize runs the election of the case and returns its serialized
+// results.
+func (c Case) Serialize() ([]byte, error) {
+	e, err := schulze.NewElectionFromConfig[string](c.Config)
+	if err != nil {
+		return nil, err
+	}
+	for i, s := range c.Ballots {
+		b, err := schulze.ParseBallot(s)
+		if err != nil {
+			return nil, fmt.Errorf("ballot %v: %w", i, err)
+		}
+		if _, err := e.Vote("voter-"+strconv.Itoa(i+1), b); err != nil {
+			return nil, fmt.Errorf("ballot %v: %w", i, err)
+		}
+	}
+	d, err := e.ResultDocument()
+	if err != nil {
+		return nil, err
+	}
+	// the software version differs between builds
+	d.SoftwareVersion = ""
+	return json.Marshal(struct {
+		Document  schulze.ResultDocument[string] `json:"document"`
+		StateHash string                         `json:"stateHash"`
+	}{
+		Document:  d,
+		StateHash: hex.EncodeToString(e.StateHash()),
+	})
+}
+
+// Check serializes the results of the case and returns the error that wraps
+// ErrMismatch if their digest differs from the expected one.
+func (c Case) Check() error {
+	data, err := c.Serialize()
+	if err != nil {
+		return fmt.Errorf("conformance: %s: %w", c.Name, err)
+	}
+	if got := digest(data); got != c.Digest {
+		return fmt.Errorf("%w: %s: got digest %s, want %s", ErrMismatch, c.Name, got, c.Digest)
+	}
+	return nil
+}
+
+// Verify checks all cases and returns the error of the first case that
+// does not conform.
+func Verify() error {
+	for _, c := range cases {
+		if err := c.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Test checks all cases as subtests of the test, reporting the serialized
+// results of the cases that do not conform.
+func Test(t *testing.T) {
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			if err := c.Check(); err != nil {
+				data, _ := c.Serialize()
+				t.Errorf("%v\n%s", err, data)
+			}
+		})
+	}
+}
+
+func digest(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+// wikipedia are the ballots of the example from the Schulze method Wikipedia
+// article.
+var wikipedia = concat(
+	repeat(5, "A > C > B > E > D"),
+	repeat(5, "A > D > E > C > B"),
+	repeat(8, "B > E > D > A > C"),
+	repeat(3, "C > A > B > E > D"),
+	repeat(7, "C > A > E > B > D"),
+	repeat(2, "C > B > A > D > E"),
+	repeat(7, "D > C > E > B > A"),
+	repeat(8, "E > B > A > D > C"),
+)
+
+var cases = []Case{
+	{
+		Name: "empty",
+		Config: schulze.ElectionConfig[string]{
+			Choices: []string{"A", "B", "C"},
+		},
+		Digest: "ff116abfe45ee4086f074716067800e7661a5e253253d1022cb6b2a8d502f4bc",
+	},
+	{
+		Name: "wikipedia",
+		Config: schulze.ElectionConfig[string]{
+			Name:    "wikipedia",
+			Choices: []string{"A", "B", "C", "D", "E"},
+		},
+		Ballots: wikipedia,
+		Digest:  "518515e3d30a461ba3d1cdb5a682345d532a01d44e02a8852c8365b7a4945426",
+	},
+	{
+		Name: "wikipedia margins",
+		Config: schulze.ElectionConfig[string]{
+			Name:            "wikipedia",
+			Choices:         []string{"A", "B", "C", "D", "E"},
+			StrengthVariant: schulze.StrengthMargins,
+		},
+		Ballots: wikipedia,
+		Digest:  "3cd279526cf2f8a0c05c4a856ae307cc54c13c34f51b5b4876156a9585ae13ef",
+	},
+	{
+		Name: "equal ranks and abstentions",
+		Config: schulze.ElectionConfig[string]{
+			Choices: []string{"A", "B", "C", "D"},
+		},
+		Ballots: []string{"A = B", "A = B", "C > A", "B > D", "B > D", "B > D", "", "D > C = A > B"},
+		Digest:  "9309cde56ace48a80af10039e184f3990564538b96569fca7cccb1d138e960c3",
+	},
+	{
+		Name: "tie",
+		Config: schulze.ElectionConfig[string]{
+			Choices: []string{"A", "B", "C"},
+		},
+		Ballots: []string{"A > B > C", "B > C > A", "C > A > B"},
+		Digest:  "a2b148d69f2a2ba7f594a47ce0b47f310bbed71fe03447f8d45502bbe4a4cb55",
+	},
+	{
+		Name: "tie-break by index",
+		Config: schulze.ElectionConfig[string]{
+			Choices:  []string{"A", "B", "C"},
+			TieBreak: schulze.TieBreakIndex,
+		},
+		Ballots: []string{"A > B > C", "B > C > A", "C > A > B"},
+		Digest:  "92f8845255d1c25284c19c432078fc90fe44e85336a16d6a4090238a567d05c9",
+	},
+	{
+		Name: "random tie-break",
+		Config: schulze.ElectionConfig[string]{
+			Choices:    []string{"A", "B", "C", "D"},
+			TieBreak:   schulze.TieBreakRandom,
+			RandomSeed: 2026,
+		},
+		Ballots: []string{"A > B", "B > A", "C = D > A = B", "A = B > C = D"},
+		Digest:  "71c4643b2d96aedd898d427be76d02d34fd6c5b08fd0b44be501d1d8db72f31d",
+	},
+	{
+		Name: "unicode choices",
+		Config: schulze.ElectionConfig[string]{
+			Choices: []string{"Janoš", "Đorđe", "Ærø", "日本"},
+		},
+		Ballots: []string{"日本 > Janoš", "Đorđe > Ærø > 日本", "Janoš = Ærø > Đorđe", "日本 > Đorđe"},
+		Digest:  "95ac8dbe0f89eca2878888b330a1749136db03736c4930cf6793d7bfba3c9e01",
+	},
+}
+
+// repeat returns the ballot repeated the number of times.
+func repeat(count int, ballot string) []string {
+	ballots := make([]string, count)
+	for i := range ballots {
+		ballots[i] = ballot
+	}
+	return ballots
+}
+
+func concat(ballots ...[]string) []string {
+	var all []string
+	for _, b := range ballots {
+		all = append(all, b...)
+	}
+	return all
+}
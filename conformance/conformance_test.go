@@ -0,0 +1,31 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conformance_test
+
+import (
+	"errors"
+	"testing"
+
+	"resenje.org/schulze/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	conformance.Test(t)
+}
+
+func TestVerify(t *testing.T) {
+	if err := conformance.Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCase_Check(t *testing.T) {
+	c := conformance.Cases()[1]
+	c.Ballots = c.Ballots[1:]
+	if err := c.Check(); !errors.Is(err, conformance.ErrMismatch) {
+		t.Errorf("got error %v, want %v", err, conformance.ErrMismatch)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WritePreferencesCSV writes the pairwise preferences matrix as CSV with the
+// choices as labels of the first row and the first column. The value in the
+// row of one choice and the column of another one is the number of ballots
+// that prefer the former choice over the latter. Diagonal cells are empty.
+func WritePreferencesCSV[C comparable](w io.Writer, preferences []int, choices []C) error {
+	return writeMatrixCSV(w, preferences, choices)
+}
+
+// WriteStrengthsCSV writes the matrix of the strongest paths strengths
+// calculated from the pairwise preferences as CSV with the choices as labels
+// of the first row and the first column. The value in the row of one choice
+// and the column of another one is the strength of the strongest path from the
+// former choice to the latter. Diagonal cells are empty.
+func WriteStrengthsCSV[C comparable](w io.Writer, preferences []int, choices []C) error {
+	return writeMatrixCSV(w, calculatePairwiseStrengths(choices, preferences, LayoutRowMajor, nil), choices)
+}
+
+// WritePreferencesCSV writes the pairwise preferences matrix of the voting as
+// CSV in the same format as the WritePreferencesCSV function.
+func (v *Voting[C]) WritePreferencesCSV(w io.Writer) error {
+	return WritePreferencesCSV(w, v.preferences, v.choices)
+}
+
+// WriteStrengthsCSV writes the matrix of the strongest paths strengths of the
+// voting as CSV in the same format as the WriteStrengthsCSV function.
+func (v *Voting[C]) WriteStrengthsCSV(w io.Writer) error {
+	v.compute()
+	return writeMatrixCSV(w, v.strengths, v.choices)
+}
+
+func writeMatrixCSV[C comparable](w io.Writer, matrix []int, choices []C) error {
+	choicesCount := len(choices)
+
+	cw := csv.NewWriter(w)
+
+	row := make([]string, choicesCount+1)
+	for i, c := range choices {
+		row[i+1] = fmt.Sprint(c)
+	}
+	if err := cw.Write(row); err != nil {
+		return err
+	}
+
+	for i, c := range choices {
+		row[0] = fmt.Sprint(c)
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				row[j+1] = ""
+				continue
+			}
+			row[j+1] = strconv.Itoa(matrix[i*choicesCount+j])
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// BallotsCSVReader reads ballots from CSV, such as survey exports, where the
+// first row has the choices as column labels and every other row is a
+// single ballot. The cell in the column of a choice is its rank, where a
+// lower rank is more preferred, or empty if the choice is not ranked.
+// Whitespace around labels and ranks is ignored.
+type BallotsCSVReader struct {
+	r       *csv.Reader
+	choices []string
+}
+
+// NewBallotsCSVReader reads the header row of the CSV and returns the reader
+// of ballots in the following rows. ErrInvalidBallotsCSV is returned if the
+// header has an empty or duplicate choice.
+func NewBallotsCSVReader(r io.Reader) (*BallotsCSVReader, error) {
+	cr := csv.NewReader(r)
+	// the number of fields is validated for every ballot
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: missing header", ErrInvalidBallotsCSV)
+		}
+		return nil, err
+	}
+	choices := make([]string, len(header))
+	seen := make(map[string]struct{}, len(header))
+	for i, c := range header {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			return nil, fmt.Errorf("%w: empty choice in column %v", ErrInvalidBallotsCSV, i+1)
+		}
+		if _, ok := seen[c]; ok {
+			return nil, fmt.Errorf("%w: duplicate choice %q", ErrInvalidBallotsCSV, c)
+		}
+		seen[c] = struct{}{}
+		choices[i] = c
+	}
+	return &BallotsCSVReader{
+		r:       cr,
+		choices: choices,
+	}, nil
+}
+
+// Choices returns the choices from the header row.
+func (r *BallotsCSVReader) Choices() []string {
+	return append([]string(nil), r.choices...)
+}
+
+// Read returns the ballot of the next row, or io.EOF if there are no more
+// rows. If the row has a different number of cells than the header or a
+// rank that is not an integer, *BallotRowError is returned, and the reading
+// can continue with the next row. Other errors are returned if the CSV is
+// malformed.
+func (r *BallotsCSVReader) Read() (Ballot[string], error) {
+	record, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	row, _ := r.r.FieldPos(0)
+	if len(record) != len(r.choices) {
+		return nil, &BallotRowError{
+			Row: row,
+			Err: fmt.Errorf("%w: got %v cells, want %v", ErrInvalidBallotsCSV, len(record), len(r.choices)),
+		}
+	}
+	b := make(Ballot[string])
+	for i, cell := range record {
+		cell = strings.TrimSpace(cell)
+		if cell == "" {
+			continue
+		}
+		rank, err := strconv.Atoi(cell)
+		if err != nil {
+			return nil, &BallotRowError{
+				Row: row,
+				Err: fmt.Errorf("%w: invalid rank %q of choice %q", ErrInvalidBallotsCSV, cell, r.choices[i]),
+			}
+		}
+		b[r.choices[i]] = rank
+	}
+	return b, nil
+}
+
+// VoteBallotsCSV reads ballots from the CSV in the format of the
+// BallotsCSVReader and votes every one of them with the vote function, such
+// as the Vote method of the Voting. Rows that can not be read or voted are
+// skipped and reported as *BallotRowError in rowErrs. The returned error is
+// not nil only if the CSV header is not valid or the CSV is malformed, in
+// which case ballots of the previous rows are already voted.
+func VoteBallotsCSV(r io.Reader, vote func(b Ballot[string]) (Record[string], error)) (voted int, rowErrs []*BallotRowError, err error) {
+	br, err := NewBallotsCSVReader(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	for {
+		b, err := br.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return voted, rowErrs, nil
+			}
+			var rowErr *BallotRowError
+			if errors.As(err, &rowErr) {
+				rowErrs = append(rowErrs, rowErr)
+				continue
+			}
+			return voted, rowErrs, err
+		}
+		if _, err := vote(b); err != nil {
+			row, _ := br.r.FieldPos(0)
+			rowErrs = append(rowErrs, &BallotRowError{Row: row, Err: err})
+			continue
+		}
+		voted++
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestWriteCSV(t *testing.T) {
+	choices := []string{"A", "B", "C, D"}
+	ballots := []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"B": 1, "C, D": 2},
+		{"C, D": 1, "A": 2},
+		{"A": 1},
+	}
+
+	preferences := schulze.NewPreferences(len(choices))
+	v := schulze.NewVoting(choices)
+	for _, b := range ballots {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wantPreferences := `,A,B,"C, D"
+A,,3,2
+B,1,,2
+"C, D",2,1,
+`
+	wantStrengths := `,A,B,"C, D"
+A,,3,2
+B,0,,2
+"C, D",0,0,
+`
+
+	for _, tc := range []struct {
+		name  string
+		write func(*bytes.Buffer) error
+		want  string
+	}{
+		{
+			name:  "preferences functional",
+			write: func(b *bytes.Buffer) error { return schulze.WritePreferencesCSV(b, preferences, choices) },
+			want:  wantPreferences,
+		},
+		{
+			name:  "preferences Voting",
+			write: func(b *bytes.Buffer) error { return v.WritePreferencesCSV(b) },
+			want:  wantPreferences,
+		},
+		{
+			name:  "strengths functional",
+			write: func(b *bytes.Buffer) error { return schulze.WriteStrengthsCSV(b, preferences, choices) },
+			want:  wantStrengths,
+		},
+		{
+			name:  "strengths Voting",
+			write: func(b *bytes.Buffer) error { return v.WriteStrengthsCSV(b) },
+			want:  wantStrengths,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tc.write(&buf); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestVoteBallotsCSV(t *testing.T) {
+	data := `A, B ,C
+1,2,
+,1,1
+2,x,1
+1,2
+3,2,1
+"1",,"2"
+,,
+`
+	v := schulze.NewVoting([]string{"A", "B", "C"})
+	voted, rowErrs, err := schulze.VoteBallotsCSV(strings.NewReader(data), v.Vote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if voted != 5 {
+		t.Errorf("got %v voted ballots, want 5", voted)
+	}
+	if len(rowErrs) != 2 {
+		t.Fatalf("got row errors %v, want 2", rowErrs)
+	}
+	for i, want := range []int{4, 5} {
+		if rowErrs[i].Row != want || !errors.Is(rowErrs[i], schulze.ErrInvalidBallotsCSV) {
+			t.Errorf("got row error %v, want invalid row %v", rowErrs[i], want)
+		}
+	}
+
+	want := schulze.NewVoting([]string{"A", "B", "C"})
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"B": 1, "C": 1},
+		{"A": 3, "B": 2, "C": 1},
+		{"A": 1, "C": 2},
+		{},
+	} {
+		if _, err := want.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(v.StateHash(), want.StateHash()) {
+		t.Error("got different preferences")
+	}
+}
+
+func TestVoteBallotsCSV_voteError(t *testing.T) {
+	v := schulze.NewVoting([]string{"A", "B"})
+	voted, rowErrs, err := schulze.VoteBallotsCSV(strings.NewReader("A,B,C\n1,2,3\n1,,\n"), v.Vote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if voted != 1 {
+		t.Errorf("got %v voted ballots, want 1", voted)
+	}
+	var unknownErr *schulze.UnknownChoiceError[string]
+	if len(rowErrs) != 1 || rowErrs[0].Row != 2 || !errors.As(rowErrs[0], &unknownErr) {
+		t.Errorf("got row errors %v, want unknown choice in row 2", rowErrs)
+	}
+}
+
+func TestNewBallotsCSVReader_invalid(t *testing.T) {
+	for _, data := range []string{"", "A,,B\n", "A,B,A\n"} {
+		if _, err := schulze.NewBallotsCSVReader(strings.NewReader(data)); !errors.Is(err, schulze.ErrInvalidBallotsCSV) {
+			t.Errorf("%q: got error %v, want %v", data, err, schulze.ErrInvalidBallotsCSV)
+		}
+	}
+
+	r, err := schulze.NewBallotsCSVReader(strings.NewReader("A,B\n\"1,2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Choices(); !reflect.DeepEqual(got, []string{"A", "B"}) {
+		t.Errorf("got choices %v", got)
+	}
+	if _, err := r.Read(); err == nil || errors.Is(err, io.EOF) {
+		t.Errorf("got error %v, want malformed csv", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dao adapts Schulze voting to token-weighted governance, where the
+// weight of every voter's ballot is the voter's token balance at the snapshot
+// block of the proposal, read from a BalanceProvider when the proposal is
+// closed.
+//
+// Reading balances at the snapshot block, that is fixed when the proposal is
+// created, instead of at the block when the proposal is closed, prevents the
+// acquisition of tokens only to influence an ongoing vote.
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"resenje.org/schulze"
+)
+
+var (
+	// ErrProposalClosed is returned when a ballot is cast to a proposal that
+	// is closed.
+	ErrProposalClosed = errors.New("dao: proposal closed")
+	// ErrNegativeBalance is returned when the BalanceProvider returns a
+	// negative balance.
+	ErrNegativeBalance = errors.New("dao: negative balance")
+)
+
+// BalanceProvider provides token balances of voters, for example from an
+// on-chain token contract or an off-chain indexer.
+type BalanceProvider[V comparable] interface {
+	// BalanceAt returns the balance of the voter at the block.
+	BalanceAt(ctx context.Context, voter V, block uint64) (*big.Int, error)
+}
+
+// Proposal collects ballots of voters that are weighted by their balances
+// when the proposal is closed. Methods on the Proposal type are not safe for
+// concurrent calls.
+type Proposal[V, C comparable] struct {
+	choices       []C
+	snapshotBlock uint64
+	ballots       map[V]schulze.Ballot[C]
+	options       options
+	tally         *Tally[V, C]
+}
+
+// Tally holds the weighted results of a closed proposal.
+type Tally[V, C comparable] struct {
+	// Block at which the balances were read.
+	SnapshotBlock uint64
+	Results       []schulze.BigResult[C]
+	Tie           bool
+	// Balances of voters at the snapshot block.
+	Balances map[V]*big.Int
+	// Weights of voters with positive weights, transformed from balances.
+	Weights map[V]*big.Int
+	// Sum of all weights.
+	TotalWeight *big.Int
+	// Number of ballots that were not counted as their weights are zero.
+	ZeroWeightBallots int
+}
+
+// NewProposal creates a new proposal for the choices with balances read at
+// the snapshot block.
+func NewProposal[V, C comparable](choices []C, snapshotBlock uint64, opts ...Option) *Proposal[V, C] {
+	o := options{
+		weightTransform: Identity,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Proposal[V, C]{
+		choices:       choices,
+		snapshotBlock: snapshotBlock,
+		ballots:       make(map[V]schulze.Ballot[C]),
+		options:       o,
+	}
+}
+
+// SnapshotBlock returns the block at which the balances are read.
+func (p *Proposal[V, C]) SnapshotBlock() uint64 {
+	return p.snapshotBlock
+}
+
+// Vote adds or replaces the voter's ballot. The ballot is validated, but its
+// weight is known only when the proposal is closed. ErrProposalClosed is
+// returned if the proposal is closed.
+func (p *Proposal[V, C]) Vote(voter V, b schulze.Ballot[C]) error {
+	if p.tally != nil {
+		return ErrProposalClosed
+	}
+	// validate the ballot
+	if _, err := schulze.Vote(schulze.NewPreferences(len(p.choices)), p.choices, b); err != nil {
+		return err
+	}
+	c := make(schulze.Ballot[C], len(b))
+	for choice, rank := range b {
+		c[choice] = rank
+	}
+	p.ballots[voter] = c
+	return nil
+}
+
+// Unvote removes the voter's ballot. ErrProposalClosed is returned if the
+// proposal is closed.
+func (p *Proposal[V, C]) Unvote(voter V) error {
+	if p.tally != nil {
+		return ErrProposalClosed
+	}
+	delete(p.ballots, voter)
+	return nil
+}
+
+// VotersCount returns the number of voters that voted.
+func (p *Proposal[V, C]) VotersCount() int {
+	return len(p.ballots)
+}
+
+// Close reads balances of all voters at the snapshot block from the provider
+// and tallies their ballots weighted by the balances, transformed by the
+// weight transform. Ballots with zero weights are not counted. After a successful close, no more ballots
+// are accepted and subsequent calls return the same tally. If reading of a
+// balance fails, the proposal remains open and the error is returned.
+func (p *Proposal[V, C]) Close(ctx context.Context, provider BalanceProvider[V]) (*Tally[V, C], error) {
+	if p.tally != nil {
+		return p.tally, nil
+	}
+
+	v := schulze.NewBigVoting(p.choices)
+	t := &Tally[V, C]{
+		SnapshotBlock: p.snapshotBlock,
+		Balances:      make(map[V]*big.Int),
+		Weights:       make(map[V]*big.Int),
+		TotalWeight:   new(big.Int),
+	}
+	for voter, b := range p.ballots {
+		balance, err := provider.BalanceAt(ctx, voter, p.snapshotBlock)
+		if err != nil {
+			return nil, fmt.Errorf("balance of %v at block %v: %w", voter, p.snapshotBlock, err)
+		}
+		if balance.Sign() < 0 {
+			return nil, fmt.Errorf("balance of %v at block %v: %w", voter, p.snapshotBlock, ErrNegativeBalance)
+		}
+		t.Balances[voter] = new(big.Int).Set(balance)
+		weight := p.options.weightTransform(t.Balances[voter])
+		if weight.Sign() <= 0 {
+			t.ZeroWeightBallots++
+			continue
+		}
+		if _, err := v.Vote(b, weight); err != nil {
+			return nil, fmt.Errorf("ballot of %v: %w", voter, err)
+		}
+		t.Weights[voter] = weight
+		t.TotalWeight.Add(t.TotalWeight, weight)
+	}
+	t.Results, t.Tie = v.Compute()
+
+	p.tally = t
+	return t, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/dao"
+)
+
+type balances map[uint64]map[string]*big.Int
+
+func (b balances) BalanceAt(_ context.Context, voter string, block uint64) (*big.Int, error) {
+	accounts, ok := b[block]
+	if !ok {
+		return nil, errors.New("unknown block")
+	}
+	if balance, ok := accounts[voter]; ok {
+		return balance, nil
+	}
+	return new(big.Int), nil
+}
+
+func TestProposal(t *testing.T) {
+	whale, _ := new(big.Int).SetString("1000000000000000000000000000000", 10)
+
+	provider := balances{
+		100: {
+			"alice": big.NewInt(3),
+			"bob":   big.NewInt(2),
+			"carol": big.NewInt(2),
+		},
+		// tokens acquired after the snapshot do not count
+		200: {
+			"alice": big.NewInt(3),
+			"bob":   big.NewInt(2),
+			"carol": big.NewInt(2),
+			"dave":  whale,
+		},
+	}
+
+	p := dao.NewProposal[string]([]string{"A", "B", "C"}, 100)
+
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"A": 1},
+		"bob":   {"B": 1},
+		"carol": {"C": 1, "B": 2},
+		"dave":  {"C": 1},
+		"erin":  {"A": 1},
+	} {
+		if err := p.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Unvote("erin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Vote("erin", schulze.Ballot[string]{"X": 1}); err == nil {
+		t.Error("expected unknown choice error")
+	}
+
+	if _, err := p.Close(context.Background(), balances{}); err == nil {
+		t.Fatal("expected balance error")
+	}
+	if err := p.Vote("erin", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Errorf("proposal closed after failed close: %v", err)
+	}
+
+	tally, err := p.Close(context.Background(), provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tally.SnapshotBlock != 100 {
+		t.Errorf("got snapshot block %v, want %v", tally.SnapshotBlock, 100)
+	}
+	// B beats A by 4 to 3, and C through A, as B and C are tied by 2 to 2
+	if tally.Results[0].Choice != "B" || tally.Tie {
+		t.Errorf("got results %+v", tally.Results)
+	}
+	if tally.TotalWeight.Cmp(big.NewInt(7)) != 0 {
+		t.Errorf("got total weight %v, want %v", tally.TotalWeight, 7)
+	}
+	if tally.ZeroWeightBallots != 2 {
+		t.Errorf("got %v zero weight ballots, want %v", tally.ZeroWeightBallots, 2)
+	}
+	if _, ok := tally.Weights["dave"]; ok {
+		t.Error("got weight of the voter without balance at the snapshot")
+	}
+
+	if err := p.Vote("frank", schulze.Ballot[string]{"A": 1}); !errors.Is(err, dao.ErrProposalClosed) {
+		t.Errorf("got error %v, want %v", err, dao.ErrProposalClosed)
+	}
+	again, err := p.Close(context.Background(), provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != tally {
+		t.Error("got a different tally after the close")
+	}
+}
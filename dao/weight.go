@@ -0,0 +1,64 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dao
+
+import "math/big"
+
+// WeightTransform returns the weight of a ballot from the voter's balance,
+// which is never negative. The balance must not be modified.
+type WeightTransform func(balance *big.Int) *big.Int
+
+// Identity is the default WeightTransform that returns the balance as the
+// weight.
+func Identity(balance *big.Int) *big.Int {
+	return new(big.Int).Set(balance)
+}
+
+// Quadratic is the WeightTransform of quadratic voting, returning the integer
+// square root of the balance as the weight, so that the cost of the voting
+// power grows quadratically.
+func Quadratic(balance *big.Int) *big.Int {
+	return new(big.Int).Sqrt(balance)
+}
+
+// Capped returns the WeightTransform that limits weights to the maximal
+// value.
+func Capped(max *big.Int) WeightTransform {
+	max = new(big.Int).Set(max)
+	return func(balance *big.Int) *big.Int {
+		if balance.Cmp(max) > 0 {
+			return new(big.Int).Set(max)
+		}
+		return new(big.Int).Set(balance)
+	}
+}
+
+// Compose returns the WeightTransform that applies the transforms in order,
+// for example the cap after the square root.
+func Compose(transforms ...WeightTransform) WeightTransform {
+	return func(balance *big.Int) *big.Int {
+		weight := new(big.Int).Set(balance)
+		for _, t := range transforms {
+			weight = t(weight)
+		}
+		return weight
+	}
+}
+
+// Option configures optional behavior of the Proposal.
+type Option func(*options)
+
+type options struct {
+	weightTransform WeightTransform
+}
+
+// WithWeightTransform sets the transform of balances to weights of ballots.
+// The default is Identity.
+func WithWeightTransform(t WeightTransform) Option {
+	return func(o *options) {
+		o.weightTransform = t
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dao_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/dao"
+)
+
+func TestWeightTransforms(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		transform dao.WeightTransform
+		balance   int64
+		want      int64
+	}{
+		{name: "identity", transform: dao.Identity, balance: 10, want: 10},
+		{name: "quadratic", transform: dao.Quadratic, balance: 10, want: 3},
+		{name: "quadratic square", transform: dao.Quadratic, balance: 16, want: 4},
+		{name: "quadratic zero", transform: dao.Quadratic, balance: 0, want: 0},
+		{name: "capped below", transform: dao.Capped(big.NewInt(5)), balance: 3, want: 3},
+		{name: "capped above", transform: dao.Capped(big.NewInt(5)), balance: 30, want: 5},
+		{name: "composed", transform: dao.Compose(dao.Quadratic, dao.Capped(big.NewInt(5))), balance: 100, want: 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			balance := big.NewInt(tc.balance)
+			got := tc.transform(balance)
+			if got.Cmp(big.NewInt(tc.want)) != 0 {
+				t.Errorf("got weight %v, want %v", got, tc.want)
+			}
+			if balance.Cmp(big.NewInt(tc.balance)) != 0 {
+				t.Errorf("balance modified to %v", balance)
+			}
+			got.SetInt64(-1)
+			if balance.Cmp(big.NewInt(tc.balance)) != 0 {
+				t.Errorf("balance modified through the weight to %v", balance)
+			}
+		})
+	}
+}
+
+func TestProposal_quadratic(t *testing.T) {
+	provider := balances{
+		1: {
+			"alice": big.NewInt(100),
+			"bob":   big.NewInt(36),
+			"carol": big.NewInt(36),
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		opts   []dao.Option
+		winner string
+		weight int64
+	}{
+		{name: "identity", winner: "A", weight: 100},
+		{name: "quadratic", opts: []dao.Option{dao.WithWeightTransform(dao.Quadratic)}, winner: "B", weight: 10},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := dao.NewProposal[string]([]string{"A", "B"}, 1, tc.opts...)
+			for voter, b := range map[string]schulze.Ballot[string]{
+				"alice": {"A": 1},
+				"bob":   {"B": 1},
+				"carol": {"B": 1},
+			} {
+				if err := p.Vote(voter, b); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tally, err := p.Close(context.Background(), provider)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tally.Results[0].Choice; got != tc.winner {
+				t.Errorf("got winner %v, want %v", got, tc.winner)
+			}
+			if got := tally.Weights["alice"]; got.Cmp(big.NewInt(tc.weight)) != 0 {
+				t.Errorf("got weight %v, want %v", got, tc.weight)
+			}
+			if got := tally.Balances["alice"]; got.Cmp(big.NewInt(100)) != 0 {
+				t.Errorf("got balance %v, want %v", got, 100)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "math"
+
+// SignificanceZ is the z-score threshold of the 95% confidence level that
+// can be passed to the Archive MarginChanges and Decay methods.
+const SignificanceZ = 1.96
+
+// MarginChange is the change of the pairwise margin between two choices from
+// one archived election to another.
+type MarginChange[C comparable] struct {
+	Choice   C
+	Opponent C
+	// Names of the compared elections.
+	From string
+	To   string
+	// Margins of the choice over the opponent in the compared elections,
+	// between -1 and 1, as the difference between the numbers of ballots
+	// that preferred the choice and the opponent, divided by the number of
+	// ballots that preferred one of them.
+	FromMargin float64
+	ToMargin   float64
+	// Numbers of ballots that preferred one of the choices in the compared
+	// elections.
+	FromBallots int
+	ToBallots   int
+	// Two-proportion z-score of the change of the share of ballots that
+	// preferred the choice, positive if the choice gained support.
+	Z float64
+	// True if the absolute z-score is not less than the threshold.
+	Significant bool
+}
+
+// MarginChanges compares pairwise margins of every pair of choices that are
+// in both archived elections with the names, in the order of the Archive
+// Choices, marking changes with the absolute z-score of at least the
+// threshold, such as SignificanceZ, as significant. UnknownElectionError is
+// returned if an election is not archived.
+func (a *Archive[C]) MarginChanges(from, to string, threshold float64) ([]MarginChange[C], error) {
+	var fromEntry, toEntry *ArchiveEntry[C]
+	for i := range a.entries {
+		switch a.entries[i].Name {
+		case from:
+			fromEntry = &a.entries[i]
+		case to:
+			toEntry = &a.entries[i]
+		}
+	}
+	if fromEntry == nil {
+		return nil, &UnknownElectionError{Name: from}
+	}
+	if toEntry == nil {
+		return nil, &UnknownElectionError{Name: to}
+	}
+	return marginChanges(a.Choices(), *fromEntry, *toEntry, threshold), nil
+}
+
+// Decay compares pairwise margins of recurring choices between every two
+// consecutive archived elections, just as MarginChanges does, to highlight
+// significant movements in long-running polls, such as the declining
+// popularity of a choice.
+func (a *Archive[C]) Decay(threshold float64) []MarginChange[C] {
+	choices := a.Choices()
+	var changes []MarginChange[C]
+	for i := 1; i < len(a.entries); i++ {
+		changes = append(changes, marginChanges(choices, a.entries[i-1], a.entries[i], threshold)...)
+	}
+	return changes
+}
+
+func marginChanges[C comparable](choices []C, from, to ArchiveEntry[C], threshold float64) []MarginChange[C] {
+	var changes []MarginChange[C]
+	for i, c := range choices {
+		for _, o := range choices[i+1:] {
+			fromFor, fromAgainst, ok := documentPreference(from.Document, c, o)
+			if !ok {
+				continue
+			}
+			toFor, toAgainst, ok := documentPreference(to.Document, c, o)
+			if !ok {
+				continue
+			}
+			m := MarginChange[C]{
+				Choice:      c,
+				Opponent:    o,
+				From:        from.Name,
+				To:          to.Name,
+				FromMargin:  pairwiseMargin(fromFor, fromAgainst),
+				ToMargin:    pairwiseMargin(toFor, toAgainst),
+				FromBallots: fromFor + fromAgainst,
+				ToBallots:   toFor + toAgainst,
+				Z:           proportionsZ(fromFor, fromFor+fromAgainst, toFor, toFor+toAgainst),
+			}
+			m.Significant = math.Abs(m.Z) >= threshold
+			changes = append(changes, m)
+		}
+	}
+	return changes
+}
+
+// documentPreference returns the numbers of ballots of the document that
+// preferred the choice over the opponent and the opponent over the choice,
+// and false if any of them is not a choice of the document.
+func documentPreference[C comparable](d ResultDocument[C], choice, opponent C) (preferred, opposed int, ok bool) {
+	i := getChoiceIndex(d.Choices, choice)
+	j := getChoiceIndex(d.Choices, opponent)
+	if i < 0 || j < 0 || int(i) >= len(d.Preferences) || int(j) >= len(d.Preferences) {
+		return 0, 0, false
+	}
+	return d.Preferences[i][j], d.Preferences[j][i], true
+}
+
+func pairwiseMargin(preferred, opposed int) float64 {
+	if preferred+opposed == 0 {
+		return 0
+	}
+	return float64(preferred-opposed) / float64(preferred+opposed)
+}
+
+// proportionsZ returns the pooled two-proportion z-score of the change from
+// x1 of n1 to x2 of n2, or zero if it is not defined.
+func proportionsZ(x1, n1, x2, n2 int) float64 {
+	if n1 == 0 || n2 == 0 {
+		return 0
+	}
+	p := float64(x1+x2) / float64(n1+n2)
+	se := math.Sqrt(p * (1 - p) * (1/float64(n1) + 1/float64(n2)))
+	if se == 0 {
+		return 0
+	}
+	return (float64(x2)/float64(n2) - float64(x1)/float64(n1)) / se
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestArchive_Decay(t *testing.T) {
+	a := schulze.NewArchive[string]()
+	add := func(t *testing.T, name string, month time.Month, choices []string, matrix [][]int) {
+		t.Helper()
+		preferences, err := schulze.ImportPairwise(matrix, choices)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := schulze.NewResultDocument(preferences, choices, 100)
+		d.Name = name
+		if err := a.Add(schulze.ArchiveEntry[string]{
+			Name:     name,
+			IssuedAt: time.Date(2026, month, 1, 0, 0, 0, 0, time.UTC),
+			Document: d,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add(t, "january", time.January, []string{"A", "B", "C"}, [][]int{
+		{0, 70, 50},
+		{30, 0, 50},
+		{50, 50, 0},
+	})
+	add(t, "february", time.February, []string{"A", "B"}, [][]int{
+		{0, 40},
+		{60, 0},
+	})
+	add(t, "march", time.March, []string{"A", "B", "C"}, [][]int{
+		{0, 38, 52},
+		{62, 0, 48},
+		{48, 52, 0},
+	})
+
+	changes := a.Decay(schulze.SignificanceZ)
+	// only A and B are in february, and C is not compared with elections
+	// that are not consecutive
+	if len(changes) != 2 {
+		t.Fatalf("got %v changes, want 2", len(changes))
+	}
+	c := changes[0]
+	if c.Choice != "A" || c.Opponent != "B" || c.From != "january" || c.To != "february" {
+		t.Errorf("got change %+v", c)
+	}
+	if c.FromMargin != 0.4 || math.Abs(c.ToMargin+0.2) > 1e-9 || c.FromBallots != 100 || c.ToBallots != 100 {
+		t.Errorf("got margins %+v", c)
+	}
+	// pooled proportion 0.55, standard error sqrt(0.55*0.45*0.02)
+	if want := -0.3 / math.Sqrt(0.55*0.45*0.02); math.Abs(c.Z-want) > 1e-9 || !c.Significant {
+		t.Errorf("got z-score %v, want %v", c.Z, want)
+	}
+	if c := changes[1]; c.From != "february" || c.To != "march" || c.Significant {
+		t.Errorf("got change %+v, want insignificant", c)
+	}
+
+	changes, err := a.MarginChanges("january", "march", schulze.SignificanceZ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 3 {
+		t.Fatalf("got %v changes, want 3", len(changes))
+	}
+	if c := changes[1]; c.Choice != "A" || c.Opponent != "C" || c.Significant {
+		t.Errorf("got change %+v, want insignificant A-C", c)
+	}
+
+	_, err = a.MarginChanges("january", "april", schulze.SignificanceZ)
+	var unknownErr *schulze.UnknownElectionError
+	if !errors.As(err, &unknownErr) || unknownErr.Name != "april" {
+		t.Errorf("got error %v, want unknown election april", err)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseDevoteeBallot parses the ballot from the line of the Debian devotee
+// tally sheet, such as "V: 21-3", against the choices in the order of the
+// options on the ballot. Every character of the vote is the rank of the
+// option at its position, a digit from 1 to 9 where 1 is the most preferred,
+// or - if the option is not ranked. Options after the end of the vote are
+// not ranked, and anything after the vote, such as the hash of the voter, is
+// ignored.
+func ParseDevoteeBallot[C comparable](line string, choices []C) (Ballot[C], error) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "V:") {
+		return nil, fmt.Errorf("%w: missing V: prefix", ErrInvalidDevoteeBallot)
+	}
+	fields := strings.Fields(strings.TrimPrefix(line, "V:"))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: missing vote", ErrInvalidDevoteeBallot)
+	}
+	vote := fields[0]
+	if len(vote) > len(choices) {
+		return nil, fmt.Errorf("%w: got %v options, want at most %v", ErrInvalidDevoteeBallot, len(vote), len(choices))
+	}
+	b := make(Ballot[C])
+	for i := 0; i < len(vote); i++ {
+		switch r := vote[i]; {
+		case r == '-':
+		case r >= '1' && r <= '9':
+			b[choices[i]] = int(r - '0')
+		default:
+			return nil, fmt.Errorf("%w: invalid rank %q of option %v", ErrInvalidDevoteeBallot, r, i+1)
+		}
+	}
+	return b, nil
+}
+
+// ReadDevoteeBallots reads ballots from all lines of the Debian devotee tally
+// sheet that start with "V:", just as the ParseDevoteeBallot function parses
+// them, ignoring all other lines. The returned error includes the number of
+// the line with the invalid ballot.
+func ReadDevoteeBallots[C comparable](r io.Reader, choices []C) ([]Ballot[C], error) {
+	var ballots []Ballot[C]
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		if !strings.HasPrefix(strings.TrimSpace(s.Text()), "V:") {
+			continue
+		}
+		b, err := ParseDevoteeBallot(s.Text(), choices)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %w", line, err)
+		}
+		ballots = append(ballots, b)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return ballots, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestParseDevoteeBallot(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	for _, tc := range []struct {
+		line string
+		want schulze.Ballot[string]
+	}{
+		{line: "V: 1324", want: schulze.Ballot[string]{"A": 1, "B": 3, "C": 2, "D": 4}},
+		{line: "V: 21-3", want: schulze.Ballot[string]{"A": 2, "B": 1, "D": 3}},
+		{line: "  V: 11-- 3f8a9b0c", want: schulze.Ballot[string]{"A": 1, "B": 1}},
+		{line: "V:1", want: schulze.Ballot[string]{"A": 1}},
+		{line: "V: ----", want: schulze.Ballot[string]{}},
+	} {
+		got, err := schulze.ParseDevoteeBallot(tc.line, choices)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.line, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: got ballot %v, want %v", tc.line, got, tc.want)
+		}
+	}
+
+	for _, line := range []string{"", "1234", "V:", "V: 12345", "V: 1x23", "V: 1023"} {
+		if _, err := schulze.ParseDevoteeBallot(line, choices); !errors.Is(err, schulze.ErrInvalidDevoteeBallot) {
+			t.Errorf("%q: got error %v, want %v", line, err, schulze.ErrInvalidDevoteeBallot)
+		}
+	}
+}
+
+func TestReadDevoteeBallots(t *testing.T) {
+	sheet := `Tally Sheet for the votes cast.
+
+   Option 1--------->: Choice A
+ /  Option 2-------->: Choice B
+ |/  Option 3------->: Further discussion
+ ||/
+V: 12-     0a1b2c3d
+V: 213     4e5f6a7b
+V: -11     8c9d0e1f
+`
+	choices := []string{"A", "B", "FD"}
+	ballots, err := schulze.ReadDevoteeBallots(strings.NewReader(sheet), choices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"A": 2, "B": 1, "FD": 3},
+		{"B": 1, "FD": 1},
+	}
+	if !reflect.DeepEqual(ballots, want) {
+		t.Errorf("got ballots %v, want %v", ballots, want)
+	}
+
+	_, err = schulze.ReadDevoteeBallots(strings.NewReader("V: 12\nV: 1234\n"), choices)
+	if !errors.Is(err, schulze.ErrInvalidDevoteeBallot) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("got error %v, want invalid ballot in line 2", err)
+	}
+}
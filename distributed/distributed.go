@@ -0,0 +1,290 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package distributed partitions the computation of the strongest paths
+// strengths of the Schulze method across multiple workers, for analyses of
+// preference datasets with enormous numbers of choices that do not fit the
+// time or memory budget of a single machine.
+//
+// The Coordinator assigns a contiguous block of rows of the strengths matrix
+// to every worker Node. In every iteration of the Floyd–Warshall algorithm,
+// the coordinator sends the pivot row to all workers, which update their rows
+// and the worker that owns the next pivot row returns it. When all iterations
+// are done, the coordinator assembles the final matrix from the rows of all
+// workers. The result is the same as of the schulze.PairwiseStrengths
+// function and it can be ranked with the schulze.RankFromStrengths function.
+//
+// Nodes may be in the same process, with NewLocalNode, or on remote machines,
+// with the HTTP protocol of the Handler and the HTTPNode.
+package distributed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	// ErrNoNodes is returned by the Coordinator without nodes.
+	ErrNoNodes = errors.New("distributed: no nodes")
+	// ErrInvalidTask is returned by the worker when the task or iteration
+	// is not valid.
+	ErrInvalidTask = errors.New("distributed: invalid task")
+)
+
+// Task is the assignment of a block of rows of the strengths matrix to a
+// worker.
+type Task struct {
+	// Number of choices, which is the number of columns of every row.
+	ChoicesCount int `json:"choicesCount"`
+	// Index of the first row of the block.
+	Start int `json:"start"`
+	// Index after the last row of the block.
+	End int `json:"end"`
+	// Initial strengths of the rows of the block.
+	Rows []int `json:"rows"`
+}
+
+// Node is a worker that computes the strengths of a block of rows, as
+// instructed by the Coordinator.
+type Node interface {
+	// Start assigns the task to the node, replacing the previous one.
+	Start(ctx context.Context, t Task) error
+	// Iterate updates the rows of the node with the pivot row of the
+	// iteration, returning the updated row of the next iteration if the node
+	// owns it, or nil otherwise.
+	Iterate(ctx context.Context, iteration int, pivot []int) (next []int, err error)
+	// Rows returns the rows of the node.
+	Rows(ctx context.Context) ([]int, error)
+}
+
+// Worker holds and updates a block of rows of the strengths matrix.
+type Worker struct {
+	task Task
+}
+
+// NewWorker returns a new Worker for the task. ErrInvalidTask is returned if
+// the task is not valid.
+func NewWorker(t Task) (*Worker, error) {
+	if t.ChoicesCount < 0 || t.Start < 0 || t.End < t.Start || t.End > t.ChoicesCount || len(t.Rows) != (t.End-t.Start)*t.ChoicesCount {
+		return nil, ErrInvalidTask
+	}
+	t.Rows = append([]int(nil), t.Rows...)
+	return &Worker{task: t}, nil
+}
+
+// Iterate updates the rows with the pivot row of the iteration and returns
+// the row of the next iteration if it is in the block, or nil otherwise.
+func (w *Worker) Iterate(iteration int, pivot []int) (next []int, err error) {
+	n := w.task.ChoicesCount
+	if iteration < 0 || iteration >= n || len(pivot) != n {
+		return nil, ErrInvalidTask
+	}
+	rows := w.task.Rows
+	for j := 0; j < w.task.End-w.task.Start; j++ {
+		row := rows[j*n : (j+1)*n]
+		ji := row[iteration]
+		for k, ik := range pivot {
+			if m := min(ji, ik); m > row[k] {
+				row[k] = m
+			}
+		}
+	}
+	if next := iteration + 1; next >= w.task.Start && next < w.task.End {
+		return w.row(next), nil
+	}
+	return nil, nil
+}
+
+// Rows returns the rows of the block.
+func (w *Worker) Rows() []int {
+	return append([]int(nil), w.task.Rows...)
+}
+
+func (w *Worker) row(i int) []int {
+	n := w.task.ChoicesCount
+	j := i - w.task.Start
+	return append(make([]int, 0, n), w.task.Rows[j*n:(j+1)*n]...)
+}
+
+// LocalNode is a Node that runs a Worker in the same process.
+type LocalNode struct {
+	mu     sync.Mutex
+	worker *Worker
+}
+
+// NewLocalNode returns a new LocalNode.
+func NewLocalNode() *LocalNode {
+	return new(LocalNode)
+}
+
+// Start implements the Node interface.
+func (n *LocalNode) Start(_ context.Context, t Task) error {
+	w, err := NewWorker(t)
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	n.worker = w
+	n.mu.Unlock()
+	return nil
+}
+
+// Iterate implements the Node interface.
+func (n *LocalNode) Iterate(_ context.Context, iteration int, pivot []int) ([]int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.worker == nil {
+		return nil, ErrInvalidTask
+	}
+	return n.worker.Iterate(iteration, pivot)
+}
+
+// Rows implements the Node interface.
+func (n *LocalNode) Rows(_ context.Context) ([]int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.worker == nil {
+		return nil, ErrInvalidTask
+	}
+	return n.worker.Rows(), nil
+}
+
+// Coordinator partitions the strengths computation across its nodes.
+type Coordinator struct {
+	nodes []Node
+}
+
+// NewCoordinator returns a new Coordinator for the nodes.
+func NewCoordinator(nodes ...Node) *Coordinator {
+	return &Coordinator{nodes: nodes}
+}
+
+// PathStrengths computes the strongest paths strengths from the preferences,
+// with the same layout as the preferences. Every node gets an equal block of
+// rows, and nodes without rows are not used.
+func (c *Coordinator) PathStrengths(ctx context.Context, preferences []int, choicesCount int) ([]int, error) {
+	if len(c.nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	n := choicesCount
+	if len(preferences) != n*n {
+		return nil, fmt.Errorf("distributed: got preferences length %v, want %v", len(preferences), n*n)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+
+	strengths := initialStrengths(preferences, n)
+
+	type block struct {
+		node       Node
+		start, end int
+	}
+	nodesCount := min(len(c.nodes), n)
+	blocks := make([]block, 0, nodesCount)
+	for i := 0; i < nodesCount; i++ {
+		blocks = append(blocks, block{
+			node:  c.nodes[i],
+			start: i * n / nodesCount,
+			end:   (i + 1) * n / nodesCount,
+		})
+	}
+
+	if err := forEach(len(blocks), func(i int) error {
+		b := blocks[i]
+		return b.node.Start(ctx, Task{
+			ChoicesCount: n,
+			Start:        b.start,
+			End:          b.end,
+			Rows:         strengths[b.start*n : b.end*n],
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	pivot := append([]int(nil), strengths[:n]...)
+	for iteration := 0; iteration < n; iteration++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var next []int
+		var mu sync.Mutex
+		if err := forEach(len(blocks), func(i int) error {
+			r, err := blocks[i].node.Iterate(ctx, iteration, pivot)
+			if err != nil {
+				return err
+			}
+			if r != nil {
+				mu.Lock()
+				next = r
+				mu.Unlock()
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if iteration+1 < n && len(next) != n {
+			return nil, fmt.Errorf("distributed: row %v not returned by its node", iteration+1)
+		}
+		pivot = next
+	}
+
+	if err := forEach(len(blocks), func(i int) error {
+		b := blocks[i]
+		rows, err := b.node.Rows(ctx)
+		if err != nil {
+			return err
+		}
+		if len(rows) != (b.end-b.start)*n {
+			return fmt.Errorf("distributed: got %v values of rows from %v to %v, want %v", len(rows), b.start, b.end, (b.end-b.start)*n)
+		}
+		copy(strengths[b.start*n:b.end*n], rows)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return strengths, nil
+}
+
+// initialStrengths returns the strengths of direct links between choices.
+func initialStrengths(preferences []int, n int) []int {
+	strengths := make([]int, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if c := preferences[i*n+j]; c > preferences[j*n+i] {
+				strengths[i*n+j] = c
+			}
+		}
+	}
+	return strengths
+}
+
+// forEach calls the function concurrently for every index and returns the
+// first error.
+func forEach(count int, f func(i int) error) error {
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		go func(i int) {
+			errs <- f(i)
+		}(i)
+	}
+	var first error
+	for i := 0; i < count; i++ {
+		if err := <-errs; err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distributed_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/distributed"
+)
+
+func TestCoordinator(t *testing.T) {
+	choices := make([]string, 37)
+	for i := range choices {
+		choices[i] = strconv.Itoa(i)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	preferences := schulze.NewPreferences(len(choices))
+	for i := 0; i < 200; i++ {
+		b := make(schulze.Ballot[string])
+		for _, c := range choices {
+			if r.Intn(3) > 0 {
+				b[c] = r.Intn(10)
+			}
+		}
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := schulze.PairwiseStrengths(preferences, choices)
+
+	remote := func(t *testing.T) distributed.Node {
+		s := httptest.NewServer(distributed.NewHandler())
+		t.Cleanup(s.Close)
+		return distributed.NewHTTPNode(s.URL, s.Client())
+	}
+
+	for _, tc := range []struct {
+		name  string
+		nodes func(t *testing.T) []distributed.Node
+	}{
+		{name: "single local", nodes: func(t *testing.T) []distributed.Node {
+			return []distributed.Node{distributed.NewLocalNode()}
+		}},
+		{name: "multiple local", nodes: func(t *testing.T) []distributed.Node {
+			return []distributed.Node{distributed.NewLocalNode(), distributed.NewLocalNode(), distributed.NewLocalNode()}
+		}},
+		{name: "more nodes than choices", nodes: func(t *testing.T) []distributed.Node {
+			nodes := make([]distributed.Node, 50)
+			for i := range nodes {
+				nodes[i] = distributed.NewLocalNode()
+			}
+			return nodes
+		}},
+		{name: "http", nodes: func(t *testing.T) []distributed.Node {
+			return []distributed.Node{remote(t), remote(t), distributed.NewLocalNode()}
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := distributed.NewCoordinator(tc.nodes(t)...)
+			got, err := c.PathStrengths(context.Background(), preferences, len(choices))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got strengths %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCoordinator_errors(t *testing.T) {
+	if _, err := distributed.NewCoordinator().PathStrengths(context.Background(), make([]int, 4), 2); !errors.Is(err, distributed.ErrNoNodes) {
+		t.Errorf("got error %v, want %v", err, distributed.ErrNoNodes)
+	}
+	if _, err := distributed.NewCoordinator(distributed.NewLocalNode()).PathStrengths(context.Background(), make([]int, 3), 2); err == nil {
+		t.Error("expected error for preferences length")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := distributed.NewCoordinator(distributed.NewLocalNode()).PathStrengths(ctx, make([]int, 4), 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+
+	if _, err := distributed.NewWorker(distributed.Task{ChoicesCount: 2, Start: 0, End: 1, Rows: []int{1}}); !errors.Is(err, distributed.ErrInvalidTask) {
+		t.Errorf("got error %v, want %v", err, distributed.ErrInvalidTask)
+	}
+}
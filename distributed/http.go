@@ -0,0 +1,192 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distributed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// IterateRequest is the body of the iterate request of the HTTP protocol.
+type IterateRequest struct {
+	Iteration int   `json:"iteration"`
+	Pivot     []int `json:"pivot"`
+}
+
+// IterateResponse is the body of the iterate response of the HTTP protocol.
+type IterateResponse struct {
+	Next []int `json:"next,omitempty"`
+}
+
+// RowsResponse is the body of the rows response of the HTTP protocol.
+type RowsResponse struct {
+	Rows []int `json:"rows"`
+}
+
+// Handler serves a LocalNode over the HTTP protocol, with POST /start,
+// POST /iterate and GET /rows endpoints that exchange JSON encoded Task,
+// IterateRequest, IterateResponse and RowsResponse bodies.
+type Handler struct {
+	node *LocalNode
+	mux  *http.ServeMux
+}
+
+// NewHandler returns a new Handler with its own LocalNode.
+func NewHandler() *Handler {
+	h := &Handler{
+		node: NewLocalNode(),
+		mux:  http.NewServeMux(),
+	}
+	h.mux.HandleFunc("/start", h.start)
+	h.mux.HandleFunc("/iterate", h.iterate)
+	h.mux.HandleFunc("/rows", h.rows)
+	return h
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) start(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	var t Task
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.node.Start(r.Context(), t); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) iterate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	var req IterateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	next, err := h.node.Iterate(r.Context(), req.Iteration, req.Pivot)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, IterateResponse{Next: next})
+}
+
+func (h *Handler) rows(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	rows, err := h.node.Rows(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, RowsResponse{Rows: rows})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrInvalidTask) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// HTTPNode is a Node on a remote machine, served by the Handler.
+type HTTPNode struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewHTTPNode returns a new HTTPNode for the base URL of the Handler. If the
+// client is nil, http.DefaultClient is used.
+func NewHTTPNode(baseURL string, client *http.Client) *HTTPNode {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPNode{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  client,
+	}
+}
+
+// Start implements the Node interface.
+func (n *HTTPNode) Start(ctx context.Context, t Task) error {
+	return n.do(ctx, http.MethodPost, "/start", t, nil)
+}
+
+// Iterate implements the Node interface.
+func (n *HTTPNode) Iterate(ctx context.Context, iteration int, pivot []int) ([]int, error) {
+	var resp IterateResponse
+	if err := n.do(ctx, http.MethodPost, "/iterate", IterateRequest{Iteration: iteration, Pivot: pivot}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Next, nil
+}
+
+// Rows implements the Node interface.
+func (n *HTTPNode) Rows(ctx context.Context) ([]int, error) {
+	var resp RowsResponse
+	if err := n.do(ctx, http.MethodGet, "/rows", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Rows, nil
+}
+
+func (n *HTTPNode) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, n.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("distributed: %s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
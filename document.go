@@ -0,0 +1,184 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "runtime/debug"
+
+// ResultDocumentSchemaVersion is the version of the ResultDocument JSON
+// schema. It is increased only when the schema changes in a way that is not
+// compatible with the previous version, so that archived documents of the
+// same schema version are comparable regardless of the library release that
+// produced them.
+const ResultDocumentSchemaVersion = 1
+
+// ResultDocument is a canonical and self-contained representation of the
+// voting results, intended to be archived and compared as JSON.
+type ResultDocument[C comparable] struct {
+	// Version of the document schema, ResultDocumentSchemaVersion.
+	SchemaVersion int `json:"schemaVersion"`
+	// Version of the library module that produced the document.
+	SoftwareVersion string `json:"softwareVersion"`
+	// Name of the election, if known.
+	Name string `json:"name,omitempty"`
+	// Variant of the method used to compute the results.
+	Method ResultMethod `json:"method"`
+	// Number of ballots that were counted.
+	BallotsCount int `json:"ballotsCount"`
+	// Number of counted ballots that did not rank any choice.
+	AbstentionsCount int `json:"abstentionsCount,omitempty"`
+	// Number of spoiled ballots that were not counted.
+	SpoiledCount int `json:"spoiledCount,omitempty"`
+	// Choices in the order of their indexes.
+	Choices []C `json:"choices"`
+	// Pairwise preferences matrix, where the value in row i and column j is
+	// the number of ballots that preferred choice i over choice j.
+	Preferences [][]int `json:"preferences"`
+	// Strongest paths strengths matrix, with the same layout as Preferences.
+	Strengths [][]int `json:"strengths"`
+	// Sorted choices with their ranks, starting with the winner.
+	Ranking []RankedChoice[C] `json:"ranking"`
+	// True if there are multiple choices in the first place.
+	Tie bool `json:"tie"`
+	// Groups of choices that share the same rank.
+	Ties [][]C `json:"ties"`
+}
+
+// ResultMethod describes the variant of the method used to compute the
+// results.
+type ResultMethod struct {
+	Name            string          `json:"name"`
+	StrengthVariant StrengthVariant `json:"strengthVariant"`
+	TieBreak        TieBreak        `json:"tieBreak"`
+}
+
+// RankedChoice is a single entry in the ResultDocument ranking. Choices with
+// the same number of wins share the same rank, unless the tie in the first
+// place is resolved by the tie-break rule.
+type RankedChoice[C comparable] struct {
+	Rank      int `json:"rank"`
+	Choice    C   `json:"choice"`
+	Index     int `json:"index"`
+	Wins      int `json:"wins"`
+	Strength  int `json:"strength"`
+	Advantage int `json:"advantage"`
+}
+
+// NewResultDocument computes results from the preferences, just as Compute
+// does, and returns them as a ResultDocument. The number of ballots can not be
+// derived from preferences, so it has to be provided.
+func NewResultDocument[C comparable](preferences []int, choices []C, ballotsCount int, opts ...Option[C]) ResultDocument[C] {
+	o := newOptions(opts)
+	strengths := pathStrengths(choices, preferences, o)
+	results, tie := calculateResults(choices, strengths, o)
+	return newResultDocument(choices, preferences, strengths, results, tie, ballotsCount, o)
+}
+
+// ResultDocument returns the current results of the election as a
+// ResultDocument. ErrSealed is returned if the results of the election are
+// sealed.
+func (e *Election[V, C]) ResultDocument() (ResultDocument[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return ResultDocument[C]{}, err
+	}
+	v := e.voting
+	v.compute()
+	d := newResultDocument(v.choices, v.preferences, v.strengths, v.results, v.tie, len(e.records), v.options)
+	d.Name = e.config.Name
+	stats := e.Stats()
+	d.AbstentionsCount = stats.Abstentions
+	d.SpoiledCount = stats.Spoiled
+	return d, nil
+}
+
+func newResultDocument[C comparable](choices []C, preferences, strengths []int, results []Result[C], tie bool, ballotsCount int, o options[C]) ResultDocument[C] {
+	d := ResultDocument[C]{
+		SchemaVersion:   ResultDocumentSchemaVersion,
+		SoftwareVersion: softwareVersion(),
+		Method: ResultMethod{
+			Name:            "schulze",
+			StrengthVariant: o.strengthVariant,
+			TieBreak:        o.tieBreak,
+		},
+		BallotsCount: ballotsCount,
+		Choices:      append(make([]C, 0, len(choices)), choices...),
+		Preferences:  documentMatrix(preferences, len(choices)),
+		Strengths:    documentMatrix(strengths, len(choices)),
+		Ranking:      make([]RankedChoice[C], 0, len(results)),
+		Tie:          tie,
+		Ties:         make([][]C, 0),
+	}
+
+	// the tie in the first place is resolved if it is not reported while the
+	// first two choices have the same number of wins
+	tieBroken := !tie && len(results) > 1 && results[0].Wins == results[1].Wins
+
+	rank := 0
+	for i, r := range results {
+		switch {
+		case i == 0:
+			rank = 1
+		case tieBroken && i == 1:
+			rank = 2
+		case r.Wins != results[i-1].Wins:
+			rank = i + 1
+		}
+		d.Ranking = append(d.Ranking, RankedChoice[C]{
+			Rank:      rank,
+			Choice:    r.Choice,
+			Index:     r.Index,
+			Wins:      r.Wins,
+			Strength:  r.Strength,
+			Advantage: r.Advantage,
+		})
+	}
+
+	for i := 0; i < len(d.Ranking); {
+		j := i + 1
+		for j < len(d.Ranking) && d.Ranking[j].Rank == d.Ranking[i].Rank {
+			j++
+		}
+		if j-i > 1 {
+			group := make([]C, 0, j-i)
+			for _, r := range d.Ranking[i:j] {
+				group = append(group, r.Choice)
+			}
+			d.Ties = append(d.Ties, group)
+		}
+		i = j
+	}
+
+	return d
+}
+
+// documentMatrix converts the flat matrix to rows, with zero diagonal.
+func documentMatrix(m []int, choicesCount int) [][]int {
+	rows := make([][]int, choicesCount)
+	for i := range rows {
+		rows[i] = append(make([]int, 0, choicesCount), m[i*choicesCount:(i+1)*choicesCount]...)
+		rows[i][i] = 0
+	}
+	return rows
+}
+
+const modulePath = "resenje.org/schulze"
+
+// softwareVersion returns the version of this module from the build
+// information of the binary.
+func softwareVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, m := range info.Deps {
+		if m.Path == modulePath {
+			return m.Version
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestNewResultDocument(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	preferences := schulze.NewPreferences(len(choices))
+
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"B": 1, "A": 2},
+		{"A": 1, "B": 1, "C": 2},
+	} {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d := schulze.NewResultDocument(preferences, choices, 3)
+
+	if d.SoftwareVersion == "" {
+		t.Error("got empty software version")
+	}
+	d.SoftwareVersion = ""
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"schemaVersion":1,"softwareVersion":"","method":{"name":"schulze","strengthVariant":"winning-votes","tieBreak":"none"},"ballotsCount":3,"choices":["A","B","C","D"],"preferences":[[0,1,3,3],[1,0,3,3],[0,0,0,1],[0,0,0,0]],"strengths":[[0,0,3,3],[0,0,3,3],[0,0,0,1],[0,0,0,0]],"ranking":[{"rank":1,"choice":"A","index":0,"wins":2,"strength":6,"advantage":6},{"rank":1,"choice":"B","index":1,"wins":2,"strength":6,"advantage":6},{"rank":3,"choice":"C","index":2,"wins":1,"strength":1,"advantage":1},{"rank":4,"choice":"D","index":3,"wins":0,"strength":0,"advantage":0}],"tie":true,"ties":[["A","B"]]}`
+	if string(data) != want {
+		t.Errorf("got json\n%s\nwant\n%s", data, want)
+	}
+
+	var decoded schulze.ResultDocument[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, d) {
+		t.Errorf("got decoded document %+v, want %+v", decoded, d)
+	}
+
+	d = schulze.NewResultDocument(preferences, choices, 3, schulze.WithTieBreak[string](schulze.TieBreakIndex))
+	if d.Tie {
+		t.Error("got tie with index tie-break")
+	}
+	if d.Ranking[0].Rank != 1 || d.Ranking[1].Rank != 2 {
+		t.Errorf("got ranks %v and %v, want 1 and 2", d.Ranking[0].Rank, d.Ranking[1].Rank)
+	}
+	if len(d.Ties) != 0 {
+		t.Errorf("got ties %v, want none", d.Ties)
+	}
+}
+
+func TestElection_ResultDocument(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:    "board",
+		Choices: []string{"A", "B", "C"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := e.ResultDocument()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "board" {
+		t.Errorf("got name %q, want %q", d.Name, "board")
+	}
+	if d.BallotsCount != 2 {
+		t.Errorf("got ballots count %v, want %v", d.BallotsCount, 2)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if d.Ranking[i].Choice != r.Choice || d.Ranking[i].Wins != r.Wins {
+			t.Errorf("got ranking %+v, want result %+v", d.Ranking[i], r)
+		}
+	}
+}
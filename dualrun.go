@@ -0,0 +1,93 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// DivergenceError is reported in the dual-run mode when the strongest path
+// strength computed by the optimized algorithm differs from the one computed
+// by the reference algorithm. Only the first difference is reported.
+type DivergenceError struct {
+	// Indexes of the choices of the path.
+	From, To int
+	// Strength computed by the optimized algorithm.
+	Got int
+	// Strength computed by the reference algorithm.
+	Want int
+}
+
+func (e *DivergenceError) Error() string {
+	return fmt.Sprintf("schulze: strength of the path from %v to %v diverges from the reference: got %v, want %v", e.From, e.To, e.Got, e.Want)
+}
+
+// WithDualRun enables the dual-run mode, where every computation of the
+// strongest paths strengths, including the custom PathStrengthComputer and
+// incremental computation, is repeated by a simple reference implementation of
+// the Floyd–Warshall algorithm and the results are compared. The onDivergence
+// function is called if they differ, and if it is nil, the computation panics
+// with the DivergenceError. The dual-run mode more than doubles the
+// computation time and it is intended for canary deployments after
+// performance changes.
+func WithDualRun[C comparable](onDivergence func(err *DivergenceError)) Option[C] {
+	return func(o *options[C]) {
+		o.dualRun = true
+		o.onDivergence = onDivergence
+	}
+}
+
+// checkDualRun compares the strengths with the ones computed by the reference
+// algorithm from the preferences, reporting the first difference.
+func checkDualRun[C comparable](preferences, strengths []int, choicesCount int, o options[C]) {
+	want := referencePathStrengths(preferences, choicesCount)
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			if got, want := strengths[i*choicesCount+j], want[i*choicesCount+j]; got != want {
+				err := &DivergenceError{From: i, To: j, Got: got, Want: want}
+				if o.onDivergence == nil {
+					panic(err)
+				}
+				o.onDivergence(err)
+				return
+			}
+		}
+	}
+}
+
+// referencePathStrengths is a straightforward implementation of the strongest
+// paths computation, as described by the method author, used as the
+// reference for the optimized implementations.
+func referencePathStrengths(preferences []int, choicesCount int) []int {
+	d := func(i, j int) int {
+		return preferences[i*choicesCount+j]
+	}
+	p := make([]int, choicesCount*choicesCount)
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i != j && d(i, j) > d(j, i) {
+				p[i*choicesCount+j] = d(i, j)
+			}
+		}
+	}
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			for k := 0; k < choicesCount; k++ {
+				if i == k || j == k {
+					continue
+				}
+				if s := min(p[j*choicesCount+i], p[i*choicesCount+k]); s > p[j*choicesCount+k] {
+					p[j*choicesCount+k] = s
+				}
+			}
+		}
+	}
+	return p
+}
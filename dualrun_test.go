@@ -0,0 +1,95 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestWithDualRun(t *testing.T) {
+	choices := newChoices(30)
+
+	var divergences []*schulze.DivergenceError
+	onDivergence := func(err *schulze.DivergenceError) {
+		divergences = append(divergences, err)
+	}
+
+	v := schulze.NewVoting(choices,
+		schulze.WithDualRun[string](onDivergence),
+		schulze.WithIncrementalCompute[string](),
+	)
+	for i, b := range randomBallots(t, choices, 100) {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+		if i%10 == 0 {
+			_, _, _ = v.Compute()
+		}
+	}
+	_, _, _ = v.Compute()
+
+	_, _, _ = schulze.Compute(v.Preferences(), choices,
+		schulze.WithDualRun[string](onDivergence),
+		schulze.WithStrengthVariant[string](schulze.StrengthMargins),
+		schulze.WithPathStrengthComputer[string](schulze.ParallelPathStrengthComputer{Workers: 4}),
+	)
+
+	if len(divergences) > 0 {
+		t.Errorf("got divergences %v", divergences)
+	}
+}
+
+type brokenPathStrengthComputer struct{}
+
+func (brokenPathStrengthComputer) PathStrengths(preferences []int, choicesCount int) []int {
+	strengths := make([]int, len(preferences))
+	copy(strengths, preferences)
+	return strengths
+}
+
+func TestWithDualRun_divergence(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"B": 1, "C": 2, "A": 3},
+		{"A": 1, "B": 2},
+	} {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var divergence *schulze.DivergenceError
+	_, _, _ = schulze.Compute(preferences, choices,
+		schulze.WithPathStrengthComputer[string](brokenPathStrengthComputer{}),
+		schulze.WithDualRun[string](func(err *schulze.DivergenceError) {
+			divergence = err
+		}),
+	)
+	if divergence == nil {
+		t.Fatal("divergence not reported")
+	}
+	// B over A has one vote in preferences and no link in strengths
+	if divergence.From != 1 || divergence.To != 0 || divergence.Got != 1 || divergence.Want != 0 {
+		t.Errorf("got divergence %+v", divergence)
+	}
+
+	defer func() {
+		err, _ := recover().(error)
+		var derr *schulze.DivergenceError
+		if !errors.As(err, &derr) {
+			t.Errorf("got panic %v, want DivergenceError", err)
+		}
+	}()
+	_, _, _ = schulze.Compute(preferences, choices,
+		schulze.WithPathStrengthComputer[string](brokenPathStrengthComputer{}),
+		schulze.WithDualRun[string](nil),
+	)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Shuffled returns an iterator over the remaining duels in a random order
+// provided by the random source. The same source seed always produces the
+// same order, which makes it suitable for reproducible reports, usually with
+// the same source as the one set by the WithRandSource option. The original
+// iterator is consumed.
+func (d DuelsIterator[C]) Shuffled(src rand.Source) DuelsIterator[C] {
+	duels := d.collect()
+	rand.New(src).Shuffle(len(duels), func(i, j int) {
+		duels[i], duels[j] = duels[j], duels[i]
+	})
+	return newSliceDuelsIterator(duels)
+}
+
+// GroupedByWinner returns an iterator over the remaining duels grouped by the
+// winner of the duel, in the order of the winner choice indexes and then by
+// the defeated choice indexes. Duels that are in a tie are returned last, in
+// the original order. The original iterator is consumed.
+func (d DuelsIterator[C]) GroupedByWinner() DuelsIterator[C] {
+	duels := d.collect()
+	sort.SliceStable(duels, func(i, j int) bool {
+		wi, di := duels[i].Outcome()
+		wj, dj := duels[j].Outcome()
+		if wi == nil || wj == nil {
+			return wi != nil
+		}
+		if wi.Index != wj.Index {
+			return wi.Index < wj.Index
+		}
+		return di.Index < dj.Index
+	})
+	return newSliceDuelsIterator(duels)
+}
+
+func (d DuelsIterator[C]) collect() (duels []*Duel[C]) {
+	for duel := d(); duel != nil; duel = d() {
+		duels = append(duels, duel)
+	}
+	return duels
+}
+
+func newSliceDuelsIterator[C comparable](duels []*Duel[C]) DuelsIterator[C] {
+	var i int
+	return func() *Duel[C] {
+		if i >= len(duels) {
+			return nil
+		}
+		defer func() { i++ }()
+		return duels[i]
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestDuelsIterator_Shuffled(t *testing.T) {
+	choices := []string{"A", "B", "C", "D", "E"}
+
+	v := schulze.NewVoting(choices)
+	for _, b := range randomBallots(t, choices, 50) {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, duels, _ := v.Compute()
+	ordered := collectDuels(duels)
+
+	_, duels, _ = v.Compute()
+	shuffled := collectDuels(duels.Shuffled(rand.NewSource(42)))
+
+	_, duels, _ = v.Compute()
+	if again := collectDuels(duels.Shuffled(rand.NewSource(42))); !reflect.DeepEqual(again, shuffled) {
+		t.Errorf("got different order for the same seed")
+	}
+
+	if len(shuffled) != len(ordered) {
+		t.Fatalf("got %v shuffled duels, want %v", len(shuffled), len(ordered))
+	}
+	for _, d := range ordered {
+		if !contains(shuffled, d) {
+			t.Errorf("duel %+v not found in shuffled duels", d)
+		}
+	}
+}
+
+func TestDuelsIterator_GroupedByWinner(t *testing.T) {
+	v := schulze.NewVoting([]string{"A", "B", "C", "D", "E"})
+	for _, b := range []schulze.Ballot[string]{
+		{"C": 1, "A": 2, "B": 3},
+		{"C": 1, "A": 2, "B": 3},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, duels, _ := v.Compute()
+	grouped := duels.GroupedByWinner()
+
+	var got [][2]string
+	for d := grouped(); d != nil; d = grouped() {
+		winner, defeated := d.Outcome()
+		if winner == nil {
+			got = append(got, [2]string{d.Left.Choice, d.Right.Choice})
+		} else {
+			got = append(got, [2]string{winner.Choice, defeated.Choice})
+		}
+	}
+
+	want := [][2]string{
+		{"A", "B"},
+		{"A", "D"},
+		{"A", "E"},
+		{"B", "D"},
+		{"B", "E"},
+		{"C", "A"},
+		{"C", "B"},
+		{"C", "D"},
+		{"C", "E"},
+		{"D", "E"}, // tie
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got duels %v, want %v", got, want)
+	}
+}
+
+func collectDuels[C comparable](duels schulze.DuelsIterator[C]) (s []schulze.Duel[C]) {
+	for d := duels(); d != nil; d = duels() {
+		s = append(s, *d)
+	}
+	return s
+}
@@ -0,0 +1,427 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "time"
+
+// Election is a voter-aware voting that keeps the Record of every voter's
+// ballot. A voter can change the vote by voting again or withdraw it, without
+// the need to keep track of previously returned Records. Methods on the
+// Election type are not safe for concurrent calls.
+type Election[V, C comparable] struct {
+	voting  *Voting[C]
+	records map[V]Record[C]
+	tags    map[V]Tags
+	scores  map[V]ScoreBallot[C]
+	presets map[string]Record[C]
+	config  ElectionConfig[C]
+	now     func() time.Time
+	// unsealed is true when the results are unsealed with the token
+	unsealed    bool
+	commitments map[V][]byte
+	// tokenVerifier is set when votes require anonymous voting tokens
+	tokenVerifier TokenVerifier
+	// tokens maps used voting tokens to voters
+	tokens map[string]V
+	// erasedTokens holds used voting tokens of erased voters
+	erasedTokens map[string]struct{}
+	// spoiled holds voters that spoiled their ballots
+	spoiled map[V]struct{}
+	audit   []AuditEntry
+	// provisional ballots that are not tallied until they are accepted
+	provisional map[V]Ballot[C]
+	// tally at the close of the election, set when a provisional ballot is
+	// accepted after the close
+	closeTally  *Voting[C]
+	closeVoters int
+	// nominations of choices that are added when the election opens
+	nominations []Nomination[V, C]
+	// times of the last votes of voters with records
+	voted map[V]time.Time
+	// audit attributes of recorded ballots
+	attributes map[V]Attributes
+	// content digests of imported ballot batches
+	batches map[string]struct{}
+	// notifier of lifecycle events with the observed state
+	lifecycle *lifecycle[C]
+	// external policy engine that gates votes
+	policy PolicyEngine[V, C]
+	// rate limits of votes
+	rateLimiter *rateLimiter[V]
+	// provenance of choices seeded from or to other elections
+	linkages []Linkage[C]
+	// appointed proxies of principals
+	proxies map[V]V
+	// principals with ballots cast by their proxies
+	proxied map[V]struct{}
+	// choices vetoed by voters with records
+	vetoes map[V][]C
+	// numbers of vetoes of choices
+	vetoCounts map[C]int
+}
+
+// Tags are key-value labels, such as region or membership class, that are
+// attached to a voter's ballot to be able to compute results per segment of
+// voters.
+type Tags map[string]string
+
+// NewElection initializes a new voter-aware election for the provided
+// choices.
+func NewElection[V, C comparable](choices []C, opts ...Option[C]) *Election[V, C] {
+	return &Election[V, C]{
+		voting:       NewVoting(choices, opts...),
+		records:      make(map[V]Record[C]),
+		tags:         make(map[V]Tags),
+		scores:       make(map[V]ScoreBallot[C]),
+		presets:      make(map[string]Record[C]),
+		now:          time.Now,
+		commitments:  make(map[V][]byte),
+		tokens:       make(map[string]V),
+		erasedTokens: make(map[string]struct{}),
+		spoiled:      make(map[V]struct{}),
+		provisional:  make(map[V]Ballot[C]),
+		voted:        make(map[V]time.Time),
+		attributes:   make(map[V]Attributes),
+		batches:      make(map[string]struct{}),
+	}
+}
+
+// Vote adds or replaces the voter's ballot. A record of a complete and
+// normalized preferences is returned. Tags and scores of the previous voter's
+// ballot are removed. An empty ballot is counted as an abstention.
+func (e *Election[V, C]) Vote(voter V, b Ballot[C]) (Record[C], error) {
+	return e.VoteTagged(voter, b, nil)
+}
+
+// VoteTagged adds or replaces the voter's ballot, just as Vote does, attaching
+// tags to it that can be used to compute results per segment with ComputeBy.
+func (e *Election[V, C]) VoteTagged(voter V, b Ballot[C], tags Tags) (Record[C], error) {
+	if e.tokenVerifier != nil {
+		return nil, ErrTokenRequired
+	}
+	return e.voteChecked(voter, b, tags)
+}
+
+// voteChecked tallies the voter's ballot if the election accepts votes.
+func (e *Election[V, C]) voteChecked(voter V, b Ballot[C], tags Tags) (Record[C], error) {
+	if e.config.CommitReveal {
+		return nil, ErrCommitRevealRequired
+	}
+	if err := e.checkSchedule(); err != nil {
+		return nil, err
+	}
+	if err := e.checkRateLimit(voter); err != nil {
+		return nil, err
+	}
+	return e.vote(voter, b, tags)
+}
+
+// checkSchedule returns an error if votes are not accepted at the current
+// time. Nominated choices are added to the election once it opens.
+func (e *Election[V, C]) checkSchedule() error {
+	err := e.config.Schedule.check(e.now())
+	if err != ErrElectionNotOpen {
+		e.admitNominations()
+	}
+	return err
+}
+
+// vote tallies the voter's ballot without checking the schedule.
+func (e *Election[V, C]) vote(voter V, b Ballot[C], tags Tags) (Record[C], error) {
+	r, err := e.tally(voter, b, tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) > 0 {
+		e.tags[voter] = copyTags(tags)
+	} else {
+		delete(e.tags, voter)
+	}
+	if err := e.updateProxies(voter); err != nil {
+		return nil, err
+	}
+	e.updateDisqualified()
+	return r, nil
+}
+
+// tally evaluates the ballot with the policy engine, validates it and
+// replaces the voter's recorded ballot with it, removing the data of the
+// previous ballot, for both personal ballots and ballots cast by proxies.
+// The previous ballot is not changed if the ballot is rejected.
+func (e *Election[V, C]) tally(voter V, b Ballot[C], tags Tags) (Record[C], error) {
+	if err := e.checkPolicy(voter, b, tags); err != nil {
+		return nil, err
+	}
+	if err := validateBallot(e.config.BallotPolicy, b); err != nil {
+		return nil, err
+	}
+	r, err := e.voting.Vote(b)
+	if err != nil {
+		return nil, err
+	}
+	if previous, ok := e.records[voter]; ok {
+		if err := e.voting.Unvote(previous); err != nil {
+			return nil, err
+		}
+	}
+	e.records[voter] = r
+	e.voted[voter] = e.now()
+	delete(e.attributes, voter)
+	delete(e.spoiled, voter)
+	delete(e.scores, voter)
+	e.removeVetoes(voter)
+	return r, nil
+}
+
+// Unvote removes the voter's ballot, or the mark of the spoiled ballot. It is
+// not an error to unvote a voter that did not vote.
+func (e *Election[V, C]) Unvote(voter V) error {
+	r, ok := e.records[voter]
+	if _, spoiled := e.spoiled[voter]; !ok && !spoiled {
+		return nil
+	}
+	if err := e.checkSchedule(); err != nil {
+		return err
+	}
+	if err := e.checkRateLimit(voter); err != nil {
+		return err
+	}
+	if !ok {
+		delete(e.spoiled, voter)
+		return e.updateProxies(voter)
+	}
+	if err := e.voting.Unvote(r); err != nil {
+		return err
+	}
+	delete(e.records, voter)
+	delete(e.voted, voter)
+	delete(e.attributes, voter)
+	delete(e.tags, voter)
+	delete(e.scores, voter)
+	e.removeVetoes(voter)
+	if err := e.updateProxies(voter); err != nil {
+		return err
+	}
+	e.updateDisqualified()
+	return nil
+}
+
+// Record returns the Record of the voter's ballot and a boolean reporting if
+// the voter voted.
+func (e *Election[V, C]) Record(voter V) (r Record[C], ok bool) {
+	r, ok = e.records[voter]
+	return r, ok
+}
+
+// Tags returns the tags of the voter's ballot.
+func (e *Election[V, C]) Tags(voter V) Tags {
+	return copyTags(e.tags[voter])
+}
+
+// VotersCount returns the number of voters that voted, including voters that
+// abstained or spoiled their ballots.
+func (e *Election[V, C]) VotersCount() int {
+	return len(e.records) + len(e.spoiled)
+}
+
+// Choices returns the current choices of the election.
+func (e *Election[V, C]) Choices() []C {
+	return e.voting.choices
+}
+
+// SetChoices updates the election to accommodate the changes to the choices.
+// It is required to pass a complete updated choices.
+func (e *Election[V, C]) SetChoices(updated []C) {
+	e.voting.SetChoices(updated)
+}
+
+// Compute calculates a sorted list of choices with the total number of wins for
+// each of them. If there are multiple winners, tie boolean parameter is true.
+// ErrSealed is returned if the results of the election are sealed.
+func (e *Election[V, C]) Compute() (results []Result[C], duels DuelsIterator[C], tie bool, err error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, nil, false, err
+	}
+	results, duels, tie = e.voting.Compute()
+	return results, duels, tie, nil
+}
+
+// ComputeWithProgress is the same as Compute, but it calls the progress
+// function during the calculation of the strongest paths strengths.
+func (e *Election[V, C]) ComputeWithProgress(progress func(done, total int)) (results []Result[C], duels DuelsIterator[C], tie bool, err error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, nil, false, err
+	}
+	results, duels, tie = e.voting.ComputeWithProgress(progress)
+	return results, duels, tie, nil
+}
+
+// ComputeRange returns at most limit results of the complete sorted list of
+// choices starting from the offset, together with the total number of results
+// and the tie flag.
+func (e *Election[V, C]) ComputeRange(offset, limit int) (results []Result[C], total int, tie bool, err error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, 0, false, err
+	}
+	results, total, tie = e.voting.ComputeRange(offset, limit)
+	return results, total, tie, nil
+}
+
+// StateHash returns the SHA-256 hash of the election choices and
+// preferences, as the Voting StateHash method does.
+func (e *Election[V, C]) StateHash() []byte {
+	return e.voting.StateHash()
+}
+
+// SegmentResult holds the results computed only from ballots of a single
+// segment of voters.
+type SegmentResult[C comparable] struct {
+	Results     []Result[C]
+	Duels       DuelsIterator[C]
+	Tie         bool
+	VotersCount int
+}
+
+// ComputeBy calculates results for every segment of voters that have the same
+// value of the tag with the provided key, together with the overall results
+// of all ballots. Ballots without the tag are counted only in the overall
+// results. Suspended, disqualified and withdrawn choices are excluded from
+// the results of segments, just as from the overall results. ErrSealed is
+// returned if the results of the election are sealed.
+func (e *Election[V, C]) ComputeBy(key string) (overall SegmentResult[C], segments map[string]SegmentResult[C], err error) {
+	if err := e.checkSealed(); err != nil {
+		return overall, nil, err
+	}
+
+	overall.Results, overall.Duels, overall.Tie = e.voting.Compute()
+	overall.VotersCount = len(e.records)
+
+	votings, counts := e.segmentVotings(key)
+	segments = make(map[string]SegmentResult[C], len(votings))
+	for value, v := range votings {
+		results, duels, tie := v.Compute()
+		segments[value] = SegmentResult[C]{
+			Results:     results,
+			Duels:       duels,
+			Tie:         tie,
+			VotersCount: counts[value],
+		}
+	}
+	return overall, segments, nil
+}
+
+// segmentVotings tallies ballots of every segment of voters with the same
+// value of the tag with the key, returning votings and numbers of voters of
+// segments.
+func (e *Election[V, C]) segmentVotings(key string) (votings map[string]*Voting[C], counts map[string]int) {
+	choices := e.voting.choices
+	votings = make(map[string]*Voting[C])
+	counts = make(map[string]int)
+	for voter, tags := range e.tags {
+		value, ok := tags[key]
+		if !ok {
+			continue
+		}
+		v, ok := votings[value]
+		if !ok {
+			v = e.voting.derive(choices, NewPreferences(len(choices)))
+			votings[value] = v
+		}
+		// ballot is constructed only from the known choices so the error
+		// is not possible
+		_, _ = v.voteRecorded(recordBallot(e.records[voter], choices))
+		counts[value]++
+	}
+	return votings, counts
+}
+
+// SetPreset stores a named predefined Record, such as a party or a slate
+// recommendation, that voters can adopt as their ballot with VotePreset. An
+// existing preset with the same name is replaced.
+func (e *Election[V, C]) SetPreset(name string, r Record[C]) {
+	e.presets[name] = copyRecord(r)
+}
+
+// Preset returns the Record of a named preset and a boolean reporting if the
+// preset exists.
+func (e *Election[V, C]) Preset(name string) (r Record[C], ok bool) {
+	r, ok = e.presets[name]
+	if !ok {
+		return nil, false
+	}
+	return copyRecord(r), true
+}
+
+// RemovePreset removes a named preset. Ballots that were already cast from the
+// preset are not changed.
+func (e *Election[V, C]) RemovePreset(name string) {
+	delete(e.presets, name)
+}
+
+// VotePreset adds or replaces the voter's ballot with the named preset.
+// Preset ranks are numbered from 1, starting from the first choices of the
+// preset Record, and the optional modifications Ballot is layered on top of
+// them, overriding the ranks of the choices that it contains. Choices of the
+// preset that are no longer in the election are ignored. With the
+// WithDescendingRanks option, preset ranks are numbered from 1 starting from
+// the last ranked choices of the preset Record instead, and the ranks of
+// modifications are descending.
+func (e *Election[V, C]) VotePreset(voter V, name string, modifications Ballot[C]) (Record[C], error) {
+	r, ok := e.presets[name]
+	if !ok {
+		return nil, &UnknownPresetError{Name: name}
+	}
+	b := recordBallot(r, e.voting.choices)
+	if e.voting.options.descendingRanks {
+		for c, rank := range b {
+			b[c] = len(r) - rank
+		}
+	}
+	for c, rank := range modifications {
+		b[c] = rank
+	}
+	return e.Vote(voter, b)
+}
+
+// recordBallot constructs a Ballot from the Record ranks, omitting the last
+// list of unranked choices and the choices that are not known.
+func recordBallot[C comparable](r Record[C], choices []C) Ballot[C] {
+	b := make(Ballot[C])
+	if len(r) == 0 {
+		return b
+	}
+	for rank, choices1 := range r[:len(r)-1] {
+		for _, c := range choices1 {
+			if getChoiceIndex(choices, c) < 0 {
+				continue
+			}
+			b[c] = rank + 1
+		}
+	}
+	return b
+}
+
+func copyRecord[C comparable](r Record[C]) Record[C] {
+	if r == nil {
+		return nil
+	}
+	c := make(Record[C], 0, len(r))
+	for _, choices := range r {
+		c = append(c, append(make([]C, 0, len(choices)), choices...))
+	}
+	return c
+}
+
+func copyTags(t Tags) Tags {
+	if t == nil {
+		return nil
+	}
+	c := make(Tags, len(t))
+	for k, v := range t {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestElection(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	e := schulze.NewElection[string](choices)
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	// change the vote
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"C": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("carol", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("carol"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("dave"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := e.VotersCount(); got != 2 {
+		t.Errorf("got voters count %v, want %v", got, 2)
+	}
+	if _, ok := e.Record("carol"); ok {
+		t.Error("got record for unvoted voter")
+	}
+	r, ok := e.Record("bob")
+	if !ok {
+		t.Fatal("record not found")
+	}
+	if want := (schulze.Record[string]{{"C"}, {"A"}, {"B"}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+
+	v := schulze.NewVoting(choices)
+	if _, err := v.Vote(schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"C": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	wantResults, _, wantTie := v.Compute()
+
+	results, _, tie, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tie != wantTie {
+		t.Errorf("got tie %v, want %v", tie, wantTie)
+	}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("got results %+v, want %+v", results, wantResults)
+	}
+}
+
+func TestElection_SetChoices(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	e.SetChoices([]string{"A", "B", "C"})
+
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	results, _, tie, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tie {
+		t.Error("got tie")
+	}
+	if results[0].Choice != "C" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "C")
+	}
+}
+
+func TestElection_VotePreset(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C", "D"})
+
+	e.SetPreset("slate", schulze.Record[string]{{"A"}, {"B", "C"}, {"D"}})
+
+	r, err := e.VotePreset("alice", "slate", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"A"}, {"B", "C"}, {"D"}}); !reflect.DeepEqual(sortedRecord(r), want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+
+	r, err = e.VotePreset("bob", "slate", schulze.Ballot[string]{"C": 1, "D": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"A", "C"}, {"B"}, {"D"}, {}}); !reflect.DeepEqual(sortedRecord(r), want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+
+	preset, ok := e.Preset("slate")
+	if !ok {
+		t.Fatal("preset not found")
+	}
+	preset[0][0] = "D"
+	if preset, _ := e.Preset("slate"); preset[0][0] != "A" {
+		t.Error("preset mutated through the returned record")
+	}
+
+	e.RemovePreset("slate")
+
+	_, err = e.VotePreset("carol", "slate", nil)
+	var perr *schulze.UnknownPresetError
+	if !errors.As(err, &perr) {
+		t.Fatalf("got error %v, want UnknownPresetError", err)
+	}
+	if perr.Name != "slate" {
+		t.Errorf("got unknown preset error name %q, want %q", perr.Name, "slate")
+	}
+}
+
+func sortedRecord(r schulze.Record[string]) schulze.Record[string] {
+	s := make(schulze.Record[string], 0, len(r))
+	for _, choices := range r {
+		c := append([]string{}, choices...)
+		sort.Strings(c)
+		s = append(s, c)
+	}
+	return s
+}
+
+func TestElection_ComputeBy(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	e := schulze.NewElection[string](choices)
+
+	for _, v := range []struct {
+		voter  string
+		ballot schulze.Ballot[string]
+		tags   schulze.Tags
+	}{
+		{voter: "alice", ballot: schulze.Ballot[string]{"A": 1, "B": 2}, tags: schulze.Tags{"region": "north"}},
+		{voter: "bob", ballot: schulze.Ballot[string]{"A": 1}, tags: schulze.Tags{"region": "north"}},
+		{voter: "carol", ballot: schulze.Ballot[string]{"C": 1, "B": 2}, tags: schulze.Tags{"region": "south"}},
+		{voter: "dave", ballot: schulze.Ballot[string]{"C": 1}},
+	} {
+		if _, err := e.VoteTagged(v.voter, v.ballot, v.tags); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// tags are discarded when voting without them
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if tags := e.Tags("bob"); tags != nil {
+		t.Errorf("got tags %v, want none", tags)
+	}
+	if tags := e.Tags("alice"); tags["region"] != "north" {
+		t.Errorf("got tags %v, want region north", tags)
+	}
+
+	overall, segments, err := e.ComputeBy("region")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if overall.VotersCount != 4 {
+		t.Errorf("got overall voters count %v, want %v", overall.VotersCount, 4)
+	}
+	results, _, tie, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(overall.Results, results) || overall.Tie != tie {
+		t.Errorf("got overall results %+v, want %+v", overall.Results, results)
+	}
+
+	if len(segments) != 2 {
+		t.Fatalf("got %v segments, want %v", len(segments), 2)
+	}
+	for region, want := range map[string]struct {
+		winner      string
+		votersCount int
+	}{
+		"north": {winner: "A", votersCount: 1},
+		"south": {winner: "C", votersCount: 1},
+	} {
+		s := segments[region]
+		if s.VotersCount != want.votersCount {
+			t.Errorf("%s: got voters count %v, want %v", region, s.VotersCount, want.votersCount)
+		}
+		if s.Results[0].Choice != want.winner {
+			t.Errorf("%s: got winner %v, want %v", region, s.Results[0].Choice, want.winner)
+		}
+	}
+}
+
+func TestElection_ComputeBy_suspended(t *testing.T) {
+	e := newSuspendedElection(t)
+
+	_, segments, err := e.ComputeBy("region")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for region, want := range map[string][]string{
+		"north": {"B", "C"},
+		"south": {"C", "B"},
+	} {
+		if got := resultChoices(segments[region].Results); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got results %v, want %v", region, got, want)
+		}
+		for _, d := range collectDuels(segments[region].Duels) {
+			if d.Left.Choice == "A" || d.Right.Choice == "A" {
+				t.Errorf("%s: got duel %+v with the suspended choice", region, d)
+			}
+		}
+	}
+}
+
+// newSuspendedElection returns an election with tagged ballots that rank the
+// choice A, which is suspended after they are voted.
+func newSuspendedElection(t *testing.T) *schulze.Election[string, string] {
+	t.Helper()
+
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+	for _, v := range []struct {
+		voter  string
+		ballot schulze.Ballot[string]
+		region string
+	}{
+		{voter: "alice", ballot: schulze.Ballot[string]{"A": 1, "B": 2, "C": 3}, region: "north"},
+		{voter: "bob", ballot: schulze.Ballot[string]{"A": 1, "B": 2}, region: "north"},
+		{voter: "carol", ballot: schulze.Ballot[string]{"A": 1, "C": 2, "B": 3}, region: "south"},
+	} {
+		if _, err := e.VoteTagged(v.voter, v.ballot, schulze.Tags{"region": v.region}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.SuspendChoice("A", "under review"); err != nil {
+		t.Fatal(err)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := resultChoices(results), []string{"B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got results %v, want %v", got, want)
+	}
+	return e
+}
+
+func resultChoices[C comparable](results []schulze.Result[C]) (choices []C) {
+	for _, r := range results {
+		choices = append(choices, r.Choice)
+	}
+	return choices
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package email collects ballots of an election from signed email messages,
+// replicating the classic email voting workflow of projects such as Debian.
+//
+// The vote is a line of the signed message text that starts with the
+// "Ballot:" prefix, followed by the ranking in the text format of the
+// schulze.ParseBallot function, such as:
+//
+//	Ballot: A > B = C > D
+//
+// Signatures are verified by a Verifier, for example with OpenPGP, which is
+// not provided by the standard library, and the eligibility of a signer is
+// decided by a function. Messages can be delivered to the Gateway directly,
+// for example from a mail delivery agent pipe, or over SMTP by the Server.
+package email
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+	"sync"
+
+	"resenje.org/schulze"
+)
+
+// BallotPrefix is the prefix of the line with the ballot.
+const BallotPrefix = "Ballot:"
+
+var (
+	// ErrNoBallot is returned when the signed text has no ballot line.
+	ErrNoBallot = errors.New("email: no ballot in the message")
+	// ErrMultipleBallots is returned when the signed text has more than one
+	// ballot line.
+	ErrMultipleBallots = errors.New("email: multiple ballots in the message")
+	// ErrNotEligible is returned by the Eligibility function when the signer
+	// is not eligible to vote.
+	ErrNotEligible = errors.New("email: signer not eligible")
+)
+
+// Verifier verifies the signature of the message with the body and returns
+// the identity of the signer, such as the key fingerprint or the email
+// address bound to the key, and the signed text.
+type Verifier interface {
+	Verify(header mail.Header, body []byte) (signer string, text []byte, err error)
+}
+
+// VerifierFunc is an adapter to use ordinary functions as Verifier.
+type VerifierFunc func(header mail.Header, body []byte) (signer string, text []byte, err error)
+
+// Verify calls f(header, body).
+func (f VerifierFunc) Verify(header mail.Header, body []byte) (signer string, text []byte, err error) {
+	return f(header, body)
+}
+
+// Eligibility returns the voter identifier of an eligible signer, or an
+// error, such as ErrNotEligible, if the signer is not eligible to vote.
+type Eligibility func(signer string) (voter string, err error)
+
+// Receipt is the outcome of a delivered ballot.
+type Receipt struct {
+	Voter  string
+	Record schulze.Record[string]
+}
+
+// String returns the confirmation text of the receipt, suitable for a
+// reply message.
+func (r Receipt) String() string {
+	ranking := schulze.FormatRecord(r.Record)
+	if ranking == "" {
+		return fmt.Sprintf("The empty ballot of %s is recorded as an abstention.", r.Voter)
+	}
+	return fmt.Sprintf("The ballot of %s is recorded: %s", r.Voter, ranking)
+}
+
+// Gateway records ballots from email messages in the election. Methods on the
+// Gateway are safe for concurrent calls and all calls to the election are
+// guarded by the Gateway lock.
+type Gateway struct {
+	election    *schulze.Election[string, string]
+	verifier    Verifier
+	eligibility Eligibility
+	mu          sync.Mutex
+}
+
+// NewGateway returns a new Gateway for the election with the signature
+// verifier and the eligibility function.
+func NewGateway(e *schulze.Election[string, string], v Verifier, eligibility Eligibility) *Gateway {
+	return &Gateway{
+		election:    e,
+		verifier:    v,
+		eligibility: eligibility,
+	}
+}
+
+// Locker returns the lock that guards all calls to the election.
+func (g *Gateway) Locker() sync.Locker {
+	return &g.mu
+}
+
+// Deliver parses the RFC 5322 message, verifies its signature and the
+// eligibility of the signer, and records the ballot from the signed text. A
+// later ballot of the same voter replaces the previous one.
+func (g *Gateway) Deliver(r io.Reader) (*Receipt, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("email: read message: %w", err)
+	}
+	body, err := io.ReadAll(msg.Body)
+	if err != nil {
+		return nil, fmt.Errorf("email: read message body: %w", err)
+	}
+	signer, text, err := g.verifier.Verify(msg.Header, body)
+	if err != nil {
+		return nil, fmt.Errorf("email: verify signature: %w", err)
+	}
+	voter, err := g.eligibility(signer)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ParseBallot(text)
+	if err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	record, err := g.election.Vote(voter, b)
+	g.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return &Receipt{
+		Voter:  voter,
+		Record: record,
+	}, nil
+}
+
+// ParseBallot parses the ballot from the only line of the text that starts
+// with the BallotPrefix. The prefix is case insensitive.
+func ParseBallot(text []byte) (schulze.Ballot[string], error) {
+	var line string
+	var found bool
+	s := bufio.NewScanner(bytes.NewReader(text))
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if len(l) < len(BallotPrefix) || !strings.EqualFold(l[:len(BallotPrefix)], BallotPrefix) {
+			continue
+		}
+		if found {
+			return nil, ErrMultipleBallots
+		}
+		line = l[len(BallotPrefix):]
+		found = true
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNoBallot
+	}
+	return schulze.ParseBallot(line)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package email_test
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/email"
+)
+
+const signaturePrefix = "Signed-By: "
+
+// verifier accepts messages with the body that ends with a fake signature
+// line of a known signer.
+var verifier = email.VerifierFunc(func(header mail.Header, body []byte) (string, []byte, error) {
+	i := bytes.LastIndex(body, []byte(signaturePrefix))
+	if i < 0 {
+		return "", nil, errors.New("no signature")
+	}
+	return strings.TrimSpace(string(body[i+len(signaturePrefix):])), body[:i], nil
+})
+
+var eligibility = func(signer string) (string, error) {
+	switch signer {
+	case "alice-key", "bob-key":
+		return strings.TrimSuffix(signer, "-key"), nil
+	}
+	return "", email.ErrNotEligible
+}
+
+func message(body string) string {
+	return "From: voter@example.com\r\nTo: vote@example.com\r\nSubject: vote\r\n\r\n" + body
+}
+
+func TestGateway_Deliver(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+	g := email.NewGateway(e, verifier, eligibility)
+
+	receipt, err := g.Deliver(strings.NewReader(message("Hello,\r\n\r\nballot: B > A > C\r\n\r\nSigned-By: alice-key\r\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.Voter != "alice" {
+		t.Errorf("got voter %q, want %q", receipt.Voter, "alice")
+	}
+	if want := "The ballot of alice is recorded: B>A>C"; receipt.String() != want {
+		t.Errorf("got receipt %q, want %q", receipt.String(), want)
+	}
+	if r, _ := e.Record("alice"); !reflect.DeepEqual(r, receipt.Record) {
+		t.Errorf("got record %v, want %v", r, receipt.Record)
+	}
+
+	for _, tc := range []struct {
+		name string
+		body string
+		err  error
+	}{
+		{name: "not signed", body: "Ballot: A\r\n", err: nil},
+		{name: "not eligible", body: "Ballot: A\r\nSigned-By: mallory-key\r\n", err: email.ErrNotEligible},
+		{name: "no ballot", body: "Hello\r\nSigned-By: bob-key\r\n", err: email.ErrNoBallot},
+		{name: "multiple ballots", body: "Ballot: A\r\nBallot: B\r\nSigned-By: bob-key\r\n", err: email.ErrMultipleBallots},
+		{name: "ballot outside signed text", body: "Signed-By: bob-key\r\nBallot: A\r\n", err: nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := g.Deliver(strings.NewReader(message(tc.body)))
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if tc.err != nil && !errors.Is(err, tc.err) {
+				t.Errorf("got error %v, want %v", err, tc.err)
+			}
+		})
+	}
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v, want %v", got, 1)
+	}
+}
+
+func TestParseBallot(t *testing.T) {
+	b, err := email.ParseBallot([]byte("> Ballot: old\n#\nBALLOT: A > B\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Ballot[string]{"A": 1, "B": 2}); !reflect.DeepEqual(b, want) {
+		t.Errorf("got ballot %v, want %v", b, want)
+	}
+}
+
+func TestServer(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+	s := &email.Server{
+		Gateway: email.NewGateway(e, verifier, eligibility),
+		Domain:  "vote.example.com",
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() { _ = s.Serve(l) }()
+
+	send := func(body string) error {
+		return smtp.SendMail(l.Addr().String(), nil, "voter@example.com", []string{"vote@example.com"}, []byte(message(body)))
+	}
+
+	if err := send("Ballot: C>B\r\nSigned-By: bob-key\r\n"); err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := e.Record("bob"); !reflect.DeepEqual(r, schulze.Record[string]{{"C"}, {"B"}, {"A"}}) {
+		t.Errorf("got record %v", r)
+	}
+
+	err = send("Ballot: C>B\r\nSigned-By: mallory-key\r\n")
+	if err == nil || !strings.Contains(err.Error(), "550") || !strings.Contains(err.Error(), "not eligible") {
+		t.Errorf("got error %v, want not eligible rejection", err)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package email
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// DefaultMaxMessageSize is the default maximal size of a message accepted by
+// the Server in bytes.
+const DefaultMaxMessageSize = 1 << 20
+
+// Server is a minimal SMTP server that delivers every received message to
+// the Gateway and rejects messages with ballots that are not recorded, so
+// that the sending mail server returns the reason to the voter. It does not
+// relay messages and it does not support TLS, which can be provided by a
+// proxy or the mail server that forwards messages to it.
+type Server struct {
+	Gateway *Gateway
+	// Domain of the server in the greeting.
+	Domain string
+	// Maximal size of a message in bytes, DefaultMaxMessageSize if zero.
+	MaxMessageSize int64
+	// Timeout of every command, no timeout if zero.
+	Timeout time.Duration
+}
+
+// Serve accepts connections on the listener and serves every connection in
+// a separate goroutine. It returns when the listener returns an error.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	c := textproto.NewConn(conn)
+	domain := s.Domain
+	if domain == "" {
+		domain = "localhost"
+	}
+	maxSize := s.MaxMessageSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxMessageSize
+	}
+
+	reply := func(code int, msg string) error {
+		return c.PrintfLine("%d %s", code, msg)
+	}
+	if err := reply(220, domain+" ESMTP schulze"); err != nil {
+		return
+	}
+
+	var from string
+	var recipients int
+	for {
+		if s.Timeout > 0 {
+			_ = conn.SetDeadline(time.Now().Add(s.Timeout))
+		}
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "HELO", "EHLO":
+			from, recipients = "", 0
+			err = reply(250, domain)
+		case "MAIL":
+			if !strings.HasPrefix(strings.ToUpper(arg), "FROM:") {
+				err = reply(501, "syntax: MAIL FROM:<address>")
+				break
+			}
+			from, recipients = strings.TrimSpace(arg[len("FROM:"):]), 0
+			err = reply(250, "OK")
+		case "RCPT":
+			switch {
+			case from == "":
+				err = reply(503, "MAIL first")
+			case !strings.HasPrefix(strings.ToUpper(arg), "TO:"):
+				err = reply(501, "syntax: RCPT TO:<address>")
+			default:
+				recipients++
+				err = reply(250, "OK")
+			}
+		case "DATA":
+			if recipients == 0 {
+				err = reply(503, "RCPT first")
+				break
+			}
+			if err = reply(354, "end data with <CR><LF>.<CR><LF>"); err != nil {
+				break
+			}
+			err = s.data(c, maxSize, reply)
+			from, recipients = "", 0
+		case "RSET":
+			from, recipients = "", 0
+			err = reply(250, "OK")
+		case "NOOP":
+			err = reply(250, "OK")
+		case "QUIT":
+			_ = reply(221, "bye")
+			return
+		default:
+			err = reply(502, "command not implemented")
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// data reads the message and delivers it to the gateway.
+func (s *Server) data(c *textproto.Conn, maxSize int64, reply func(code int, msg string) error) error {
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(c.DotReader(), maxSize+1))
+	if err != nil {
+		return err
+	}
+	if n > maxSize {
+		// discard the rest of the message
+		if _, err := io.Copy(io.Discard, c.DotReader()); err != nil {
+			return err
+		}
+		return reply(552, "message too large")
+	}
+	receipt, err := s.Gateway.Deliver(&buf)
+	if err != nil {
+		return reply(550, singleLine(err.Error()))
+	}
+	return reply(250, singleLine(receipt.String()))
+}
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// EraseVoter removes the voter's ballot from the tally and deletes all data
+// about the voter, including the Record, tags, scores, vetoes, audit
+// attributes, provisional ballot, commitment, proxy appointments with the
+// ballots cast by the voter as a proxy and the association with used voting
+// tokens, to honor a deletion request of the voter. Used tokens can not be
+// used again. Unlike Unvote, the ballot is removed regardless of the
+// election schedule, so the results of a closed election may change. An
+// anonymous tombstone entry without the voter identity is recorded in the
+// audit log for continuity of the audit. It is not an error to erase a voter
+// without any data, in which case no entry is recorded.
+func (e *Election[V, C]) EraseVoter(voter V, reason string) error {
+	var found bool
+	ballotsCount := 0
+	if r, ok := e.records[voter]; ok {
+		if err := e.voting.Unvote(r); err != nil {
+			return err
+		}
+		delete(e.records, voter)
+		e.removeVetoes(voter)
+		found = true
+		ballotsCount++
+	}
+	if _, ok := e.spoiled[voter]; ok {
+		delete(e.spoiled, voter)
+		found = true
+		ballotsCount++
+	}
+	if _, ok := e.provisional[voter]; ok {
+		delete(e.provisional, voter)
+		found = true
+	}
+	if _, ok := e.commitments[voter]; ok {
+		delete(e.commitments, voter)
+		found = true
+	}
+	// tokens remain used, but without the association with the voter
+	for token, v := range e.tokens {
+		if v == voter {
+			delete(e.tokens, token)
+			e.erasedTokens[token] = struct{}{}
+			found = true
+		}
+	}
+	if _, ok := e.proxies[voter]; ok {
+		delete(e.proxies, voter)
+		delete(e.proxied, voter)
+		found = true
+	}
+	// ballots cast by the voter as a proxy are removed with the appointments
+	for principal, proxy := range e.proxies {
+		if proxy != voter {
+			continue
+		}
+		if _, ok := e.proxied[principal]; ok {
+			if err := e.voting.Unvote(e.records[principal]); err != nil {
+				return err
+			}
+			e.removeRecord(principal)
+			ballotsCount++
+		}
+		delete(e.proxies, principal)
+		found = true
+	}
+	delete(e.voted, voter)
+	delete(e.tags, voter)
+	delete(e.scores, voter)
+	delete(e.attributes, voter)
+	if !found {
+		return nil
+	}
+	e.updateDisqualified()
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditErase,
+		Reason:       reason,
+		BallotsCount: ballotsCount,
+	})
+	return nil
+}
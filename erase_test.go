@@ -0,0 +1,105 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_EraseVoter(t *testing.T) {
+	closes := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:  []string{"A", "B"},
+		Schedule: schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := closes.Add(-time.Hour)
+	e.SetNow(func() time.Time { return now })
+
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"A": 1},
+		"bob":   {"B": 1},
+		"carol": {"B": 1},
+	} {
+		if _, err := e.VoteTagged(voter, b, schulze.Tags{"region": "north"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.SetAttributes(voter, schulze.Attributes{"session": voter}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.Spoil("dave"); err != nil {
+		t.Fatal(err)
+	}
+
+	// erasure is possible after the election is closed
+	now = closes
+	for _, voter := range []string{"bob", "carol", "dave", "erin"} {
+		if err := e.EraseVoter(voter, "deletion request"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, ok := e.Record("bob"); ok {
+		t.Error("got record of the erased voter")
+	}
+	if got := e.Tags("bob"); got != nil {
+		t.Errorf("got tags %v of the erased voter", got)
+	}
+	if got := e.Attributes("bob"); got != nil {
+		t.Errorf("got attributes %v of the erased voter", got)
+	}
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v, want %v", got, 1)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "A" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+	}
+	if err := e.SelfCheck(); err != nil {
+		t.Error(err)
+	}
+
+	var tombstones []schulze.AuditEntry
+	for _, entry := range e.AuditLog() {
+		entry.Time = time.Time{}
+		tombstones = append(tombstones, entry)
+	}
+	tombstone := schulze.AuditEntry{Action: schulze.AuditErase, Reason: "deletion request", BallotsCount: 1}
+	if want := []schulze.AuditEntry{tombstone, tombstone, tombstone}; !reflect.DeepEqual(tombstones, want) {
+		t.Errorf("got audit log %+v, want %+v", tombstones, want)
+	}
+}
+
+type acceptAllTokens struct{}
+
+func (acceptAllTokens) VerifyToken(_, _ []byte) error { return nil }
+
+func TestElection_EraseVoter_token(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+	e.SetTokenVerifier(acceptAllTokens{})
+
+	token := []byte("token")
+	if _, err := e.VoteWithToken("pseudonym", token, nil, schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.EraseVoter("pseudonym", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteWithToken("pseudonym", token, nil, schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrTokenUsed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrTokenUsed)
+	}
+}
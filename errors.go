@@ -5,7 +5,189 @@
 
 package schulze
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidPairwiseMatrix is returned when the pairwise matrix does not have
+// the expected dimensions or values.
+var ErrInvalidPairwiseMatrix = errors.New("schulze: invalid pairwise matrix")
+
+// ErrInvalidElectionConfig is returned when the election configuration is not
+// valid.
+var ErrInvalidElectionConfig = errors.New("schulze: invalid election config")
+
+// ErrBallotPolicyViolation is returned when the ballot does not satisfy the
+// ballot policy of the election.
+var ErrBallotPolicyViolation = errors.New("schulze: ballot policy violation")
+
+// ErrElectionNotOpen is returned when voting before the election opens.
+var ErrElectionNotOpen = errors.New("schulze: election is not open")
+
+// ErrElectionClosed is returned when voting after the election closes.
+var ErrElectionClosed = errors.New("schulze: election is closed")
+
+// ErrSealed is returned when the results of a sealed election are requested
+// before the election is closed or unsealed.
+var ErrSealed = errors.New("schulze: election results are sealed")
+
+// ErrInvalidUnsealToken is returned when the unseal token does not match the
+// configured one.
+var ErrInvalidUnsealToken = errors.New("schulze: invalid unseal token")
+
+// ErrCommitRevealRequired is returned when voting directly in a commit-reveal
+// election.
+var ErrCommitRevealRequired = errors.New("schulze: ballot must be committed and revealed")
+
+// ErrNotCommitReveal is returned when committing or revealing a ballot in an
+// election that is not a commit-reveal election.
+var ErrNotCommitReveal = errors.New("schulze: not a commit-reveal election")
+
+// ErrRevealNotOpen is returned when revealing a ballot before the election is
+// closed.
+var ErrRevealNotOpen = errors.New("schulze: reveal phase is not open")
+
+// ErrNoCommitment is returned when revealing a ballot of a voter without a
+// commitment.
+var ErrNoCommitment = errors.New("schulze: no commitment")
+
+// ErrCommitmentMismatch is returned when the revealed ballot and salt do not
+// match the commitment.
+var ErrCommitmentMismatch = errors.New("schulze: commitment mismatch")
+
+// ErrTokenRequired is returned when voting without a voting token in an
+// election that requires it.
+var ErrTokenRequired = errors.New("schulze: voting token required")
+
+// ErrNoTokenVerifier is returned when voting with a voting token in an
+// election without a token verifier.
+var ErrNoTokenVerifier = errors.New("schulze: no token verifier")
+
+// ErrTokenUsed is returned when the voting token is already used by a
+// different voter.
+var ErrTokenUsed = errors.New("schulze: voting token already used")
+
+// ErrInvalidEpsilon is returned when the differential privacy epsilon is not
+// a positive number.
+var ErrInvalidEpsilon = errors.New("schulze: invalid epsilon")
+
+// ErrInvalidStrengthsState is returned when the serialized state of the
+// StrengthsComputation is not valid.
+var ErrInvalidStrengthsState = errors.New("schulze: invalid strengths computation state")
+
+// ErrInvalidTurnoutScenario is returned when the TurnoutScenario has
+// negative numbers of remaining voters or turnouts outside of the range from
+// zero to one.
+var ErrInvalidTurnoutScenario = errors.New("schulze: invalid turnout scenario")
+
+// ErrChallengeWindowOpen is returned when the results are certified before
+// the challenge window ends.
+var ErrChallengeWindowOpen = errors.New("schulze: challenge window is open")
+
+// ErrChallengeWindowClosed is returned when a provisional ballot is cast or
+// resolved after the challenge window ends.
+var ErrChallengeWindowClosed = errors.New("schulze: challenge window is closed")
+
+// ErrNoProvisionalBallot is returned when the voter has no provisional ballot
+// to be resolved.
+var ErrNoProvisionalBallot = errors.New("schulze: no provisional ballot")
+
+// ErrInvalidCertificate is returned when the election Certificate signature
+// is not valid for the public key or the certificate content can not be
+// decoded.
+var ErrInvalidCertificate = errors.New("schulze: invalid certificate")
+
+// ErrUnsupportedKey is returned when the key of the crypto.Signer or the
+// public key is not one of the supported Ed25519, ECDSA and RSA keys.
+var ErrUnsupportedKey = errors.New("schulze: unsupported key")
+
+// ErrInvalidWeight is returned when a weighted ballot has a weight that is
+// not a positive number.
+var ErrInvalidWeight = errors.New("schulze: invalid weight")
+
+// ErrInvalidBallotText is returned when the ballot text can not be parsed
+// by the ParseBallot function.
+var ErrInvalidBallotText = errors.New("schulze: invalid ballot text")
+
+// ErrInvalidBatch is returned when the signature of the BallotBatch is not
+// valid for the public key or the batch content can not be decoded.
+var ErrInvalidBatch = errors.New("schulze: invalid ballot batch")
+
+// ErrBatchImported is returned when the same BallotBatch is imported again.
+var ErrBatchImported = errors.New("schulze: ballot batch already imported")
+
+// ErrVoterNotRegistered is returned when the identity is not registered in
+// the VoterRegistry.
+var ErrVoterNotRegistered = errors.New("schulze: voter not registered")
+
+// ErrRateLimited is matched by the RateLimitError when a call exceeds the
+// rate limit of the election.
+var ErrRateLimited = errors.New("schulze: rate limited")
+
+// ErrResultsNotFinal is returned when choices are seeded from the results of
+// an election that is not in its final phase.
+var ErrResultsNotFinal = errors.New("schulze: results are not final")
+
+// ErrElectionHasBallots is returned when choices are seeded to an election
+// that already has recorded ballots.
+var ErrElectionHasBallots = errors.New("schulze: election has ballots")
+
+// ErrInvalidSeedCount is returned when the number of choices to seed is not
+// positive.
+var ErrInvalidSeedCount = errors.New("schulze: invalid number of seeded choices")
+
+// ErrInvalidProxy is returned when the proxy appointment would make the
+// voter its own proxy or chain proxies.
+var ErrInvalidProxy = errors.New("schulze: invalid proxy")
+
+// ErrVotedPersonally is returned when a proxy is appointed for the principal
+// who already voted personally.
+var ErrVotedPersonally = errors.New("schulze: principal voted personally")
+
+// ErrInvalidWizardAnswer is returned when the answer to a pairwise question
+// of the Wizard is not valid.
+var ErrInvalidWizardAnswer = errors.New("schulze: invalid wizard answer")
+
+// ErrUnnamedElection is returned when the results of an election without a
+// name are added to the Archive.
+var ErrUnnamedElection = errors.New("schulze: unnamed election")
+
+// ErrInvalidVotingState is returned when the serialized state of the Voting
+// or the preferences is not valid.
+var ErrInvalidVotingState = errors.New("schulze: invalid voting state")
+
+// ErrTallyOverflow is returned when a tally value does not fit in the int
+// type of the platform, which is 32 bits wide on 32-bit platforms, or in the
+// int64 preferences of the Voting64 type.
+var ErrTallyOverflow = errors.New("schulze: tally overflow")
+
+// ErrInvalidBallotsCSV is returned when the CSV with ballots has invalid
+// header or cells.
+var ErrInvalidBallotsCSV = errors.New("schulze: invalid ballots csv")
+
+// ErrNoChoices is returned when a voting has no choices.
+var ErrNoChoices = errors.New("schulze: no choices")
+
+// ErrInvalidDevoteeBallot is returned when the line of the Debian devotee
+// tally sheet is not a valid ballot.
+var ErrInvalidDevoteeBallot = errors.New("schulze: invalid devotee ballot")
+
+// ErrNoBallot is returned when the voter has no recorded ballot.
+var ErrNoBallot = errors.New("schulze: no ballot")
+
+// ErrNominationsClosed is returned when a choice is nominated or seconded
+// after the election opens or in an election without the opening time.
+var ErrNominationsClosed = errors.New("schulze: nominations are closed")
+
+// ErrVoteQueueClosed is returned when a ballot is submitted to a closed
+// VoteQueue.
+var ErrVoteQueueClosed = errors.New("schulze: vote queue closed")
+
+// ErrPreferencesLengthMismatch is returned when preferences that are combined
+// are not of the same length.
+var ErrPreferencesLengthMismatch = errors.New("schulze: preferences length mismatch")
 
 type UnknownChoiceError[C comparable] struct {
 	Choice C
@@ -14,3 +196,97 @@ type UnknownChoiceError[C comparable] struct {
 func (e *UnknownChoiceError[C]) Error() string {
 	return fmt.Sprintf("schulze: unknown choice %v", e.Choice)
 }
+
+// SuspendedChoiceError is returned when a ballot ranks a suspended choice.
+type SuspendedChoiceError[C comparable] struct {
+	Choice C
+}
+
+func (e *SuspendedChoiceError[C]) Error() string {
+	return fmt.Sprintf("schulze: suspended choice %v", e.Choice)
+}
+
+// DuplicateChoiceError is returned when a choice is renamed to a choice that
+// already exists.
+type DuplicateChoiceError[C comparable] struct {
+	Choice C
+}
+
+func (e *DuplicateChoiceError[C]) Error() string {
+	return fmt.Sprintf("schulze: duplicate choice %v", e.Choice)
+}
+
+// UnknownPresetError is returned when voting with a preset that is not
+// defined.
+type UnknownPresetError struct {
+	Name string
+}
+
+func (e *UnknownPresetError) Error() string {
+	return fmt.Sprintf("schulze: unknown preset %q", e.Name)
+}
+
+// UnknownElectionError is returned when the Manager or the Archive has no
+// election with the name.
+type UnknownElectionError struct {
+	Name string
+}
+
+func (e *UnknownElectionError) Error() string {
+	return fmt.Sprintf("schulze: unknown election %q", e.Name)
+}
+
+// DuplicateElectionError is returned when an election is added to the
+// Manager or the Archive with the name of an existing election.
+type DuplicateElectionError struct {
+	Name string
+}
+
+func (e *DuplicateElectionError) Error() string {
+	return fmt.Sprintf("schulze: duplicate election %q", e.Name)
+}
+
+// PolicyDeniedError is returned when the PolicyEngine denies the vote.
+type PolicyDeniedError struct {
+	Reason string
+}
+
+func (e *PolicyDeniedError) Error() string {
+	if e.Reason == "" {
+		return "schulze: vote denied by policy"
+	}
+	return fmt.Sprintf("schulze: vote denied by policy: %s", e.Reason)
+}
+
+// RateLimitError is returned when a call exceeds the rate limit of the
+// election. It matches ErrRateLimited with errors.Is.
+type RateLimitError struct {
+	// Duration after which the call would be allowed.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("schulze: rate limited, retry after %v", e.RetryAfter)
+}
+
+// Is returns true for ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// BallotRowError is returned when the ballot in a row of the CSV can not be
+// read or voted.
+type BallotRowError struct {
+	// Line number of the row in the CSV, starting from one for the header.
+	Row int
+	Err error
+}
+
+func (e *BallotRowError) Error() string {
+	return fmt.Sprintf("schulze: ballot in row %v: %v", e.Row, e.Err)
+}
+
+// Unwrap returns the error of the row.
+func (e *BallotRowError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExplainFormat defines the output format of the Explain function.
+type ExplainFormat int
+
+const (
+	// ExplainText formats the explanation as plain text.
+	ExplainText ExplainFormat = iota
+	// ExplainMarkdown formats the explanation as Markdown.
+	ExplainMarkdown
+)
+
+// Explain returns a human-readable narrative of the outcome of the voting,
+// suitable for announcements, from the results and duels returned by the
+// Compute function. The duels iterator is consumed.
+func Explain[C comparable](results []Result[C], duels DuelsIterator[C], format ExplainFormat) string {
+	return ExplainLocalized(results, duels, format, englishPrinter{})
+}
+
+// Printer formats localized messages with locale specific number formatting
+// and pluralization. The *message.Printer of the golang.org/x/text/message
+// package implements it, with messages translated in its catalog.
+type Printer interface {
+	Sprintf(key any, a ...any) string
+}
+
+// Messages of the ExplainLocalized function, used as keys of the Printer.
+// Messages that contain a count of wins or contests, passed as the only
+// argument, are pluralized by the Printer, and the other messages include
+// them as formatted strings.
+const (
+	MessageNoChoices    = "There are no choices."
+	MessageWins         = "%d wins"
+	MessageTiedWinners  = "%[1]s are tied for the first place with %[2]s each"
+	MessageOnlyChoice   = "%s is the only choice"
+	MessageUndefeated   = "%s is the winner, defeating every other choice by the strongest paths"
+	MessageWinner       = "%[1]s is the winner with %[2]s out of %[3]d"
+	MessageClosest      = "; the closest contest was %[1]s vs %[2]s at %[3]d–%[4]d"
+	MessageTiedContests = "%d contests ended in a tie."
+	MessageRanking      = "%[1]d. %[2]s - %[3]s"
+	MessageRankingMD    = "%[1]d. %[2]s: %[3]s"
+	MessageAnd          = "%[1]s and %[2]s"
+)
+
+// ExplainMessages lists all messages of the ExplainLocalized function to be
+// translated.
+var ExplainMessages = []string{
+	MessageNoChoices,
+	MessageWins,
+	MessageTiedWinners,
+	MessageOnlyChoice,
+	MessageUndefeated,
+	MessageWinner,
+	MessageClosest,
+	MessageTiedContests,
+	MessageRanking,
+	MessageRankingMD,
+	MessageAnd,
+}
+
+// ExplainLocalized returns the narrative of the outcome of the voting, just
+// as Explain does, with messages formatted by the Printer, so that
+// announcements can be generated in multiple languages.
+func ExplainLocalized[C comparable](results []Result[C], duels DuelsIterator[C], format ExplainFormat, p Printer) string {
+	choice := func(c C) string {
+		if format == ExplainMarkdown {
+			return "**" + fmt.Sprint(c) + "**"
+		}
+		return fmt.Sprint(c)
+	}
+
+	var b strings.Builder
+
+	if len(results) == 0 {
+		b.WriteString(p.Sprintf(MessageNoChoices))
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	opponents := len(results) - 1
+
+	var winners []string
+	for _, r := range results {
+		if r.Wins != results[0].Wins {
+			break
+		}
+		winners = append(winners, choice(r.Choice))
+	}
+
+	switch {
+	case len(winners) > 1:
+		b.WriteString(p.Sprintf(MessageTiedWinners, joinAnd(p, winners), p.Sprintf(MessageWins, results[0].Wins)))
+	case opponents == 0:
+		b.WriteString(p.Sprintf(MessageOnlyChoice, winners[0]))
+	case results[0].Wins == opponents:
+		b.WriteString(p.Sprintf(MessageUndefeated, winners[0]))
+	default:
+		b.WriteString(p.Sprintf(MessageWinner, winners[0], p.Sprintf(MessageWins, results[0].Wins), opponents))
+	}
+
+	var closest *Duel[C]
+	var closestMargin int
+	var tiedDuels int
+	if duels != nil {
+		for d := duels(); d != nil; d = duels() {
+			winner, defeated := d.Outcome()
+			if winner == nil {
+				tiedDuels++
+				continue
+			}
+			if margin := winner.Strength - defeated.Strength; closest == nil || margin < closestMargin {
+				closest = d
+				closestMargin = margin
+			}
+		}
+	}
+
+	if closest != nil {
+		winner, defeated := closest.Outcome()
+		b.WriteString(p.Sprintf(MessageClosest, choice(winner.Choice), choice(defeated.Choice), winner.Strength, defeated.Strength))
+	}
+	b.WriteString(".")
+	if tiedDuels > 0 {
+		b.WriteString(" ")
+		b.WriteString(p.Sprintf(MessageTiedContests, tiedDuels))
+	}
+	b.WriteString("\n\n")
+
+	ranking := MessageRanking
+	if format == ExplainMarkdown {
+		ranking = MessageRankingMD
+	}
+	for i, r := range results {
+		b.WriteString(p.Sprintf(ranking, i+1, choice(r.Choice), p.Sprintf(MessageWins, r.Wins)))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// englishPrinter is the default Printer with English pluralization of
+// counts.
+type englishPrinter struct{}
+
+var englishSingulars = map[any]string{
+	MessageWins:         "%d win",
+	MessageTiedContests: "%d contest ended in a tie.",
+}
+
+func (englishPrinter) Sprintf(key any, a ...any) string {
+	if singular, ok := englishSingulars[key]; ok && len(a) == 1 && a[0] == 1 {
+		key = singular
+	}
+	return fmt.Sprintf(fmt.Sprint(key), a...)
+}
+
+func joinAnd(p Printer, s []string) string {
+	if len(s) <= 1 {
+		return strings.Join(s, "")
+	}
+	return p.Sprintf(MessageAnd, strings.Join(s[:len(s)-1], ", "), s[len(s)-1])
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func ExampleExplain() {
+	v := schulze.NewVoting([]string{"A", "B", "C"})
+
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "C": 2},
+		{"A": 1, "B": 1},
+		{"A": 1, "B": 2, "C": 2},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	results, duels, _ := v.Compute()
+
+	fmt.Print(schulze.Explain(results, duels, schulze.ExplainText))
+
+	// Output: A is the winner, defeating every other choice by the strongest paths; the closest contest was A vs B at 2–0. 1 contest ended in a tie.
+	//
+	// 1. A - 2 wins
+	// 2. B - 0 wins
+	// 3. C - 0 wins
+}
+
+func TestExplain(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		choices []string
+		ballots []schulze.Ballot[string]
+		format  schulze.ExplainFormat
+		want    string
+	}{
+		{
+			name: "no choices",
+			want: "There are no choices.\n",
+		},
+		{
+			name:    "single choice",
+			choices: []string{"A"},
+			want:    "A is the only choice.\n\n1. A - 0 wins\n",
+		},
+		{
+			name:    "tie",
+			choices: []string{"A", "B", "C"},
+			ballots: []schulze.Ballot[string]{
+				{"A": 1},
+				{"B": 1},
+			},
+			format: schulze.ExplainMarkdown,
+			want:   "**A** and **B** are tied for the first place with 1 win each; the closest contest was **A** vs **C** at 1–0. 1 contest ended in a tie.\n\n1. **A**: 1 win\n2. **B**: 1 win\n3. **C**: 0 wins\n",
+		},
+		{
+			name:    "partial wins",
+			choices: []string{"A", "B", "C"},
+			ballots: []schulze.Ballot[string]{
+				{"A": 1, "B": 1},
+				{"C": 1, "A": 2},
+			},
+			want: "A is the winner with 1 win out of 2; the closest contest was A vs B at 1–0. 2 contests ended in a tie.\n\n1. A - 1 win\n2. B - 0 wins\n3. C - 0 wins\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := schulze.NewVoting(tc.choices)
+			for _, b := range tc.ballots {
+				if _, err := v.Vote(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+			results, duels, _ := v.Compute()
+			if got := schulze.Explain(results, duels, tc.format); got != tc.want {
+				t.Errorf("got\n%q\nwant\n%q", got, tc.want)
+			}
+		})
+	}
+}
+
+// germanPrinter is a minimal Printer with translated messages, German
+// pluralization and a dot as the thousands separator.
+type germanPrinter struct {
+	t *testing.T
+}
+
+var germanMessages = map[string][2]string{
+	schulze.MessageNoChoices:    {"", "Es gibt keine Optionen."},
+	schulze.MessageWins:         {"%s Sieg", "%s Siege"},
+	schulze.MessageTiedWinners:  {"", "%[1]s teilen sich den ersten Platz mit je %[2]s"},
+	schulze.MessageOnlyChoice:   {"", "%s ist die einzige Option"},
+	schulze.MessageUndefeated:   {"", "%s gewinnt und besiegt jede andere Option über die stärksten Pfade"},
+	schulze.MessageWinner:       {"", "%[1]s gewinnt mit %[2]s von %[3]s"},
+	schulze.MessageClosest:      {"", "; das knappste Duell war %[1]s gegen %[2]s mit %[3]s–%[4]s"},
+	schulze.MessageTiedContests: {"%s Duell endete unentschieden.", "%s Duelle endeten unentschieden."},
+	schulze.MessageRanking:      {"", "%[1]s. %[2]s - %[3]s"},
+	schulze.MessageRankingMD:    {"", "%[1]s. %[2]s: %[3]s"},
+	schulze.MessageAnd:          {"", "%[1]s und %[2]s"},
+}
+
+func (p germanPrinter) Sprintf(key any, a ...any) string {
+	m, ok := germanMessages[key.(string)]
+	if !ok {
+		p.t.Errorf("message %q is not translated", key)
+	}
+	msg := m[1]
+	if len(a) == 1 && a[0] == 1 && m[0] != "" {
+		msg = m[0]
+	}
+	for i, v := range a {
+		if n, ok := v.(int); ok {
+			a[i] = germanNumber(n)
+		}
+	}
+	return fmt.Sprintf(msg, a...)
+}
+
+func germanNumber(n int) string {
+	s := fmt.Sprint(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "." + s[i:]
+	}
+	return s
+}
+
+func TestExplainLocalized(t *testing.T) {
+	for _, key := range schulze.ExplainMessages {
+		if _, ok := germanMessages[key]; !ok {
+			t.Errorf("message %q is not translated", key)
+		}
+	}
+
+	v := schulze.NewVoting([]string{"A", "B", "C"})
+	for b, count := range map[string]int{
+		"A>B": 1500,
+		"B":   1200,
+		"C>A": 1000,
+	} {
+		ballot, err := schulze.ParseBallot(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < count; i++ {
+			if _, err := v.Vote(ballot); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	results, duels, _ := v.Compute()
+
+	got := schulze.ExplainLocalized(results, duels, schulze.ExplainText, germanPrinter{t: t})
+	want := "A gewinnt und besiegt jede andere Option über die stärksten Pfade; das knappste Duell war A gegen B mit 2.500–0.\n\n1. A - 2 Siege\n2. B - 1 Sieg\n3. C - 0 Siege\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -5,9 +5,26 @@
 
 package schulze
 
+import "time"
+
 // Preferences reruns a copy of preferences for testing purposes.
 func (v *Voting[C]) Preferences() []int {
 	p := make([]int, len(v.preferences))
 	copy(p, v.preferences)
 	return p
 }
+
+// SetNow replaces the clock of the election for testing purposes.
+func (e *Election[V, C]) SetNow(now func() time.Time) {
+	e.now = now
+}
+
+// Voting returns the underlying voting of the election for testing purposes.
+func (e *Election[V, C]) Voting() *Voting[C] {
+	return e.voting
+}
+
+// SetPreferences replaces the preferences of the voting for testing purposes.
+func (v *Voting64[C]) SetPreferences(preferences []int64) {
+	v.preferences = preferences
+}
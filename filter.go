@@ -0,0 +1,92 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// ComputeWith calculates the results just as Compute does, but only for the
+// eligible choices for which the filter function returns true, such as
+// candidates who accepted the nomination. Ineligible choices are excluded from
+// the results and from the strongest paths, while preferences are not
+// changed. Indexes in results and duels refer to the choices slice.
+func ComputeWith[C comparable](preferences []int, choices []C, filter func(C) bool, opts ...Option[C]) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	return computeWith(preferences, choices, filter, newOptions(opts))
+}
+
+// ComputeWith calculates the results only for the eligible choices for which
+// the filter function returns true, just as the ComputeWith function does.
+// Suspended and disqualified choices are not eligible, and withdrawn choices
+// are removed from the results and duels by their withdrawal mode, just as
+// Compute does. The results are not cached.
+func (v *Voting[C]) ComputeWith(filter func(C) bool) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	if v.hasExcluded() {
+		f := filter
+		filter = func(c C) bool {
+			return !v.excluded(c) && f(c)
+		}
+	}
+	results, duels, tie = computeWith(v.preferences, v.choices, filter, v.options)
+	if !v.countsWithdrawn() {
+		return results, duels, tie
+	}
+	results, tie = v.excludeWithdrawn(results, tie)
+	eligibleDuels := duels
+	duels = func() *Duel[C] {
+		for {
+			d := eligibleDuels()
+			if d == nil {
+				return nil
+			}
+			_, leftWithdrawn := v.withdrawn[d.Left.Choice]
+			_, rightWithdrawn := v.withdrawn[d.Right.Choice]
+			if !leftWithdrawn && !rightWithdrawn {
+				return d
+			}
+		}
+	}
+	return results, duels, tie
+}
+
+// ComputeWith calculates the results only for the eligible choices for which
+// the filter function returns true, just as the ComputeWith function does.
+// ErrSealed is returned if the results of the election are sealed.
+func (e *Election[V, C]) ComputeWith(filter func(C) bool) (results []Result[C], duels DuelsIterator[C], tie bool, err error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, nil, false, err
+	}
+	results, duels, tie = e.voting.ComputeWith(filter)
+	return results, duels, tie, nil
+}
+
+func computeWith[C comparable](preferences []int, choices []C, filter func(C) bool, o options[C]) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	eligible := make([]C, 0, len(choices))
+	indexes := make([]int, 0, len(choices))
+	for i, c := range choices {
+		if filter(c) {
+			eligible = append(eligible, c)
+			indexes = append(indexes, i)
+		}
+	}
+
+	eligiblePreferences := SetChoices(preferences, choices, eligible)
+	strengths := pathStrengths(eligible, eligiblePreferences, o)
+	results, tie = calculateResults(eligible, strengths, o)
+
+	for i := range results {
+		results[i].Index = indexes[results[i].Index]
+	}
+
+	eligibleDuels := newDuelsIterator(eligible, strengths)
+	duels = func() *Duel[C] {
+		d := eligibleDuels()
+		if d == nil {
+			return nil
+		}
+		d.Left.Index = indexes[d.Left.Index]
+		d.Right.Index = indexes[d.Right.Index]
+		return d
+	}
+
+	return results, duels, tie
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_ComputeWith(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+
+	v := schulze.NewVoting(choices)
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"A": 1, "C": 2},
+		{"B": 1, "D": 2},
+		{"C": 1, "B": 2},
+		{"C": 1},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	allResults, _, _ := v.Compute()
+
+	declined := map[string]bool{"A": true}
+	results, duels, tie := v.ComputeWith(func(c string) bool {
+		return !declined[c]
+	})
+
+	// results must be the same as of the voting without the declined choice,
+	// except that indexes refer to all choices
+	w := schulze.NewVoting(choices)
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"A": 1, "C": 2},
+		{"B": 1, "D": 2},
+		{"C": 1, "B": 2},
+		{"C": 1},
+	} {
+		if _, err := w.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.SetChoices([]string{"B", "C", "D"})
+	wantResults, _, wantTie := w.Compute()
+	for i := range wantResults {
+		wantResults[i].Index++
+	}
+
+	if tie != wantTie {
+		t.Errorf("got tie %v, want %v", tie, wantTie)
+	}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("got results %+v, want %+v", results, wantResults)
+	}
+	if results[0].Choice != "C" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "C")
+	}
+
+	var count int
+	for _, d := range collectDuels(duels) {
+		count++
+		if choices[d.Left.Index] != d.Left.Choice || choices[d.Right.Index] != d.Right.Choice {
+			t.Errorf("got duel with indexes not matching choices %+v", d)
+		}
+		if d.Left.Choice == "A" || d.Right.Choice == "A" {
+			t.Errorf("got duel with ineligible choice %+v", d)
+		}
+	}
+	if count != 3 {
+		t.Errorf("got %v duels, want %v", count, 3)
+	}
+
+	// preferences are not changed
+	if results, _, _ := v.Compute(); !reflect.DeepEqual(results, allResults) {
+		t.Errorf("got results %+v after filtered computation, want %+v", results, allResults)
+	}
+}
+
+func TestVoting_ComputeWith_withdrawn(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	v := schulze.NewVoting(choices)
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"A": 1, "C": 2},
+		{"B": 1, "A": 2},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := v.WithdrawChoice("A", schulze.WithdrawKeepCounting); err != nil {
+		t.Fatal(err)
+	}
+
+	results, duels, tie := v.ComputeWith(func(string) bool { return true })
+	wantResults, wantDuels, wantTie := v.Compute()
+	if !reflect.DeepEqual(results, wantResults) || tie != wantTie {
+		t.Errorf("got results %+v, tie %v, want %+v, tie %v", results, tie, wantResults, wantTie)
+	}
+	if got, want := collectDuels(duels), collectDuels(wantDuels); !reflect.DeepEqual(got, want) {
+		t.Errorf("got duels %+v, want %+v", got, want)
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want B", results[0].Choice)
+	}
+}
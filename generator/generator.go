@@ -0,0 +1,262 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package generator generates machine-readable test vectors with choices,
+// ballots and the expected pairwise preferences, strongest paths strengths
+// and ranking, computed by the schulze package, so that implementations of
+// the Schulze method in other languages can be validated against it as the
+// reference.
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+	"strconv"
+
+	"resenje.org/schulze"
+)
+
+// VectorsVersion is the version of the test vectors JSON format.
+const VectorsVersion = 1
+
+// ErrMismatch is returned by the Verify function when the computed values
+// differ from the expected values of the vector.
+var ErrMismatch = errors.New("generator: vector mismatch")
+
+// Vectors is the JSON document with test vectors.
+type Vectors struct {
+	Version int      `json:"version"`
+	Vectors []Vector `json:"vectors"`
+}
+
+// Vector is a single test case with the input choices and ballots and the
+// expected output values.
+type Vector struct {
+	Name    string   `json:"name"`
+	Choices []string `json:"choices"`
+	Ballots []Ballot `json:"ballots"`
+	// Expected pairwise preferences matrix, where the value in row i and
+	// column j is the number of ballots that preferred the choice i over the
+	// choice j. Diagonal values are zero.
+	Preferences [][]int `json:"preferences"`
+	// Expected strongest paths strengths matrix, with the same layout as
+	// Preferences, where only links with more preferences than in the
+	// opposite direction form paths. Diagonal values are zero.
+	Strengths [][]int `json:"strengths"`
+	// Expected ranking, sorted by the number of wins, then by the sum of
+	// strengths of wins, and then by the choice index.
+	Ranking []Result `json:"ranking"`
+	// True if multiple choices have the most wins.
+	Tie bool `json:"tie"`
+}
+
+// Ballot is a ranking of choices cast by the number of voters. Lower rank
+// values are preferred and choices with the same rank are equally preferred.
+// Choices that are not ranked are less preferred than all ranked choices.
+type Ballot struct {
+	Count   int            `json:"count"`
+	Ranking map[string]int `json:"ranking"`
+}
+
+// Result is the expected standing of a single choice.
+type Result struct {
+	Choice   string `json:"choice"`
+	Index    int    `json:"index"`
+	Wins     int    `json:"wins"`
+	Strength int    `json:"strength"`
+}
+
+// NewVector computes the expected values for the choices and ballots.
+func NewVector(name string, choices []string, ballots []Ballot) (Vector, error) {
+	if ballots == nil {
+		ballots = make([]Ballot, 0)
+	}
+	preferences := schulze.NewPreferences(len(choices))
+	for i, b := range ballots {
+		for j := 0; j < b.Count; j++ {
+			if _, err := schulze.Vote(preferences, choices, schulze.Ballot[string](b.Ranking)); err != nil {
+				return Vector{}, fmt.Errorf("ballot %v: %w", i, err)
+			}
+		}
+	}
+	strengths := schulze.PairwiseStrengths(preferences, choices)
+	results, _, tie := schulze.RankFromStrengths(strengths, choices)
+
+	ranking := make([]Result, 0, len(results))
+	for _, r := range results {
+		ranking = append(ranking, Result{
+			Choice:   r.Choice,
+			Index:    r.Index,
+			Wins:     r.Wins,
+			Strength: r.Strength,
+		})
+	}
+	return Vector{
+		Name:        name,
+		Choices:     choices,
+		Ballots:     ballots,
+		Preferences: matrix(preferences, len(choices)),
+		Strengths:   matrix(strengths, len(choices)),
+		Ranking:     ranking,
+		Tie:         tie,
+	}, nil
+}
+
+// Random generates a vector with the number of choices and distinct ballots,
+// each cast by up to maxCount voters, with random rankings that may leave
+// choices unranked or rank them equally.
+func Random(r *rand.Rand, name string, choicesCount, ballotsCount, maxCount int) (Vector, error) {
+	choices := make([]string, choicesCount)
+	for i := range choices {
+		choices[i] = choiceName(i)
+	}
+	ballots := make([]Ballot, 0, ballotsCount)
+	for i := 0; i < ballotsCount; i++ {
+		ranking := make(map[string]int)
+		for _, c := range choices {
+			// rank about two thirds of choices with ranks that are likely to
+			// repeat
+			if r.Intn(3) > 0 {
+				ranking[c] = r.Intn(choicesCount) + 1
+			}
+		}
+		ballots = append(ballots, Ballot{
+			Count:   r.Intn(maxCount) + 1,
+			Ranking: ranking,
+		})
+	}
+	return NewVector(name, choices, ballots)
+}
+
+// Generate returns the canonical vectors, followed by the number of random
+// vectors generated from the seed.
+func Generate(seed int64, count int) (Vectors, error) {
+	vectors := Vectors{
+		Version: VectorsVersion,
+	}
+	for _, c := range canonical {
+		v, err := NewVector(c.name, c.choices, c.ballots)
+		if err != nil {
+			return Vectors{}, fmt.Errorf("vector %s: %w", c.name, err)
+		}
+		vectors.Vectors = append(vectors.Vectors, v)
+	}
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < count; i++ {
+		name := "random-" + strconv.Itoa(i+1)
+		v, err := Random(r, name, r.Intn(12)+1, r.Intn(30)+1, 10)
+		if err != nil {
+			return Vectors{}, fmt.Errorf("vector %s: %w", name, err)
+		}
+		vectors.Vectors = append(vectors.Vectors, v)
+	}
+	return vectors, nil
+}
+
+// WriteJSON writes the vectors as indented JSON.
+func WriteJSON(w io.Writer, vectors Vectors) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(vectors)
+}
+
+// Verify computes the values of the vector from its choices and ballots and
+// returns an error that wraps ErrMismatch if they differ from the expected
+// values.
+func Verify(v Vector) error {
+	got, err := NewVector(v.Name, v.Choices, v.Ballots)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(got.Preferences, v.Preferences) {
+		return fmt.Errorf("%w: %s: preferences", ErrMismatch, v.Name)
+	}
+	if !reflect.DeepEqual(got.Strengths, v.Strengths) {
+		return fmt.Errorf("%w: %s: strengths", ErrMismatch, v.Name)
+	}
+	if !reflect.DeepEqual(got.Ranking, v.Ranking) {
+		return fmt.Errorf("%w: %s: ranking", ErrMismatch, v.Name)
+	}
+	if got.Tie != v.Tie {
+		return fmt.Errorf("%w: %s: tie", ErrMismatch, v.Name)
+	}
+	return nil
+}
+
+func matrix(values []int, choicesCount int) [][]int {
+	m := make([][]int, choicesCount)
+	for i := range m {
+		m[i] = make([]int, choicesCount)
+		for j := range m[i] {
+			if i != j {
+				m[i][j] = values[i*choicesCount+j]
+			}
+		}
+	}
+	return m
+}
+
+// choiceName returns names A to Z, followed by AA, AB and so on, for choice
+// indexes.
+func choiceName(i int) string {
+	name := ""
+	for i >= 0 {
+		name = string(rune('A'+i%26)) + name
+		i = i/26 - 1
+	}
+	return name
+}
+
+var canonical = []struct {
+	name    string
+	choices []string
+	ballots []Ballot
+}{
+	{
+		name:    "empty",
+		choices: []string{"A", "B", "C"},
+	},
+	{
+		name:    "single choice",
+		choices: []string{"A"},
+		ballots: []Ballot{{Count: 1, Ranking: map[string]int{"A": 1}}},
+	},
+	{
+		name:    "tie",
+		choices: []string{"A", "B"},
+		ballots: []Ballot{
+			{Count: 1, Ranking: map[string]int{"A": 1}},
+			{Count: 1, Ranking: map[string]int{"B": 1}},
+		},
+	},
+	{
+		// example from the Schulze method Wikipedia article
+		name:    "wikipedia",
+		choices: []string{"A", "B", "C", "D", "E"},
+		ballots: []Ballot{
+			{Count: 5, Ranking: map[string]int{"A": 1, "C": 2, "B": 3, "E": 4, "D": 5}},
+			{Count: 5, Ranking: map[string]int{"A": 1, "D": 2, "E": 3, "C": 4, "B": 5}},
+			{Count: 8, Ranking: map[string]int{"B": 1, "E": 2, "D": 3, "A": 4, "C": 5}},
+			{Count: 3, Ranking: map[string]int{"C": 1, "A": 2, "B": 3, "E": 4, "D": 5}},
+			{Count: 7, Ranking: map[string]int{"C": 1, "A": 2, "E": 3, "B": 4, "D": 5}},
+			{Count: 2, Ranking: map[string]int{"C": 1, "B": 2, "A": 3, "D": 4, "E": 5}},
+			{Count: 7, Ranking: map[string]int{"D": 1, "C": 2, "E": 3, "B": 4, "A": 5}},
+			{Count: 8, Ranking: map[string]int{"E": 1, "B": 2, "A": 3, "D": 4, "C": 5}},
+		},
+	},
+	{
+		name:    "equal ranks and unranked choices",
+		choices: []string{"A", "B", "C", "D"},
+		ballots: []Ballot{
+			{Count: 2, Ranking: map[string]int{"A": 1, "B": 1}},
+			{Count: 1, Ranking: map[string]int{"C": 1, "A": 2}},
+			{Count: 3, Ranking: map[string]int{"D": 2, "B": 1}},
+		},
+	},
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze/generator"
+)
+
+func TestGenerate(t *testing.T) {
+	vectors, err := generator.Generate(42, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vectors.Version != generator.VectorsVersion {
+		t.Errorf("got version %v, want %v", vectors.Version, generator.VectorsVersion)
+	}
+
+	var buf bytes.Buffer
+	if err := generator.WriteJSON(&buf, vectors); err != nil {
+		t.Fatal(err)
+	}
+	var decoded generator.Vectors
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, vectors) {
+		t.Error("decoded vectors differ")
+	}
+
+	var wikipedia *generator.Vector
+	for i, v := range decoded.Vectors {
+		if err := generator.Verify(v); err != nil {
+			t.Error(err)
+		}
+		if v.Name == "wikipedia" {
+			wikipedia = &decoded.Vectors[i]
+		}
+	}
+	if wikipedia == nil {
+		t.Fatal("wikipedia vector not found")
+	}
+	var ranking []string
+	for _, r := range wikipedia.Ranking {
+		ranking = append(ranking, r.Choice)
+	}
+	if want := []string{"E", "A", "C", "B", "D"}; !reflect.DeepEqual(ranking, want) {
+		t.Errorf("got ranking %v, want %v", ranking, want)
+	}
+	if got, want := wikipedia.Strengths[0], []int{0, 28, 28, 30, 24}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got strengths of A %v, want %v", got, want)
+	}
+
+	again, err := generator.Generate(42, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again, vectors) {
+		t.Error("vectors generated with the same seed differ")
+	}
+
+	wikipedia.Strengths[0][1]++
+	if err := generator.Verify(*wikipedia); !errors.Is(err, generator.ErrMismatch) {
+		t.Errorf("got error %v, want %v", err, generator.ErrMismatch)
+	}
+}
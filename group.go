@@ -0,0 +1,70 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// GroupResult holds the results of a single group of choices.
+type GroupResult[C comparable] struct {
+	// Choices of the group in the order of the voting choices. Indexes in
+	// Results and Duels refer to this slice.
+	Choices []C
+	Results []Result[C]
+	Duels   DuelsIterator[C]
+	Tie     bool
+}
+
+// GroupResults calculates results separately for every group of choices, such
+// as candidates per department, where the group of a choice is returned by the
+// groupOf function. All groups are computed from the same preferences, so
+// ballots may rank choices across groups, and pairwise preferences between
+// choices of the same group are not affected by the choices of other groups.
+func GroupResults[C, G comparable](preferences []int, choices []C, groupOf func(C) G, opts ...Option[C]) map[G]GroupResult[C] {
+	v := &Voting[C]{
+		choices:     choices,
+		preferences: preferences,
+		options:     newOptions(opts),
+	}
+	return groupResults(v, groupOf)
+}
+
+// GroupVotingResults calculates results separately for every group of choices
+// of the voting, just as GroupResults does, excluding the suspended,
+// disqualified and withdrawn choices from the results of their groups. It is
+// a function and not a Voting method as methods can not have additional type
+// parameters.
+func GroupVotingResults[C, G comparable](v *Voting[C], groupOf func(C) G) map[G]GroupResult[C] {
+	return groupResults(v, groupOf)
+}
+
+// GroupElectionResults calculates results separately for every group of
+// choices of the election, just as GroupResults does. ErrSealed is returned if
+// the results of the election are sealed.
+func GroupElectionResults[V, C, G comparable](e *Election[V, C], groupOf func(C) G) (map[G]GroupResult[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, err
+	}
+	return GroupVotingResults(e.voting, groupOf), nil
+}
+
+func groupResults[C, G comparable](v *Voting[C], groupOf func(C) G) map[G]GroupResult[C] {
+	groups := make(map[G][]C)
+	for _, c := range v.choices {
+		g := groupOf(c)
+		groups[g] = append(groups[g], c)
+	}
+
+	results := make(map[G]GroupResult[C], len(groups))
+	for g, groupChoices := range groups {
+		group := v.derive(groupChoices, SetChoices(v.preferences, v.choices, groupChoices))
+		r, duels, tie := group.Compute()
+		results[g] = GroupResult[C]{
+			Choices: groupChoices,
+			Results: r,
+			Duels:   duels,
+			Tie:     tie,
+		}
+	}
+	return results
+}
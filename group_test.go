@@ -0,0 +1,94 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestGroupResults(t *testing.T) {
+	choices := []string{"sales/A", "dev/B", "sales/C", "dev/D", "dev/E"}
+	department := func(c string) string {
+		return strings.SplitN(c, "/", 2)[0]
+	}
+
+	v := schulze.NewVoting(choices)
+	for _, b := range []schulze.Ballot[string]{
+		{"dev/B": 1, "sales/C": 2, "sales/A": 3},
+		{"sales/A": 1, "dev/D": 2, "dev/B": 3},
+		{"dev/D": 1, "sales/C": 1, "dev/E": 2},
+		{"dev/D": 1, "sales/C": 2},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	groups := schulze.GroupVotingResults(v, department)
+	if len(groups) != 2 {
+		t.Fatalf("got %v groups, want %v", len(groups), 2)
+	}
+
+	for g, want := range map[string][]string{
+		"sales": {"sales/A", "sales/C"},
+		"dev":   {"dev/B", "dev/D", "dev/E"},
+	} {
+		group := groups[g]
+		if !reflect.DeepEqual(group.Choices, want) {
+			t.Errorf("%s: got choices %v, want %v", g, group.Choices, want)
+		}
+
+		// results of a group must be the same as of the voting with only the
+		// choices of the group and ballots restricted to them
+		gv := schulze.NewVoting(want)
+		for _, b := range []schulze.Ballot[string]{
+			{"dev/B": 1, "sales/C": 2, "sales/A": 3},
+			{"sales/A": 1, "dev/D": 2, "dev/B": 3},
+			{"dev/D": 1, "sales/C": 1, "dev/E": 2},
+			{"dev/D": 1, "sales/C": 2},
+		} {
+			restricted := make(schulze.Ballot[string])
+			for c, rank := range b {
+				if department(c) == g {
+					restricted[c] = rank
+				}
+			}
+			if _, err := gv.Vote(restricted); err != nil {
+				t.Fatal(err)
+			}
+		}
+		wantResults, _, wantTie := gv.Compute()
+		if group.Tie != wantTie {
+			t.Errorf("%s: got tie %v, want %v", g, group.Tie, wantTie)
+		}
+		if !reflect.DeepEqual(group.Results, wantResults) {
+			t.Errorf("%s: got results %+v, want %+v", g, group.Results, wantResults)
+		}
+	}
+
+	if groups["dev"].Results[0].Choice != "dev/D" {
+		t.Errorf("got dev winner %v, want %v", groups["dev"].Results[0].Choice, "dev/D")
+	}
+}
+
+func TestGroupElectionResults_suspended(t *testing.T) {
+	e := newSuspendedElection(t)
+
+	groups, err := schulze.GroupElectionResults(e, func(c string) bool { return c != "C" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := resultChoices(groups[true].Results), []string{"B"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got results %v, want %v", got, want)
+	}
+	if duels := collectDuels(groups[true].Duels); len(duels) != 0 {
+		t.Errorf("got duels %+v with the suspended choice", duels)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+)
+
+// StateHash returns the SHA-256 hash of the voting choices and preferences.
+// Votings with the same choices in the same order and the same preferences
+// have the same state hash, regardless of the order of votes that led to the
+// state. Choices are hashed by their default formatting, as produced by the
+// fmt package.
+func (v *Voting[C]) StateHash() []byte {
+	if v.stateHash == nil {
+		v.stateHash = stateHash(v.choices, v.preferences)
+	}
+	return append([]byte(nil), v.stateHash...)
+}
+
+func stateHash[C comparable](choices []C, preferences []int) []byte {
+	h := sha256.New()
+	writeUint64(h, uint64(len(choices)))
+	for _, c := range choices {
+		s := fmt.Sprint(c)
+		writeUint64(h, uint64(len(s)))
+		_, _ = h.Write([]byte(s))
+	}
+	for _, p := range preferences {
+		writeUint64(h, uint64(p))
+	}
+	return h.Sum(nil)
+}
+
+func writeUint64(h hash.Hash, v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	_, _ = h.Write(b[:])
+}
+
+// WithResultsCache keeps up to size computations of previous voting states,
+// keyed by the state hash, so that the Compute method does not repeat the
+// calculation when the voting returns to one of the previous states, for
+// example when a ballot is voted and then unvoted. The computation of the
+// current state is always cached until the voting is changed.
+func WithResultsCache[C comparable](size int) Option[C] {
+	return func(o *options[C]) {
+		o.resultsCacheSize = size
+	}
+}
+
+type computation[C comparable] struct {
+	stateHash string
+	strengths []int
+	results   []Result[C]
+	tie       bool
+}
+
+// resultsCache is a least recently used cache of computations.
+type resultsCache[C comparable] struct {
+	size    int
+	entries []computation[C]
+}
+
+func (c *resultsCache[C]) get(stateHash string) (computation[C], bool) {
+	for i, e := range c.entries {
+		if e.stateHash == stateHash {
+			copy(c.entries[1:i+1], c.entries[:i])
+			c.entries[0] = e
+			return e, true
+		}
+	}
+	return computation[C]{}, false
+}
+
+func (c *resultsCache[C]) add(e computation[C]) {
+	if len(c.entries) < c.size {
+		c.entries = append(c.entries, computation[C]{})
+	}
+	copy(c.entries[1:], c.entries)
+	c.entries[0] = e
+}
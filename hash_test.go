@@ -0,0 +1,113 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"bytes"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_StateHash(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	ballots := []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"C": 1},
+		{"B": 1, "C": 1, "A": 2},
+	}
+
+	v1 := schulze.NewVoting(choices)
+	for _, b := range ballots {
+		if _, err := v1.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	v2 := schulze.NewVoting(choices)
+	for i := len(ballots) - 1; i >= 0; i-- {
+		if _, err := v2.Vote(ballots[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := v1.StateHash()
+	if len(h) != 32 {
+		t.Fatalf("got state hash length %v, want %v", len(h), 32)
+	}
+	if !bytes.Equal(h, v2.StateHash()) {
+		t.Error("got different state hashes for the same state")
+	}
+
+	r, err := v2.Vote(schulze.Ballot[string]{"A": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(h, v2.StateHash()) {
+		t.Error("got the same state hash after vote")
+	}
+	if err := v2.Unvote(r); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(h, v2.StateHash()) {
+		t.Error("got different state hash after unvote")
+	}
+
+	v2.SetChoices([]string{"A", "C", "B"})
+	if bytes.Equal(h, v2.StateHash()) {
+		t.Error("got the same state hash after rearranging choices")
+	}
+}
+
+func TestWithResultsCache(t *testing.T) {
+	c := &countingPathStrengthComputer{PathStrengthComputer: schulze.ParallelPathStrengthComputer{}}
+
+	v := schulze.NewVoting([]string{"A", "B", "C"},
+		schulze.WithPathStrengthComputer[string](c),
+		schulze.WithResultsCache[string](2),
+	)
+
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	want, _, _ := v.Compute()
+
+	var records []schulze.Record[string]
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1},
+		{"C": 1},
+	} {
+		r, err := v.Vote(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, r)
+		_, _, _ = v.Compute()
+	}
+	if c.calls != 3 {
+		t.Fatalf("got %v calls, want %v", c.calls, 3)
+	}
+
+	// the computation of the second state is in the cache
+	if err := v.Unvote(records[1]); err != nil {
+		t.Fatal(err)
+	}
+	_, _, _ = v.Compute()
+	if c.calls != 3 {
+		t.Errorf("got %v calls, want %v", c.calls, 3)
+	}
+
+	// the computation of the first state was evicted from the cache
+	if err := v.Unvote(records[0]); err != nil {
+		t.Fatal(err)
+	}
+	got, _, _ := v.Compute()
+	if c.calls != 4 {
+		t.Errorf("got %v calls, want %v", c.calls, 4)
+	}
+	if got[0] != want[0] {
+		t.Errorf("got winner %+v, want %+v", got[0], want[0])
+	}
+}
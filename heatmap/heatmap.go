@@ -0,0 +1,216 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package heatmap renders the pairwise margins of the Schulze method as a
+// heatmap image, with choices on both axes in the order of the choices slice
+// and every cell colored by the margin of the row choice over the column
+// choice, blue when it is positive and red when it is negative, with the
+// intensity relative to the largest absolute margin.
+//
+// Images are written as SVG documents, with choice labels and margin values,
+// or as PNG images with only the colored cells, as the standard library
+// provides no fonts to render the labels.
+package heatmap
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+
+	"resenje.org/schulze"
+)
+
+// DefaultCellSize is the default width and height of a heatmap cell in
+// pixels.
+const DefaultCellSize = 40
+
+var (
+	positiveColor = color.RGBA{R: 0x21, G: 0x66, B: 0xac, A: 0xff}
+	negativeColor = color.RGBA{R: 0xb2, G: 0x18, B: 0x2b, A: 0xff}
+	neutralColor  = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	diagonalColor = color.RGBA{R: 0xe0, G: 0xe0, B: 0xe0, A: 0xff}
+)
+
+// Option configures the rendering of the heatmap.
+type Option func(*options)
+
+type options struct {
+	cellSize int
+}
+
+// WithCellSize sets the width and height of a heatmap cell in pixels. Values
+// that are not positive are ignored.
+func WithCellSize(size int) Option {
+	return func(o *options) {
+		if size > 0 {
+			o.cellSize = size
+		}
+	}
+}
+
+// WriteSVG writes the heatmap of pairwise margins of the preferences as an
+// SVG document. Every cell has a title with the choices and the margin, shown
+// as a tooltip by web browsers.
+func WriteSVG[C comparable](w io.Writer, preferences []int, choices []C, opts ...Option) error {
+	m, err := newMargins(preferences, len(choices))
+	if err != nil {
+		return err
+	}
+	o := newOptions(opts)
+
+	labels := make([]string, len(choices))
+	labelWidth := 0
+	for i, c := range choices {
+		labels[i] = fmt.Sprint(c)
+		if l := len([]rune(labels[i])); l > labelWidth {
+			labelWidth = l
+		}
+	}
+	// approximate width of the labels with the average character width
+	offset := labelWidth*o.cellSize/4 + o.cellSize/2
+	size := offset + len(choices)*o.cellSize
+	fontSize := o.cellSize * 3 / 10
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%v" height="%v" viewBox="0 0 %v %v" font-family="sans-serif" font-size="%v">`+"\n", size, size, size, size, fontSize)
+	for i, label := range labels {
+		center := offset + i*o.cellSize + o.cellSize/2
+		fmt.Fprintf(bw, `<text x="%v" y="%v" text-anchor="end" dominant-baseline="middle">%s</text>`+"\n", offset-o.cellSize/8, center, escape(label))
+		fmt.Fprintf(bw, `<text x="%v" y="%v" text-anchor="start" dominant-baseline="middle" transform="rotate(-90 %v %v)">%s</text>`+"\n", center, offset-o.cellSize/8, center, offset-o.cellSize/8, escape(label))
+	}
+	for i := range choices {
+		for j := range choices {
+			x := offset + j*o.cellSize
+			y := offset + i*o.cellSize
+			c := m.color(i, j)
+			fmt.Fprintf(bw, `<g><title>%s over %s: %v</title>`, escape(labels[i]), escape(labels[j]), m.margin(i, j))
+			fmt.Fprintf(bw, `<rect x="%v" y="%v" width="%v" height="%v" fill="#%02x%02x%02x"/>`, x, y, o.cellSize, o.cellSize, c.R, c.G, c.B)
+			if i != j {
+				fmt.Fprintf(bw, `<text x="%v" y="%v" text-anchor="middle" dominant-baseline="middle">%v</text>`, x+o.cellSize/2, y+o.cellSize/2, m.margin(i, j))
+			}
+			bw.WriteString("</g>\n")
+		}
+	}
+	bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+// WritePNG writes the heatmap of pairwise margins of the preferences as a PNG
+// image without labels.
+func WritePNG[C comparable](w io.Writer, preferences []int, choices []C, opts ...Option) error {
+	img, err := Image(preferences, choices, opts...)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, img)
+}
+
+// Image returns the heatmap of pairwise margins of the preferences as an
+// image without labels, which can be composed with other images or encoded
+// in any format.
+func Image[C comparable](preferences []int, choices []C, opts ...Option) (*image.RGBA, error) {
+	m, err := newMargins(preferences, len(choices))
+	if err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+
+	size := len(choices) * o.cellSize
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for i := range choices {
+		for j := range choices {
+			c := m.color(i, j)
+			for y := i * o.cellSize; y < (i+1)*o.cellSize; y++ {
+				for x := j * o.cellSize; x < (j+1)*o.cellSize; x++ {
+					img.SetRGBA(x, y, c)
+				}
+			}
+		}
+	}
+	return img, nil
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		cellSize: DefaultCellSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// margins provides pairwise margins and cell colors from the preferences.
+type margins struct {
+	preferences []int
+	n           int
+	max         int
+}
+
+func newMargins(preferences []int, choicesCount int) (*margins, error) {
+	if len(preferences) != choicesCount*choicesCount {
+		return nil, fmt.Errorf("%w: got length %v, want %v", schulze.ErrPreferencesLengthMismatch, len(preferences), choicesCount*choicesCount)
+	}
+	m := &margins{
+		preferences: preferences,
+		n:           choicesCount,
+	}
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			if d := m.margin(i, j); d > m.max {
+				m.max = d
+			}
+		}
+	}
+	return m, nil
+}
+
+// margin returns the margin of the choice with index i over the choice with
+// index j.
+func (m *margins) margin(i, j int) int {
+	if i == j {
+		return 0
+	}
+	return m.preferences[i*m.n+j] - m.preferences[j*m.n+i]
+}
+
+func (m *margins) color(i, j int) color.RGBA {
+	if i == j {
+		return diagonalColor
+	}
+	d := m.margin(i, j)
+	if d == 0 || m.max == 0 {
+		return neutralColor
+	}
+	target := positiveColor
+	if d < 0 {
+		target = negativeColor
+		d = -d
+	}
+	t := float64(d) / float64(m.max)
+	mix := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+	}
+	return color.RGBA{
+		R: mix(neutralColor.R, target.R),
+		G: mix(neutralColor.G, target.G),
+		B: mix(neutralColor.B, target.B),
+		A: 0xff,
+	}
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
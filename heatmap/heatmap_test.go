@@ -0,0 +1,117 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package heatmap_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/heatmap"
+)
+
+func testPreferences(t *testing.T) ([]int, []string) {
+	t.Helper()
+
+	choices := []string{"A", "B", "<C>"}
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"A": 1, "<C>": 2},
+		{"B": 1},
+	} {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return preferences, choices
+}
+
+func TestWriteSVG(t *testing.T) {
+	preferences, choices := testPreferences(t)
+
+	var buf bytes.Buffer
+	if err := heatmap.WriteSVG(&buf, preferences, choices); err != nil {
+		t.Fatal(err)
+	}
+
+	// the document must be well formed
+	d := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+	var rects int
+	for {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e, ok := token.(xml.StartElement); ok && e.Name.Local == "rect" {
+			rects++
+		}
+	}
+	if rects != len(choices)*len(choices) {
+		t.Errorf("got %v cells, want %v", rects, len(choices)*len(choices))
+	}
+
+	svg := buf.String()
+	for _, want := range []string{
+		"&lt;C&gt;",
+		"<title>A over B: 1</title>",
+		"<title>B over A: -1</title>",
+		// the largest margin has the full color
+		`fill="#2166ac"`,
+		`fill="#b2182b"`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("svg does not contain %q", want)
+		}
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	preferences, choices := testPreferences(t)
+
+	var buf bytes.Buffer
+	if err := heatmap.WritePNG(&buf, preferences, choices, heatmap.WithCellSize(10)); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := img.Bounds().Dx(), 30; got != want {
+		t.Fatalf("got width %v, want %v", got, want)
+	}
+
+	rgba := func(x, y int) color.RGBA {
+		return color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+	}
+	// A over <C> has the largest margin of 2
+	if got, want := rgba(25, 5), (color.RGBA{R: 0x21, G: 0x66, B: 0xac, A: 0xff}); got != want {
+		t.Errorf("got color %v, want %v", got, want)
+	}
+	if got, want := rgba(5, 25), (color.RGBA{R: 0xb2, G: 0x18, B: 0x2b, A: 0xff}); got != want {
+		t.Errorf("got color %v, want %v", got, want)
+	}
+	if got, want := rgba(15, 15), (color.RGBA{R: 0xe0, G: 0xe0, B: 0xe0, A: 0xff}); got != want {
+		t.Errorf("got diagonal color %v, want %v", got, want)
+	}
+}
+
+func TestWritePNG_lengthMismatch(t *testing.T) {
+	err := heatmap.WritePNG(io.Discard, []int{1, 2, 3}, []string{"A", "B"})
+	if !errors.Is(err, schulze.ErrPreferencesLengthMismatch) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrPreferencesLengthMismatch)
+	}
+}
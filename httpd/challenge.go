@@ -0,0 +1,171 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpd
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/bits"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrChallengeFailed is returned by the Challenger when the solution of the
+// challenge is not valid.
+var ErrChallengeFailed = errors.New("httpd: challenge failed")
+
+// ChallengeProofOfWork is the type of the ProofOfWork challenge, which the
+// embedded page solves.
+const ChallengeProofOfWork = "pow"
+
+// Challenge is the response body of the challenge endpoint, issued to the
+// client before voting.
+type Challenge struct {
+	// Type of the challenge, ChallengeProofOfWork or a type of a custom
+	// Challenger, such as a CAPTCHA provider.
+	Type string `json:"type"`
+	// Value of the challenge, such as the proof-of-work puzzle or the
+	// CAPTCHA site key.
+	Value string `json:"value"`
+	// Difficulty of the proof-of-work in leading zero bits.
+	Difficulty int `json:"difficulty,omitempty"`
+}
+
+// Challenger raises the cost of automated vote flooding of anonymous public
+// polls by requiring every vote to carry a solution of a challenge, such
+// as a CAPTCHA or a proof-of-work. Methods of the Challenger must be safe
+// for concurrent calls.
+type Challenger interface {
+	// Issue returns a new challenge for the client of the request.
+	Issue(r *http.Request) (Challenge, error)
+	// Verify returns an error, such as ErrChallengeFailed, if the solution
+	// of the vote request is not valid.
+	Verify(r *http.Request, solution string) error
+}
+
+// WithChallenger sets the Challenger that every vote request must pass. The
+// challenge is issued by the challenge endpoint and its solution is sent in
+// the solution field of the VoteRequest.
+func WithChallenger(c Challenger) Option {
+	return func(h *Handler) {
+		h.challenger = c
+	}
+}
+
+// ProofOfWork is a Challenger that requires clients to find a nonce with
+// the SHA-256 hash of the challenge value, a colon and the nonce that has
+// at least the difficulty number of leading zero bits. The solution is the
+// challenge value, a colon and the nonce. Challenges are stateless, signed
+// with a random key, and every solution is accepted only once before the
+// challenge expires.
+type ProofOfWork struct {
+	difficulty int
+	ttl        time.Duration
+	key        []byte
+	now        func() time.Time
+
+	mu sync.Mutex
+	// expiration times of challenges with accepted solutions
+	used map[string]time.Time
+}
+
+// NewProofOfWork returns a new ProofOfWork with the difficulty in leading
+// zero bits and the duration for which the challenge is valid. Every
+// additional bit doubles the average work of the client, and the embedded
+// page solves the difficulty of 16 bits in about a second.
+func NewProofOfWork(difficulty int, ttl time.Duration) (*ProofOfWork, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return &ProofOfWork{
+		difficulty: difficulty,
+		ttl:        ttl,
+		key:        key,
+		now:        time.Now,
+		used:       make(map[string]time.Time),
+	}, nil
+}
+
+// Issue implements the Challenger interface.
+func (p *ProofOfWork) Issue(_ *http.Request) (Challenge, error) {
+	b := make([]byte, 8+16)
+	binary.BigEndian.PutUint64(b, uint64(p.now().Add(p.ttl).Unix()))
+	if _, err := rand.Read(b[8:]); err != nil {
+		return Challenge{}, err
+	}
+	puzzle := hex.EncodeToString(b)
+	return Challenge{
+		Type:       ChallengeProofOfWork,
+		Value:      puzzle + "." + hex.EncodeToString(p.sign(puzzle)),
+		Difficulty: p.difficulty,
+	}, nil
+}
+
+// Verify implements the Challenger interface.
+func (p *ProofOfWork) Verify(_ *http.Request, solution string) error {
+	value, _, ok := strings.Cut(solution, ":")
+	if !ok {
+		return ErrChallengeFailed
+	}
+	puzzle, signature, ok := strings.Cut(value, ".")
+	if !ok {
+		return ErrChallengeFailed
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, p.sign(puzzle)) {
+		return ErrChallengeFailed
+	}
+	b, err := hex.DecodeString(puzzle)
+	if err != nil || len(b) < 8 {
+		return ErrChallengeFailed
+	}
+	now := p.now()
+	expires := time.Unix(int64(binary.BigEndian.Uint64(b)), 0)
+	if !now.Before(expires) {
+		return ErrChallengeFailed
+	}
+	hash := sha256.Sum256([]byte(solution))
+	if leadingZeroBits(hash[:]) < p.difficulty {
+		return ErrChallengeFailed
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.used[puzzle]; ok {
+		return ErrChallengeFailed
+	}
+	for k, t := range p.used {
+		if !now.Before(t) {
+			delete(p.used, k)
+		}
+	}
+	p.used[puzzle] = expires
+	return nil
+}
+
+func (p *ProofOfWork) sign(puzzle string) []byte {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(puzzle))
+	return mac.Sum(nil)
+}
+
+func leadingZeroBits(b []byte) int {
+	var n int
+	for _, x := range b {
+		if x != 0 {
+			return n + bits.LeadingZeros8(x)
+		}
+		n += 8
+	}
+	return n
+}
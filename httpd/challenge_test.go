@@ -0,0 +1,134 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpd_test
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"math/bits"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/httpd"
+)
+
+func TestHandler_challenger(t *testing.T) {
+	pow, err := httpd.NewProofOfWork(8, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := schulze.NewElection[string]([]string{"A", "B"})
+	h := httpd.NewHandler(e, httpd.WithChallenger(pow), httpd.WithVoterFunc(func(_ http.ResponseWriter, r *http.Request) (string, error) {
+		return r.Header.Get("X-User"), nil
+	}))
+
+	do := func(method, path, user string, body any) *httptest.ResponseRecorder {
+		var data []byte
+		if body != nil {
+			var err error
+			if data, err = json.Marshal(body); err != nil {
+				t.Fatal(err)
+			}
+		}
+		r := httptest.NewRequest(method, path, strings.NewReader(string(data)))
+		r.Header.Set("X-User", user)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	var poll httpd.Poll
+	if err := json.NewDecoder(do(http.MethodGet, "/api/poll", "", nil).Body).Decode(&poll); err != nil {
+		t.Fatal(err)
+	}
+	if !poll.Challenge {
+		t.Error("poll does not require the challenge")
+	}
+
+	var c httpd.Challenge
+	if err := json.NewDecoder(do(http.MethodGet, "/api/challenge", "", nil).Body).Decode(&c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Type != httpd.ChallengeProofOfWork || c.Difficulty != 8 {
+		t.Fatalf("got challenge %+v", c)
+	}
+	solution := solve(c)
+	// a different random part of the puzzle
+	tampered := []byte(solution)
+	if tampered[20] == '0' {
+		tampered[20] = '1'
+	} else {
+		tampered[20] = '0'
+	}
+
+	for _, tc := range []struct {
+		name     string
+		user     string
+		solution string
+		status   int
+	}{
+		{name: "no solution", user: "alice", status: http.StatusForbidden},
+		{name: "invalid solution", user: "alice", solution: unsolved(c), status: http.StatusForbidden},
+		{name: "tampered challenge", user: "alice", solution: string(tampered), status: http.StatusForbidden},
+		{name: "valid solution", user: "alice", solution: solution, status: http.StatusOK},
+		{name: "reused solution", user: "bob", solution: solution, status: http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := do(http.MethodPost, "/api/vote", tc.user, httpd.VoteRequest{
+				Ballot:   schulze.Ballot[string]{"A": 1},
+				Solution: tc.solution,
+			})
+			if w.Code != tc.status {
+				t.Errorf("got status %v, want %v: %s", w.Code, tc.status, w.Body)
+			}
+		})
+	}
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v, want %v", got, 1)
+	}
+
+	h = httpd.NewHandler(e)
+	if w := do(http.MethodGet, "/api/challenge", "", nil); w.Code != http.StatusNotFound {
+		t.Errorf("got status %v without the challenger, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func solve(c httpd.Challenge) string {
+	for nonce := 0; ; nonce++ {
+		solution := c.Value + ":" + strconv.Itoa(nonce)
+		if solves(c, solution) {
+			return solution
+		}
+	}
+}
+
+// unsolved returns a solution of the challenge with a nonce that does not
+// solve it, as any nonce can solve it by chance.
+func unsolved(c httpd.Challenge) string {
+	for nonce := 0; ; nonce++ {
+		solution := c.Value + ":invalid" + strconv.Itoa(nonce)
+		if !solves(c, solution) {
+			return solution
+		}
+	}
+}
+
+func solves(c httpd.Challenge, solution string) bool {
+	hash := sha256.Sum256([]byte(solution))
+	var zeros int
+	for _, b := range hash {
+		zeros += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+	return zeros >= c.Difficulty
+}
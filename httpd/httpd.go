@@ -0,0 +1,287 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httpd serves a complete poll over HTTP, with an embedded HTML and
+// JavaScript page for voting and results and the JSON API that the page
+// uses, so that small communities can run a poll from a single Go binary.
+//
+// The Handler serves the following endpoints, relative to its mount path:
+//
+//   - GET /: the voting and results page
+//   - GET /api/poll: the Poll with the name, choices and phase of the
+//     election
+//   - POST /api/vote: cast or replace the ballot of the voter with a JSON
+//     encoded VoteRequest, responding with the VoteResponse
+//   - DELETE /api/vote: remove the ballot of the voter
+//   - GET /api/results: the schulze.ResultDocument of the current results
+//   - GET /api/challenge: a new Challenge that the vote request must solve,
+//     if the Handler is configured with a Challenger
+//   - GET /api/openapi.json: the OpenAPI 3 specification of the API
+//
+// The resenje.org/schulze/client package is the Go client of the API.
+package httpd
+
+import (
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"resenje.org/schulze"
+)
+
+//go:embed web
+var web embed.FS
+
+// OpenAPI is the OpenAPI 3 specification of the API in JSON.
+//
+//go:embed openapi.json
+var OpenAPI []byte
+
+// VoterCookieName is the name of the cookie that identifies voters by
+// default.
+const VoterCookieName = "schulze_voter"
+
+// VoterFunc returns the identifier of the voter of the request. It may set
+// headers, such as cookies, on the response writer.
+type VoterFunc func(w http.ResponseWriter, r *http.Request) (string, error)
+
+// Poll is the response body of the poll endpoint.
+type Poll struct {
+	Name        string   `json:"name,omitempty"`
+	Choices     []string `json:"choices"`
+	Phase       string   `json:"phase"`
+	VotersCount int      `json:"votersCount"`
+	// True if votes require a solution of the challenge.
+	Challenge bool `json:"challenge,omitempty"`
+}
+
+// VoteRequest is the request body of the vote endpoint.
+type VoteRequest struct {
+	Ballot schulze.Ballot[string] `json:"ballot"`
+	// Solution of the challenge, if the poll requires it.
+	Solution string `json:"solution,omitempty"`
+}
+
+// VoteResponse is the response body of the vote endpoint.
+type VoteResponse struct {
+	Record schulze.Record[string] `json:"record"`
+}
+
+// Handler serves the election over HTTP. All calls to the election are
+// guarded by the Handler lock.
+type Handler struct {
+	election   *schulze.Election[string, string]
+	voter      VoterFunc
+	challenger Challenger
+	mu         sync.Mutex
+	mux        *http.ServeMux
+}
+
+// Option configures the Handler.
+type Option func(*Handler)
+
+// WithVoterFunc sets the function that identifies voters, for example by an
+// authenticated user of the request. By default, voters are identified by a
+// random identifier in the VoterCookieName cookie, which is only suitable
+// for informal polls, as a voter can vote again by removing the cookie.
+func WithVoterFunc(f VoterFunc) Option {
+	return func(h *Handler) {
+		h.voter = f
+	}
+}
+
+// NewHandler returns a new Handler that serves the election.
+func NewHandler(e *schulze.Election[string, string], opts ...Option) *Handler {
+	h := &Handler{
+		election: e,
+		voter:    cookieVoter,
+		mux:      http.NewServeMux(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	static, err := fs.Sub(web, "web")
+	if err != nil {
+		// the embedded directory always exists
+		panic(err)
+	}
+	h.mux.Handle("/", http.FileServer(http.FS(static)))
+	h.mux.HandleFunc("/api/poll", h.poll)
+	h.mux.HandleFunc("/api/vote", h.vote)
+	h.mux.HandleFunc("/api/results", h.results)
+	h.mux.HandleFunc("/api/openapi.json", h.openAPI)
+	h.mux.HandleFunc("/api/challenge", h.challenge)
+	return h
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// Locker returns the lock that guards all calls to the election, to be used
+// by other callers, such as the election RunSelfCheck method.
+func (h *Handler) Locker() sync.Locker {
+	return &h.mu
+}
+
+func (h *Handler) poll(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	h.mu.Lock()
+	config := h.election.Config()
+	p := Poll{
+		Name:        config.Name,
+		Choices:     config.Choices,
+		Phase:       h.election.Phase().String(),
+		VotersCount: h.election.VotersCount(),
+		Challenge:   h.challenger != nil,
+	}
+	h.mu.Unlock()
+	if p.Choices == nil {
+		p.Choices = make([]string, 0)
+	}
+	writeJSON(w, p)
+}
+
+func (h *Handler) vote(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost, http.MethodDelete:
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	voter, err := h.voter(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		h.mu.Lock()
+		err := h.election.Unvote(voter)
+		h.mu.Unlock()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var req VoteRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if h.challenger != nil {
+		if err := h.challenger.Verify(r, req.Solution); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+	h.mu.Lock()
+	record, err := h.election.Vote(voter, req.Ballot)
+	h.mu.Unlock()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, VoteResponse{Record: record})
+}
+
+func (h *Handler) results(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	h.mu.Lock()
+	d, err := h.election.ResultDocument()
+	h.mu.Unlock()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, d)
+}
+
+func (h *Handler) challenge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if h.challenger == nil {
+		http.NotFound(w, r)
+		return
+	}
+	c, err := h.challenger.Issue(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, c)
+}
+
+func (h *Handler) openAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(OpenAPI)
+}
+
+func cookieVoter(w http.ResponseWriter, r *http.Request) (string, error) {
+	if c, err := r.Cookie(VoterCookieName); err == nil && c.Value != "" {
+		return c.Value, nil
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	voter := hex.EncodeToString(b)
+	http.SetCookie(w, &http.Cookie{
+		Name:     VoterCookieName,
+		Value:    voter,
+		Path:     "/",
+		MaxAge:   365 * 24 * 60 * 60,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return voter, nil
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var unknownChoice *schulze.UnknownChoiceError[string]
+	var policyDenied *schulze.PolicyDeniedError
+	var rateLimited *schulze.RateLimitError
+	switch {
+	case errors.As(err, &rateLimited):
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(rateLimited.RetryAfter.Seconds()))))
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	case errors.Is(err, schulze.ErrBallotPolicyViolation), errors.As(err, &unknownChoice):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, schulze.ErrSealed), errors.As(err, &policyDenied):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, schulze.ErrElectionNotOpen), errors.Is(err, schulze.ErrElectionClosed):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpd_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/httpd"
+)
+
+func TestHandler(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:    "Lunch",
+		Choices: []string{"Pizza", "Sushi", "Tacos"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := httptest.NewServer(httpd.NewHandler(e))
+	defer s.Close()
+
+	newClient := func(t *testing.T) *http.Client {
+		t.Helper()
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Client{Jar: jar}
+	}
+	do := func(t *testing.T, c *http.Client, method, path, body string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(data)
+	}
+
+	alice, bob := newClient(t), newClient(t)
+
+	status, body := do(t, alice, http.MethodGet, "/", "")
+	if status != http.StatusOK || !strings.Contains(body, "<html") {
+		t.Errorf("got page status %v", status)
+	}
+
+	status, body = do(t, alice, http.MethodGet, "/api/poll", "")
+	if status != http.StatusOK {
+		t.Fatalf("got poll status %v: %s", status, body)
+	}
+	var poll httpd.Poll
+	if err := json.Unmarshal([]byte(body), &poll); err != nil {
+		t.Fatal(err)
+	}
+	if want := (httpd.Poll{Name: "Lunch", Choices: []string{"Pizza", "Sushi", "Tacos"}, Phase: "open"}); !reflect.DeepEqual(poll, want) {
+		t.Errorf("got poll %+v, want %+v", poll, want)
+	}
+
+	if status, _ := do(t, alice, http.MethodPost, "/api/vote", `{"ballot":{"Pasta":1}}`); status != http.StatusBadRequest {
+		t.Errorf("got status %v for an unknown choice, want %v", status, http.StatusBadRequest)
+	}
+	for _, v := range []struct {
+		client *http.Client
+		ballot string
+	}{
+		{client: alice, ballot: `{"ballot":{"Pizza":1}}`},
+		// the vote is replaced
+		{client: alice, ballot: `{"ballot":{"Tacos":1,"Sushi":2}}`},
+		{client: bob, ballot: `{"ballot":{"Tacos":1}}`},
+	} {
+		if status, body := do(t, v.client, http.MethodPost, "/api/vote", v.ballot); status != http.StatusOK {
+			t.Fatalf("got vote status %v: %s", status, body)
+		}
+	}
+
+	status, body = do(t, bob, http.MethodGet, "/api/results", "")
+	if status != http.StatusOK {
+		t.Fatalf("got results status %v: %s", status, body)
+	}
+	var d schulze.ResultDocument[string]
+	if err := json.Unmarshal([]byte(body), &d); err != nil {
+		t.Fatal(err)
+	}
+	if d.BallotsCount != 2 || d.Ranking[0].Choice != "Tacos" {
+		t.Errorf("got results %+v", d)
+	}
+
+	if status, _ := do(t, bob, http.MethodDelete, "/api/vote", ""); status != http.StatusNoContent {
+		t.Errorf("got unvote status %v, want %v", status, http.StatusNoContent)
+	}
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v, want %v", got, 1)
+	}
+}
+
+func TestHandler_voterFunc(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+	h := httpd.NewHandler(e, httpd.WithVoterFunc(func(_ http.ResponseWriter, r *http.Request) (string, error) {
+		user := r.Header.Get("X-User")
+		if user == "" {
+			return "", errors.New("unauthenticated")
+		}
+		return user, nil
+	}))
+
+	for _, tc := range []struct {
+		user   string
+		status int
+	}{
+		{user: "", status: http.StatusUnauthorized},
+		{user: "alice", status: http.StatusOK},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/api/vote", strings.NewReader(`{"ballot":{"A":1}}`))
+		if tc.user != "" {
+			r.Header.Set("X-User", tc.user)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tc.status {
+			t.Errorf("user %q: got status %v, want %v", tc.user, w.Code, tc.status)
+		}
+	}
+
+	if _, ok := e.Record("alice"); !ok {
+		t.Error("vote of the authenticated voter not recorded")
+	}
+}
+
+func TestHandler_openAPI(t *testing.T) {
+	h := httpd.NewHandler(schulze.NewElection[string]([]string{"A", "B"}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %v, want %v", w.Code, http.StatusOK)
+	}
+	var spec struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.OpenAPI == "" {
+		t.Error("missing openapi version")
+	}
+	for path, methods := range map[string][]string{
+		"/api/poll":         {"get"},
+		"/api/vote":         {"post", "delete"},
+		"/api/results":      {"get"},
+		"/api/openapi.json": {"get"},
+		"/api/challenge":    {"get"},
+	} {
+		for _, method := range methods {
+			if _, ok := spec.Paths[path][method]; !ok {
+				t.Errorf("missing %s %s operation", method, path)
+			}
+		}
+	}
+}
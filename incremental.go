@@ -0,0 +1,222 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// WithIncrementalCompute enables incremental update of the cached computation
+// of the Voting when a single ballot is voted. A vote can only increase or
+// create links from the choices that it ranks in the pairwise preferences
+// graph, except when it breaks a pairwise defeat into a tie, and the strongest
+// paths strengths are updated for links of every ranked choice in O(n²) time,
+// instead of the O(n³) time of the full computation. If the ballot removes a
+// link without an alternative path of the same strength or ranks too many
+// choices, the computation is invalidated and fully calculated on the next
+// Compute call. Unvote always invalidates the computation.
+//
+// This option makes live results feasible after every vote for votings with
+// a moderate number of choices and ballots that rank only a few of them.
+func WithIncrementalCompute[C comparable]() Option[C] {
+	return func(o *options[C]) {
+		o.incremental = true
+	}
+}
+
+// voteIncremental votes the ballot and updates the cached computation
+// incrementally if it is possible.
+func (v *Voting[C]) voteIncremental(b Ballot[C]) (Record[C], error) {
+	choicesCount := len(v.choices)
+	// maximal number of choices with changed links for which the incremental
+	// update is cheaper than the full computation
+	maxSources := choicesCount / 4
+
+	// changed preferences indexes grouped by the choice index of the row
+	var sources [][]int
+	broad := false
+	// bitwise or of the incremented preferences, as in the Vote function
+	var incremented int
+	r, err := voteFunc(v.choices, b, func(index int) {
+		v.preferences[index]++
+		incremented |= v.preferences[index]
+		if broad {
+			return
+		}
+		i := index / choicesCount
+		if i == index%choicesCount {
+			// diagonal values do not participate in the computation
+			return
+		}
+		if l := len(sources); l == 0 || sources[l-1][0]/choicesCount != i {
+			if l >= maxSources {
+				broad = true
+				return
+			}
+			sources = append(sources, nil)
+		}
+		sources[len(sources)-1] = append(sources[len(sources)-1], index)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if incremented < 0 && hasWrappedPreferences(v.preferences) {
+		if err := Unvote(v.preferences, v.choices, r); err != nil {
+			return nil, err
+		}
+		return nil, ErrTallyOverflow
+	}
+	v.stateHash = nil
+	if broad {
+		v.invalidate()
+		return r, nil
+	}
+
+	// a removed link does not change any strength if there is an alternative
+	// path that is at least as strong as the link was
+	removed := 0
+	var buf widestPathBuffer
+	for _, indexes := range sources {
+		for _, index := range indexes {
+			i, j := index/choicesCount, index%choicesCount
+			strength := v.preferences[j*choicesCount+i]
+			if v.preferences[index] != strength {
+				continue
+			}
+			// the link from j to i is removed
+			removed++
+			if removed > maxSources || widestPath(v.preferences, choicesCount, j, i, strength, &buf) < strength {
+				v.invalidate()
+				return r, nil
+			}
+		}
+	}
+
+	strengths := make([]int, len(v.strengths))
+	copy(strengths, v.strengths)
+	best := make([]int, choicesCount)
+	for _, indexes := range sources {
+		addLinksStrengths(strengths, v.preferences, choicesCount, indexes, best)
+	}
+
+	if v.options.dualRun {
+		checkDualRun(v.preferences, strengths, choicesCount, v.options)
+	}
+
+	v.strengths = strengths
+	v.results, v.tie = calculateResults(v.choices, v.strengths, v.options)
+	return r, nil
+}
+
+// addLinksStrengths updates the strongest paths strengths with the new or
+// increased strengths of the links from a single choice, referenced by their
+// preferences indexes. Every path that becomes stronger must contain one of
+// the links, so it is sufficient to check concatenations of the strongest
+// paths to the choice with the strongest paths from the choice through one of
+// the links. The best slice is used as a buffer.
+func addLinksStrengths(strengths, preferences []int, choicesCount int, indexes []int, best []int) {
+	from := indexes[0] / choicesCount
+
+	// strongest paths from the choice that start with one of the links
+	for b := range best {
+		best[b] = 0
+	}
+	for _, index := range indexes {
+		to := index % choicesCount
+		strength := preferences[index]
+		if strength <= preferences[to*choicesCount+from] {
+			// not a link
+			continue
+		}
+		tcc := to * choicesCount
+		for b := 0; b < choicesCount; b++ {
+			m := strength
+			if b != to {
+				m = min(strengths[tcc+b], strength)
+			}
+			if m > best[b] {
+				best[b] = m
+			}
+		}
+	}
+
+	for a := 0; a < choicesCount; a++ {
+		acc := a * choicesCount
+		left := -1 // the path from the choice to itself is not limiting
+		if a != from {
+			left = strengths[acc+from]
+			if left == 0 {
+				continue
+			}
+		}
+		for b := 0; b < choicesCount; b++ {
+			if a == b || b == from {
+				continue
+			}
+			m := best[b]
+			if left >= 0 {
+				m = min(left, m)
+			}
+			if m > strengths[acc+b] {
+				strengths[acc+b] = m
+			}
+		}
+	}
+}
+
+type widestPathBuffer struct {
+	width   []int
+	visited bitSet
+}
+
+// widestPath returns the strength of the strongest path between two choices
+// in the pairwise preferences graph, or the target strength if a path of at
+// least that strength is found before the search is complete.
+func widestPath(preferences []int, choicesCount, from, to, target int, buf *widestPathBuffer) int {
+	if buf.width == nil {
+		buf.width = make([]int, choicesCount)
+		buf.visited = newBitset(uint64(choicesCount))
+	} else {
+		for i := range buf.width {
+			buf.width[i] = 0
+		}
+		for i := range buf.visited {
+			buf.visited[i] = 0
+		}
+	}
+	width, visited := buf.width, buf.visited
+
+	current := from
+	currentWidth := -1 // the path from the choice to itself is not limiting
+	for {
+		visited.set(uint64(current))
+		if current == to {
+			return currentWidth
+		}
+		ccc := current * choicesCount
+		next, nextWidth := -1, 0
+		for x := 0; x < choicesCount; x++ {
+			if visited.isSet(uint64(x)) {
+				continue
+			}
+			if p := preferences[ccc+x]; p > preferences[x*choicesCount+current] {
+				w := p
+				if currentWidth >= 0 {
+					w = min(currentWidth, p)
+				}
+				if w > width[x] {
+					width[x] = w
+				}
+			}
+			if width[x] > nextWidth {
+				next, nextWidth = x, width[x]
+			}
+		}
+		if next < 0 {
+			return 0
+		}
+		if next == to && nextWidth >= target {
+			return nextWidth
+		}
+		current, currentWidth = next, nextWidth
+	}
+}
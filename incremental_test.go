@@ -0,0 +1,104 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestWithIncrementalCompute(t *testing.T) {
+	seed := time.Now().UnixNano()
+	t.Logf("seed: %v", seed)
+	random := rand.New(rand.NewSource(seed))
+
+	choices := newChoices(40)
+
+	c := &countingPathStrengthComputer{PathStrengthComputer: schulze.ParallelPathStrengthComputer{}}
+
+	incremental := schulze.NewVoting(choices,
+		schulze.WithIncrementalCompute[string](),
+		schulze.WithPathStrengthComputer[string](c),
+	)
+	v := schulze.NewVoting(choices)
+
+	for i := 0; i < 500; i++ {
+		// ballots that rank only a few choices
+		b := make(schulze.Ballot[string])
+		for j, count := 0, random.Intn(4); j < count; j++ {
+			b[choices[random.Intn(len(choices))]] = random.Intn(3)
+		}
+		if _, err := incremental.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+
+		gotResults, gotDuels, gotTie := incremental.Compute()
+		wantResults, wantDuels, wantTie := v.Compute()
+		if gotTie != wantTie {
+			t.Fatalf("vote %v: got tie %v, want %v", i, gotTie, wantTie)
+		}
+		if !reflect.DeepEqual(gotResults, wantResults) {
+			t.Fatalf("vote %v: got results %+v, want %+v", i, gotResults, wantResults)
+		}
+		if got, want := collectDuels(gotDuels), collectDuels(wantDuels); !reflect.DeepEqual(got, want) {
+			t.Fatalf("vote %v: got duels %+v, want %+v", i, got, want)
+		}
+	}
+
+	if c.calls >= 500 {
+		t.Errorf("got %v full computations for 500 votes", c.calls)
+	}
+	t.Logf("full computations: %v", c.calls)
+}
+
+func BenchmarkWithIncrementalCompute(b *testing.B) {
+	const choicesCount = 500
+
+	choices := newChoices(choicesCount)
+
+	v := schulze.NewVoting(choices, schulze.WithIncrementalCompute[string]())
+
+	// ballots with a clear popularity of choices, ranking only a few of them
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	choice := func() int {
+		c := int(random.ExpFloat64() * 20)
+		if c >= choicesCount {
+			return choicesCount - 1
+		}
+		return c
+	}
+	ballot := func() schulze.Ballot[string] {
+		b := make(schulze.Ballot[string])
+		for i := 0; i < 3; i++ {
+			c := choice()
+			b[choices[c]] = c
+		}
+		return b
+	}
+
+	for i := 0; i < 10000; i++ {
+		if _, err := v.Vote(ballot()); err != nil {
+			b.Fatal(err)
+		}
+	}
+	_, _, _ = v.Compute()
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		if _, err := v.Vote(ballot()); err != nil {
+			b.Fatal(err)
+		}
+		_, _, _ = v.Compute()
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PreferencesMatrix is the stable JSON representation of the choices and the
+// pairwise preferences of a voting, used to exchange voting state between
+// services, in the shape:
+//
+//	{"choices":["A","B"],"preferences":[[1,1],[0,1]]}
+type PreferencesMatrix[C comparable] struct {
+	Choices []C `json:"choices"`
+	// Element Preferences[i][j] is the number of ballots that prefer the
+	// choice with index i over the choice with index j. Diagonal values
+	// are the numbers of ballots that ranked the choice over the unranked
+	// choices, as described for the ImportPairwise function.
+	Preferences [][]int `json:"preferences"`
+}
+
+// NewPreferencesMatrix returns the PreferencesMatrix of the preferences for
+// the choices. ErrPreferencesLengthMismatch is returned if the length of the
+// preferences does not match the number of choices.
+func NewPreferencesMatrix[C comparable](preferences []int, choices []C) (PreferencesMatrix[C], error) {
+	choicesCount := len(choices)
+	if len(preferences) != choicesCount*choicesCount {
+		return PreferencesMatrix[C]{}, fmt.Errorf("%w: got length %v for %v choices", ErrPreferencesLengthMismatch, len(preferences), choicesCount)
+	}
+	m := PreferencesMatrix[C]{
+		Choices:     append(make([]C, 0, choicesCount), choices...),
+		Preferences: make([][]int, choicesCount),
+	}
+	for i := range m.Preferences {
+		m.Preferences[i] = append(make([]int, 0, choicesCount), preferences[i*choicesCount:(i+1)*choicesCount]...)
+	}
+	return m, nil
+}
+
+// Import validates the matrix, just as the ImportPairwise function does, and
+// returns the preferences for its choices.
+func (m PreferencesMatrix[C]) Import() ([]int, error) {
+	return ImportPairwise(m.Preferences, m.Choices)
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the choices
+// and the preferences of the voting as the PreferencesMatrix. Options and
+// suspended, withdrawn and disqualified choices are not encoded.
+func (v *Voting[C]) MarshalJSON() ([]byte, error) {
+	m, err := NewPreferencesMatrix(v.preferences, v.choices)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, replacing the
+// choices and the preferences of the voting with the ones decoded from the
+// PreferencesMatrix. ErrInvalidPairwiseMatrix is returned if the matrix is not
+// square, or if the number of its rows does not match the number of choices.
+func (v *Voting[C]) UnmarshalJSON(data []byte) error {
+	var m PreferencesMatrix[C]
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	preferences, err := m.Import()
+	if err != nil {
+		return err
+	}
+	v.restore(preferences, m.Choices)
+	return nil
+}
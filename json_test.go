@@ -0,0 +1,90 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_MarshalJSON(t *testing.T) {
+	v := schulze.NewVoting([]string{"A", "B", "C"})
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"C": 1},
+		{"B": 1, "A": 1},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"choices":["A","B","C"],"preferences":[[2,1,2],[0,2,2],[1,1,1]]}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	var restored schulze.Voting[string]
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.StateHash(), v.StateHash()) {
+		t.Error("got different state hash")
+	}
+	gotResults, _, gotTie := restored.Compute()
+	wantResults, _, wantTie := v.Compute()
+	if !reflect.DeepEqual(gotResults, wantResults) || gotTie != wantTie {
+		t.Errorf("got results %v, tie %v, want %v, tie %v", gotResults, gotTie, wantResults, wantTie)
+	}
+}
+
+func TestVoting_UnmarshalJSON_invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+	}{
+		{name: "rows", data: `{"choices":["A","B"],"preferences":[[0,1]]}`},
+		{name: "columns", data: `{"choices":["A","B"],"preferences":[[0,1],[0]]}`},
+		{name: "choices", data: `{"choices":["A"],"preferences":[[0,1],[0,0]]}`},
+		{name: "negative", data: `{"choices":["A","B"],"preferences":[[0,-1],[0,0]]}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var v schulze.Voting[string]
+			if err := json.Unmarshal([]byte(tc.data), &v); !errors.Is(err, schulze.ErrInvalidPairwiseMatrix) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidPairwiseMatrix)
+			}
+		})
+	}
+}
+
+func TestNewPreferencesMatrix(t *testing.T) {
+	m, err := schulze.NewPreferencesMatrix([]int{1, 2, 3, 4}, []int{10, 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.PreferencesMatrix[int]{Choices: []int{10, 20}, Preferences: [][]int{{1, 2}, {3, 4}}}); !reflect.DeepEqual(m, want) {
+		t.Errorf("got %+v, want %+v", m, want)
+	}
+	preferences, err := m.Import()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(preferences, want) {
+		t.Errorf("got preferences %v, want %v", preferences, want)
+	}
+
+	if _, err := schulze.NewPreferencesMatrix([]int{1, 2, 3}, []int{10, 20}); !errors.Is(err, schulze.ErrPreferencesLengthMismatch) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrPreferencesLengthMismatch)
+	}
+}
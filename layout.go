@@ -0,0 +1,131 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// StrengthsLayout defines the order in which the built-in computation
+// accesses the strengths matrix in the Floyd–Warshall algorithm. The
+// computed strengths are the same for every layout.
+type StrengthsLayout int
+
+const (
+	// LayoutRowMajor updates the whole matrix row by row in every iteration
+	// of the algorithm. It is the default layout, and the fastest one for
+	// dense preferences.
+	LayoutRowMajor StrengthsLayout = iota
+	// LayoutTiled updates the matrix in square tiles of 48 choices,
+	// performing all iterations through the choices of a tile while the
+	// tiles are in the processor cache, which improves locality for very
+	// wide matrices with sparse preferences. Progress is reported once per
+	// tile of iterations.
+	LayoutTiled
+	// LayoutAuto selects LayoutTiled for votings with at least 512 choices
+	// where less than 30% of ordered pairs of choices have direct links, out
+	// of at most a half of them that can, such as votings where ballots
+	// rank only a few of many choices, and LayoutRowMajor otherwise.
+	LayoutAuto
+)
+
+const (
+	// tiledLayoutSize is the number of choices of a tile side, so that a
+	// single tile fits in the L1 cache of most processors.
+	tiledLayoutSize = 48
+	// tiledLayoutThreshold is the number of choices from which the tiled
+	// layout is faster than the row-major for sparse preferences, measured
+	// with the BenchmarkStrengthsLayout benchmark. With dense preferences,
+	// the row-major layout is faster for every number of choices.
+	tiledLayoutThreshold = 512
+)
+
+// WithStrengthsLayout sets the layout of the built-in computation of the
+// strongest paths strengths. It is not used by a custom
+// PathStrengthComputer or the StrengthsComputation.
+func WithStrengthsLayout[C comparable](layout StrengthsLayout) Option[C] {
+	return func(o *options[C]) {
+		o.strengthsLayout = layout
+	}
+}
+
+// useTiledLayout returns true if the strengths should be computed in the
+// tiled layout for the direct links strengths.
+func useTiledLayout(layout StrengthsLayout, strengths []int, choicesCount int) bool {
+	switch layout {
+	case LayoutTiled:
+		return true
+	case LayoutAuto:
+		if choicesCount < tiledLayoutThreshold {
+			return false
+		}
+		var links int
+		for _, s := range strengths {
+			if s > 0 {
+				links++
+			}
+		}
+		return links < len(strengths)*3/10
+	default:
+		return false
+	}
+}
+
+// tiledStrengths widens paths of the direct links strengths in place with the
+// tiled Floyd–Warshall algorithm, where the iterations through the choices
+// of every diagonal tile are performed first on the diagonal tile, then on
+// the tiles in its row and column, and at last on all other tiles, which
+// depend only on the former ones.
+func tiledStrengths(strengths []int, choicesCount int, progress func(done, total int)) {
+	for kStart := 0; kStart < choicesCount; kStart += tiledLayoutSize {
+		kEnd := min(kStart+tiledLayoutSize, choicesCount)
+
+		tileIterations(strengths, choicesCount, kStart, kEnd, kStart, kEnd, kStart, kEnd)
+		for start := 0; start < choicesCount; start += tiledLayoutSize {
+			if start == kStart {
+				continue
+			}
+			end := min(start+tiledLayoutSize, choicesCount)
+			tileIterations(strengths, choicesCount, kStart, kEnd, start, end, kStart, kEnd)
+			tileIterations(strengths, choicesCount, start, end, kStart, kEnd, kStart, kEnd)
+		}
+		for iStart := 0; iStart < choicesCount; iStart += tiledLayoutSize {
+			if iStart == kStart {
+				continue
+			}
+			iEnd := min(iStart+tiledLayoutSize, choicesCount)
+			for jStart := 0; jStart < choicesCount; jStart += tiledLayoutSize {
+				if jStart == kStart {
+					continue
+				}
+				jEnd := min(jStart+tiledLayoutSize, choicesCount)
+				tileIterations(strengths, choicesCount, iStart, iEnd, jStart, jEnd, kStart, kEnd)
+			}
+		}
+
+		if progress != nil {
+			progress(kEnd, choicesCount)
+		}
+	}
+}
+
+// tileIterations widens paths from the rows iStart to iEnd to the columns
+// jStart to jEnd of the strengths matrix through the choices kStart to kEnd.
+func tileIterations(strengths []int, choicesCount, iStart, iEnd, jStart, jEnd, kStart, kEnd int) {
+	for k := kStart; k < kEnd; k++ {
+		kRow := strengths[k*choicesCount+jStart : k*choicesCount+jEnd]
+		for i := iStart; i < iEnd; i++ {
+			ik := strengths[i*choicesCount+k]
+			if ik == 0 {
+				// strengths are not negative, so no path is widened
+				continue
+			}
+			iRow := strengths[i*choicesCount+jStart : i*choicesCount+jEnd]
+			iRow = iRow[:len(kRow)]
+			for j, kj := range kRow {
+				if m := min(ik, kj); m > iRow[j] {
+					iRow[j] = m
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestWithStrengthsLayout(t *testing.T) {
+	for _, choicesCount := range []int{1, 2, 7, 48, 49, 101, 150} {
+		t.Run(fmt.Sprintf("choices %v", choicesCount), func(t *testing.T) {
+			choices := newChoices(choicesCount)
+			preferences := schulze.NewPreferences(choicesCount)
+			for _, b := range randomBallots(t, choices, 30) {
+				if _, err := schulze.Vote(preferences, choices, b); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want := schulze.PairwiseStrengths(preferences, choices)
+			for _, layout := range []schulze.StrengthsLayout{schulze.LayoutRowMajor, schulze.LayoutTiled, schulze.LayoutAuto} {
+				got := schulze.PairwiseStrengths(preferences, choices, schulze.WithStrengthsLayout[string](layout))
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("layout %v: got different strengths", layout)
+				}
+			}
+
+			var done []int
+			_, _, _ = schulze.ComputeWithProgress(preferences, choices, func(d, total int) {
+				if total != choicesCount {
+					t.Errorf("got total %v, want %v", total, choicesCount)
+				}
+				done = append(done, d)
+			}, schulze.WithStrengthsLayout[string](schulze.LayoutTiled))
+			if len(done) == 0 || done[len(done)-1] != choicesCount {
+				t.Errorf("got progress %v", done)
+			}
+		})
+	}
+}
+
+func TestWithStrengthsLayout_sparse(t *testing.T) {
+	choices := newChoices(120)
+	preferences := schulze.NewPreferences(len(choices))
+	// a long chain of preferences, widened through many tiles
+	for i := len(choices) - 1; i > 0; i-- {
+		if _, err := schulze.Vote(preferences, choices, schulze.Ballot[string]{
+			choices[i]:   1,
+			choices[i-1]: 2,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := schulze.PairwiseStrengths(preferences, choices)
+	got := schulze.PairwiseStrengths(preferences, choices, schulze.WithStrengthsLayout[string](schulze.LayoutTiled))
+	if !reflect.DeepEqual(got, want) {
+		t.Error("got different strengths")
+	}
+}
+
+func BenchmarkStrengthsLayout(b *testing.B) {
+	for _, choicesCount := range []int{200, 1000} {
+		// ballots ranking more choices produce denser preferences
+		for _, ranked := range []int{4, 20, 100} {
+			choices := newChoices(choicesCount)
+			random := rand.New(rand.NewSource(1))
+			preferences := schulze.NewPreferences(choicesCount)
+			for i := 0; i < 100; i++ {
+				ballot := make(schulze.Ballot[string])
+				for rank, c := range random.Perm(choicesCount)[:ranked] {
+					ballot[choices[c]] = rank
+				}
+				if _, err := schulze.Vote(preferences, choices, ballot); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			for _, l := range []struct {
+				name   string
+				layout schulze.StrengthsLayout
+			}{
+				{"row-major", schulze.LayoutRowMajor},
+				{"tiled", schulze.LayoutTiled},
+				{"auto", schulze.LayoutAuto},
+			} {
+				opt := schulze.WithStrengthsLayout[string](l.layout)
+				b.Run(fmt.Sprintf("%v choices %v ranked %s", choicesCount, ranked, l.name), func(b *testing.B) {
+					for n := 0; n < b.N; n++ {
+						_ = schulze.PairwiseStrengths(preferences, choices, opt)
+					}
+				})
+			}
+		}
+	}
+}
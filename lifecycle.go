@@ -0,0 +1,172 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WinnerChange is the data of the EventWinnerChanged event.
+type WinnerChange[C comparable] struct {
+	Previous []C `json:"previous"`
+	Winners  []C `json:"winners"`
+}
+
+// lifecycle holds the state of the election observed by the notifier.
+type lifecycle[C comparable] struct {
+	notifier Notifier
+	phase    ElectionPhase
+	winners  []C
+	// winnersKnown is false while the results are sealed
+	winnersKnown bool
+}
+
+// SetNotifier sets the notifier of election lifecycle events, such as a
+// webhook dispatcher. Events of phase changes and winner changes are
+// delivered by CheckLifecycle, relative to the state of the election when
+// the notifier is set, and the EventCertified by the Certify function. A nil
+// notifier disables the events.
+func (e *Election[V, C]) SetNotifier(n Notifier) {
+	if n == nil {
+		e.lifecycle = nil
+		return
+	}
+	e.lifecycle = &lifecycle[C]{
+		notifier: n,
+		phase:    e.Phase(),
+	}
+	e.lifecycle.winners, e.lifecycle.winnersKnown = e.winners()
+}
+
+// CheckLifecycle delivers events to the notifier for the changes of the
+// election phase and winners since the previous check. As elections open
+// and close by their schedules, without calls to the election, it should be
+// called periodically, for example by the Manager RunLifecycle method.
+// Winner changes are not reported while the results are sealed.
+func (e *Election[V, C]) CheckLifecycle() {
+	l := e.lifecycle
+	if l == nil {
+		return
+	}
+	now := e.now()
+
+	phase := e.Phase()
+	if l.phase < PhaseOpen && phase >= PhaseOpen {
+		e.notify(Event{Type: EventOpened, Time: now, Message: "the election is open"})
+	}
+	if l.phase <= PhaseOpen && phase > PhaseOpen {
+		e.notify(Event{Type: EventClosed, Time: now, Message: "the election is closed"})
+	}
+	l.phase = phase
+
+	winners, ok := e.winners()
+	if !ok {
+		return
+	}
+	if l.winnersKnown && !equalChoices(winners, l.winners) {
+		e.notify(Event{
+			Type:    EventWinnerChanged,
+			Time:    now,
+			Message: fmt.Sprintf("the winners changed from %v to %v", l.winners, winners),
+			Data: WinnerChange[C]{
+				Previous: l.winners,
+				Winners:  winners,
+			},
+		})
+	}
+	l.winners, l.winnersKnown = winners, true
+}
+
+// winners returns the choices in the first place, or false if the results
+// are sealed. There are no winners without ballots.
+func (e *Election[V, C]) winners() ([]C, bool) {
+	if e.checkSealed() != nil {
+		return nil, false
+	}
+	if len(e.records) == 0 {
+		return []C{}, true
+	}
+	results, _, _ := e.voting.Compute()
+	winners := make([]C, 0, 1)
+	for _, r := range results {
+		if r.Wins != results[0].Wins {
+			break
+		}
+		winners = append(winners, r.Choice)
+	}
+	return winners, true
+}
+
+// notify delivers the event about the election to the notifier.
+func (e *Election[V, C]) notify(ev Event) {
+	if e.lifecycle == nil {
+		return
+	}
+	ev.Election = e.config.Name
+	e.lifecycle.notifier.Notify(ev)
+}
+
+func equalChoices[C comparable](a, b []C) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SetNotifier sets the notifier of lifecycle events of all current and
+// future elections of the Manager, just as the Election SetNotifier method
+// does.
+func (m *Manager[V, C]) SetNotifier(n Notifier) {
+	m.mu.Lock()
+	m.notifier = n
+	elections := make([]*managedElection[V, C], 0, len(m.elections))
+	for _, me := range m.elections {
+		elections = append(elections, me)
+	}
+	m.mu.Unlock()
+
+	for _, me := range elections {
+		me.mu.Lock()
+		me.election.SetNotifier(n)
+		me.mu.Unlock()
+	}
+}
+
+// RunLifecycle calls the CheckLifecycle method of all elections of the
+// Manager and the CheckLinks method in the interval, until the context is
+// canceled.
+func (m *Manager[V, C]) RunLifecycle(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		var wg sync.WaitGroup
+		for _, name := range m.Names() {
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				_ = m.Do(name, func(e *Election[V, C]) error {
+					e.CheckLifecycle()
+					return nil
+				})
+			}(name)
+		}
+		wg.Wait()
+		_ = m.CheckLinks()
+	}
+}
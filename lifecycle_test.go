@@ -0,0 +1,144 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_CheckLifecycle(t *testing.T) {
+	opens := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	closes := opens.Add(24 * time.Hour)
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:     "board",
+		Choices:  []string{"A", "B", "C"},
+		Schedule: schulze.Schedule{Opens: &opens, Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := opens.Add(-time.Hour)
+	e.SetNow(func() time.Time { return now })
+
+	var events []schulze.Event
+	e.SetNotifier(schulze.NotifierFunc(func(ev schulze.Event) {
+		events = append(events, ev)
+	}))
+	types := func() (types []schulze.EventType) {
+		for _, ev := range events {
+			types = append(types, ev.Type)
+		}
+		events = nil
+		return types
+	}
+
+	e.CheckLifecycle()
+	if got := types(); got != nil {
+		t.Errorf("got events %v before the election opens", got)
+	}
+
+	now = opens.Add(time.Hour)
+	e.CheckLifecycle()
+	if got, want := types(), []schulze.EventType{schulze.EventOpened}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	e.CheckLifecycle()
+	if len(events) != 1 || events[0].Type != schulze.EventWinnerChanged || events[0].Election != "board" {
+		t.Fatalf("got events %+v, want winner change", events)
+	}
+	if got, want := events[0].Data, (schulze.WinnerChange[string]{Previous: []string{}, Winners: []string{"A"}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got winner change %+v, want %+v", got, want)
+	}
+	events = nil
+
+	// the same winner
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"A": 1, "B": 2}); err != nil {
+		t.Fatal(err)
+	}
+	e.CheckLifecycle()
+	if got := types(); got != nil {
+		t.Errorf("got events %v without changes", got)
+	}
+
+	for _, voter := range []string{"carol", "dave", "erin"} {
+		if _, err := e.Vote(voter, schulze.Ballot[string]{"B": 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the election closes and the winner changes between checks
+	now = closes.Add(time.Hour)
+	e.CheckLifecycle()
+	if got, want := types(), []schulze.EventType{schulze.EventClosed, schulze.EventWinnerChanged}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := schulze.Certify(e, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Type != schulze.EventCertified || events[0].Data != c {
+		t.Errorf("got events %+v, want certification", events)
+	}
+}
+
+func TestManager_RunLifecycle(t *testing.T) {
+	closes := time.Now().Add(50 * time.Millisecond)
+
+	var mu sync.Mutex
+	var events []schulze.Event
+	m := schulze.NewManager[string, string]()
+	m.SetNotifier(schulze.NotifierFunc(func(ev schulze.Event) {
+		mu.Lock()
+		events = append(events, ev)
+		mu.Unlock()
+	}))
+	if err := m.Create("poll", schulze.ElectionConfig[string]{
+		Name:     "poll",
+		Choices:  []string{"A", "B"},
+		Schedule: schulze.Schedule{Closes: &closes},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go m.RunLifecycle(ctx, 10*time.Millisecond)
+
+	for {
+		mu.Lock()
+		n := len(events)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("timeout waiting for the event")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if events[0].Type != schulze.EventClosed || events[0].Election != "poll" {
+		t.Errorf("got event %+v, want closed", events[0])
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"encoding/hex"
+	"time"
+)
+
+// Linkage is the provenance of choices of an election that are seeded from
+// the top results of a preceding election, such as a general election that
+// is seeded from a primary. It is recorded in both elections.
+type Linkage[C comparable] struct {
+	// Name of the election with the results.
+	Source string `json:"source"`
+	// Name of the election with the seeded choices.
+	Target string `json:"target"`
+	// Requested number of the top choices.
+	Top int `json:"top"`
+	// Seeded choices in the order of the results, including choices that
+	// are tied with the last of the top choices.
+	Choices []C `json:"choices"`
+	// Hex encoded state hash of the source election when the choices were
+	// seeded.
+	SourceStateHash string    `json:"sourceStateHash"`
+	Time            time.Time `json:"time"`
+}
+
+// SeedChoices sets the choices of the target election to the top choices by
+// the final results of the source election, and records the Linkage in both
+// elections, with their names from the configurations. Choices that are tied
+// with the last of the top choices are seeded as well. ErrResultsNotFinal is
+// returned if the source election is not in its final phase, and
+// ErrElectionHasBallots if the target election has recorded ballots.
+func SeedChoices[V, C comparable](source, target *Election[V, C], top int) (Linkage[C], error) {
+	return seedChoices(source, target, source.config.Name, target.config.Name, top)
+}
+
+func seedChoices[V, C comparable](source, target *Election[V, C], sourceName, targetName string, top int) (Linkage[C], error) {
+	if top < 1 {
+		return Linkage[C]{}, ErrInvalidSeedCount
+	}
+	if source.Phase() != PhaseFinal {
+		return Linkage[C]{}, ErrResultsNotFinal
+	}
+	if target.VotersCount() > 0 {
+		return Linkage[C]{}, ErrElectionHasBallots
+	}
+	results, _, _, err := source.Compute()
+	if err != nil {
+		return Linkage[C]{}, err
+	}
+	var choices []C
+	for i, r := range results {
+		if i >= top && r.Wins != results[top-1].Wins {
+			break
+		}
+		choices = append(choices, r.Choice)
+	}
+	l := Linkage[C]{
+		Source:          sourceName,
+		Target:          targetName,
+		Top:             top,
+		Choices:         choices,
+		SourceStateHash: hex.EncodeToString(source.StateHash()),
+		Time:            target.now().UTC(),
+	}
+	target.SetChoices(append([]C(nil), choices...))
+	source.linkages = append(source.linkages, l)
+	target.linkages = append(target.linkages, copyLinkage(l))
+	return copyLinkage(l), nil
+}
+
+// Linkages returns the provenance of choices seeded from and to the election
+// in the order they were seeded.
+func (e *Election[V, C]) Linkages() []Linkage[C] {
+	linkages := make([]Linkage[C], 0, len(e.linkages))
+	for _, l := range e.linkages {
+		linkages = append(linkages, copyLinkage(l))
+	}
+	return linkages
+}
+
+func copyLinkage[C comparable](l Linkage[C]) Linkage[C] {
+	l.Choices = append([]C(nil), l.Choices...)
+	return l
+}
+
+// managerLink is a pending linkage of two elections of the Manager.
+type managerLink struct {
+	source string
+	target string
+	top    int
+}
+
+// Link links the elections of the Manager, so that the top choices by the
+// final results of the source election are seeded as the choices of the
+// target election, just as SeedChoices does, once the source election is in
+// its final phase. The Linkage is recorded with the names of the elections
+// in the Manager. Links are checked by the CheckLinks method, which is
+// called periodically by RunLifecycle. UnknownElectionError is returned if
+// any of the elections does not exist.
+func (m *Manager[V, C]) Link(source, target string, top int) error {
+	if top < 1 {
+		return ErrInvalidSeedCount
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, name := range []string{source, target} {
+		if _, ok := m.elections[name]; !ok {
+			return &UnknownElectionError{Name: name}
+		}
+	}
+	m.links = append(m.links, managerLink{
+		source: source,
+		target: target,
+		top:    top,
+	})
+	return nil
+}
+
+// CheckLinks seeds the choices of the linked elections of the Manager whose
+// source elections are in their final phase, and returns the error of the
+// first link that could not be seeded. Links of removed elections are
+// discarded, and links that are not seeded are checked again by the next
+// call.
+func (m *Manager[V, C]) CheckLinks() error {
+	m.mu.Lock()
+	links := m.links
+	m.links = nil
+	m.mu.Unlock()
+
+	var pending []managerLink
+	var firstErr error
+	for _, l := range links {
+		seeded, err := m.seedLink(l)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if !seeded {
+			pending = append(pending, l)
+		}
+	}
+
+	m.mu.Lock()
+	m.links = append(pending, m.links...)
+	m.mu.Unlock()
+	return firstErr
+}
+
+// seedLink seeds the choices of the link and returns true if the link is
+// done, either by seeding or as its elections are removed.
+func (m *Manager[V, C]) seedLink(l managerLink) (done bool, err error) {
+	m.mu.Lock()
+	source, sourceOK := m.elections[l.source]
+	target, targetOK := m.elections[l.target]
+	m.mu.Unlock()
+	if !sourceOK || !targetOK {
+		return true, nil
+	}
+
+	// elections are locked in the order of names, so that concurrent
+	// checks of links in opposite directions do not deadlock
+	first, second := source, target
+	if l.target < l.source {
+		first, second = target, source
+	}
+	first.mu.Lock()
+	defer first.mu.Unlock()
+	if second != first {
+		second.mu.Lock()
+		defer second.mu.Unlock()
+	}
+	if source.election.Phase() != PhaseFinal {
+		return false, nil
+	}
+	if _, err := seedChoices(source.election, target.election, l.source, l.target, l.top); err != nil {
+		return false, err
+	}
+	return true, nil
+}
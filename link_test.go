@@ -0,0 +1,197 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestSeedChoices(t *testing.T) {
+	closes := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	now := closes.Add(-time.Hour)
+
+	primary, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:     "primary",
+		Choices:  []string{"A", "B", "C", "D"},
+		Schedule: schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary.SetNow(func() time.Time { return now })
+	general, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name: "general",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	general.SetNow(func() time.Time { return now })
+
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"B": 1, "A": 2, "C": 3, "D": 4},
+		"bob":   {"B": 1, "C": 2, "A": 3, "D": 4},
+		"carol": {"A": 1, "B": 2, "C": 3, "D": 4},
+	} {
+		if _, err := primary.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := schulze.SeedChoices(primary, general, 2); !errors.Is(err, schulze.ErrResultsNotFinal) {
+		t.Fatalf("got error %v, want %v", err, schulze.ErrResultsNotFinal)
+	}
+
+	now = closes.Add(time.Hour)
+	l, err := schulze.SeedChoices(primary, general, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := schulze.Linkage[string]{
+		Source:          "primary",
+		Target:          "general",
+		Top:             2,
+		Choices:         []string{"B", "A"},
+		SourceStateHash: l.SourceStateHash,
+		Time:            now,
+	}
+	if !reflect.DeepEqual(l, want) {
+		t.Errorf("got linkage %+v, want %+v", l, want)
+	}
+	if l.SourceStateHash == "" {
+		t.Error("got no source state hash")
+	}
+	if got := general.Config().Choices; !reflect.DeepEqual(got, []string{"B", "A"}) {
+		t.Errorf("got general choices %v, want %v", got, []string{"B", "A"})
+	}
+	for name, e := range map[string]*schulze.Election[string, string]{"primary": primary, "general": general} {
+		if got := e.Linkages(); !reflect.DeepEqual(got, []schulze.Linkage[string]{want}) {
+			t.Errorf("%s: got linkages %+v, want %+v", name, got, want)
+		}
+	}
+
+	if _, err := general.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := schulze.SeedChoices(primary, general, 2); !errors.Is(err, schulze.ErrElectionHasBallots) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrElectionHasBallots)
+	}
+	if _, err := schulze.SeedChoices(primary, general, 0); !errors.Is(err, schulze.ErrInvalidSeedCount) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidSeedCount)
+	}
+}
+
+func TestSeedChoices_tie(t *testing.T) {
+	closes := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	now := closes.Add(-time.Hour)
+
+	primary, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:  []string{"A", "B", "C"},
+		Schedule: schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary.SetNow(func() time.Time { return now })
+	general := schulze.NewElection[string, string](nil)
+
+	if _, err := primary.Vote("alice", schulze.Ballot[string]{"A": 1, "B": 2, "C": 2}); err != nil {
+		t.Fatal(err)
+	}
+	now = closes
+
+	l, err := schulze.SeedChoices(primary, general, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(l.Choices); got != 3 {
+		t.Errorf("got seeded choices %v, want all choices tied for the second place", l.Choices)
+	}
+}
+
+func TestManager_Link(t *testing.T) {
+	closes := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	now := closes.Add(-time.Hour)
+
+	m := schulze.NewManager[string, string]()
+	primary, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:  []string{"A", "B", "C"},
+		Schedule: schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary.SetNow(func() time.Time { return now })
+	if err := m.Add("primary", primary); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Create("general", schulze.ElectionConfig[string]{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var uerr *schulze.UnknownElectionError
+	if err := m.Link("primary", "runoff", 1); !errors.As(err, &uerr) {
+		t.Errorf("got error %v, want UnknownElectionError", err)
+	}
+	if err := m.Link("primary", "general", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Do("primary", func(e *schulze.Election[string, string]) error {
+		_, err := e.Vote("alice", schulze.Ballot[string]{"C": 1})
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	general := func() (choices []string, linkages []schulze.Linkage[string]) {
+		if err := m.Do("general", func(e *schulze.Election[string, string]) error {
+			choices = e.Config().Choices
+			linkages = e.Linkages()
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return choices, linkages
+	}
+
+	// the link is pending until the primary is closed
+	if err := m.CheckLinks(); err != nil {
+		t.Fatal(err)
+	}
+	if choices, _ := general(); len(choices) != 0 {
+		t.Errorf("got general choices %v before the primary is closed", choices)
+	}
+
+	now = closes.Add(time.Hour)
+	if err := m.CheckLinks(); err != nil {
+		t.Fatal(err)
+	}
+	choices, linkages := general()
+	if !reflect.DeepEqual(choices, []string{"C"}) {
+		t.Errorf("got general choices %v, want %v", choices, []string{"C"})
+	}
+	if len(linkages) != 1 || linkages[0].Source != "primary" || linkages[0].Target != "general" {
+		t.Errorf("got linkages %+v, want the linkage of the primary and the general election", linkages)
+	}
+
+	// the link is seeded only once
+	if err := m.Do("general", func(e *schulze.Election[string, string]) error {
+		e.SetChoices([]string{"C", "D"})
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CheckLinks(); err != nil {
+		t.Fatal(err)
+	}
+	if choices, _ := general(); !reflect.DeepEqual(choices, []string{"C", "D"}) {
+		t.Errorf("got general choices %v, want %v", choices, []string{"C", "D"})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"sort"
+	"sync"
+)
+
+// Manager holds multiple named elections, such as polls in different chat
+// channels, and serializes calls to every election. Methods on the Manager
+// type are safe for concurrent calls.
+type Manager[V, C comparable] struct {
+	elections map[string]*managedElection[V, C]
+	notifier  Notifier
+	links     []managerLink
+	mu        sync.Mutex
+}
+
+type managedElection[V, C comparable] struct {
+	election *Election[V, C]
+	mu       sync.Mutex
+}
+
+// NewManager returns a new Manager without elections.
+func NewManager[V, C comparable]() *Manager[V, C] {
+	return &Manager[V, C]{
+		elections: make(map[string]*managedElection[V, C]),
+	}
+}
+
+// Create adds a new election with the name from the configuration.
+// DuplicateElectionError is returned if the election with the same name
+// exists.
+func (m *Manager[V, C]) Create(name string, config ElectionConfig[C], opts ...Option[C]) error {
+	e, err := NewElectionFromConfig[V](config, opts...)
+	if err != nil {
+		return err
+	}
+	return m.Add(name, e)
+}
+
+// Add adds an existing election with the name. DuplicateElectionError is
+// returned if the election with the same name exists. The election must not
+// be used directly after it is added. The notifier of the Manager, if it is
+// set, replaces the notifier of the election.
+func (m *Manager[V, C]) Add(name string, e *Election[V, C]) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.elections[name]; ok {
+		return &DuplicateElectionError{Name: name}
+	}
+	if m.notifier != nil {
+		e.SetNotifier(m.notifier)
+	}
+	m.elections[name] = &managedElection[V, C]{election: e}
+	return nil
+}
+
+// Remove removes the election with the name and returns it, or nil if the
+// election does not exist.
+func (m *Manager[V, C]) Remove(name string) *Election[V, C] {
+	m.mu.Lock()
+	me, ok := m.elections[name]
+	delete(m.elections, name)
+	m.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	// wait for the calls in progress
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	return me.election
+}
+
+// Names returns sorted names of all elections.
+func (m *Manager[V, C]) Names() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.elections))
+	for name := range m.elections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Do calls the function with the election of the name, while no other calls
+// to the same election are in progress, and returns the error of the
+// function. UnknownElectionError is returned if the election does not exist.
+func (m *Manager[V, C]) Do(name string, f func(e *Election[V, C]) error) error {
+	m.mu.Lock()
+	me, ok := m.elections[name]
+	m.mu.Unlock()
+	if !ok {
+		return &UnknownElectionError{Name: name}
+	}
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	return f(me.election)
+}
+
+// VoterRegistry maps identities of users on external platforms, such as chat
+// or email accounts, to voters, so that the same voter can vote through
+// multiple platforms and only registered users are eligible to vote.
+// Methods on the VoterRegistry type are safe for concurrent calls.
+type VoterRegistry[V comparable] struct {
+	voters map[string]V
+	mu     sync.RWMutex
+}
+
+// NewVoterRegistry returns a new VoterRegistry without voters.
+func NewVoterRegistry[V comparable]() *VoterRegistry[V] {
+	return &VoterRegistry[V]{
+		voters: make(map[string]V),
+	}
+}
+
+// Register maps the identity to the voter, replacing the existing mapping.
+func (r *VoterRegistry[V]) Register(identity string, voter V) {
+	r.mu.Lock()
+	r.voters[identity] = voter
+	r.mu.Unlock()
+}
+
+// Unregister removes the identity from the registry. Ballots that the voter
+// already cast are not changed.
+func (r *VoterRegistry[V]) Unregister(identity string) {
+	r.mu.Lock()
+	delete(r.voters, identity)
+	r.mu.Unlock()
+}
+
+// Voter returns the voter of the identity. ErrVoterNotRegistered is returned
+// if the identity is not registered.
+func (r *VoterRegistry[V]) Voter(identity string) (voter V, err error) {
+	r.mu.RLock()
+	voter, ok := r.voters[identity]
+	r.mu.RUnlock()
+	if !ok {
+		return voter, ErrVoterNotRegistered
+	}
+	return voter, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestManager(t *testing.T) {
+	m := schulze.NewManager[string, string]()
+
+	if err := m.Create("lunch", schulze.ElectionConfig[string]{Choices: []string{"A", "B"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Create("dinner", schulze.ElectionConfig[string]{Choices: []string{"C", "D"}}); err != nil {
+		t.Fatal(err)
+	}
+	var derr *schulze.DuplicateElectionError
+	if err := m.Create("lunch", schulze.ElectionConfig[string]{}); !errors.As(err, &derr) || derr.Name != "lunch" {
+		t.Fatalf("got error %v, want DuplicateElectionError", err)
+	}
+	if got, want := m.Names(), []string{"dinner", "lunch"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got names %v, want %v", got, want)
+	}
+
+	var wg sync.WaitGroup
+	for _, voter := range []string{"alice", "bob", "carol", "dave"} {
+		wg.Add(1)
+		go func(voter string) {
+			defer wg.Done()
+			if err := m.Do("lunch", func(e *schulze.Election[string, string]) error {
+				_, err := e.Vote(voter, schulze.Ballot[string]{"B": 1})
+				return err
+			}); err != nil {
+				t.Error(err)
+			}
+		}(voter)
+	}
+	wg.Wait()
+
+	e := m.Remove("lunch")
+	if e == nil {
+		t.Fatal("election not removed")
+	}
+	if got := e.VotersCount(); got != 4 {
+		t.Errorf("got voters count %v, want %v", got, 4)
+	}
+	if e := m.Remove("lunch"); e != nil {
+		t.Error("removed election twice")
+	}
+
+	var uerr *schulze.UnknownElectionError
+	if err := m.Do("lunch", func(*schulze.Election[string, string]) error { return nil }); !errors.As(err, &uerr) || uerr.Name != "lunch" {
+		t.Fatalf("got error %v, want UnknownElectionError", err)
+	}
+}
+
+func TestVoterRegistry(t *testing.T) {
+	r := schulze.NewVoterRegistry[string]()
+
+	r.Register("slack:U1", "alice")
+	r.Register("email:alice@example.com", "alice")
+
+	for _, identity := range []string{"slack:U1", "email:alice@example.com"} {
+		voter, err := r.Voter(identity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if voter != "alice" {
+			t.Errorf("%s: got voter %q, want %q", identity, voter, "alice")
+		}
+	}
+
+	r.Unregister("slack:U1")
+	if _, err := r.Voter("slack:U1"); !errors.Is(err, schulze.ErrVoterNotRegistered) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrVoterNotRegistered)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Types of the Matrix Client-Server API that are used by the Bot.
+
+type syncResponse struct {
+	NextBatch string `json:"next_batch"`
+	Rooms     struct {
+		Join map[string]struct {
+			Timeline struct {
+				Events []event `json:"events"`
+			} `json:"timeline"`
+		} `json:"join"`
+		Invite map[string]json.RawMessage `json:"invite"`
+	} `json:"rooms"`
+}
+
+type event struct {
+	Type    string       `json:"type"`
+	EventID string       `json:"event_id"`
+	Sender  string       `json:"sender"`
+	Content eventContent `json:"content"`
+}
+
+type eventContent struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+type messageContent struct {
+	MsgType    string          `json:"msgtype"`
+	Body       string          `json:"body"`
+	NewContent *messageContent `json:"m.new_content,omitempty"`
+	RelatesTo  *relatesTo      `json:"m.relates_to,omitempty"`
+}
+
+type relatesTo struct {
+	RelType string `json:"rel_type"`
+	EventID string `json:"event_id"`
+}
+
+// APIError is returned when the Matrix homeserver responds with an error.
+type APIError struct {
+	StatusCode int
+	ErrCode    string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("matrix: %d %s: %s", e.StatusCode, e.ErrCode, e.Message)
+}
+
+// call calls the Client-Server API endpoint with the JSON encoded request,
+// if it is not nil, and decodes the response into the result value, if it
+// is not nil.
+func (b *Bot) call(ctx context.Context, method, path string, request, result any) error {
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, b.homeserver+"/_matrix/client/v3"+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+b.accessToken)
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("matrix: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			ErrCode string `json:"errcode"`
+			Error   string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&e)
+		return &APIError{StatusCode: resp.StatusCode, ErrCode: e.ErrCode, Message: e.Error}
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("matrix: decode response: %w", err)
+	}
+	return nil
+}
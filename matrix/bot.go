@@ -0,0 +1,363 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package matrix runs ranked polls in rooms of the Matrix chat protocol with
+// a bot account that synchronizes with the homeserver by long polling.
+//
+// The bot joins rooms that it is invited to and responds to the following
+// commands in room messages:
+//
+//   - !poll A, B, C: create a poll in the room with the listed choices and
+//     pin the results message, which is edited on every change
+//   - !vote A > B = C: cast or replace the ballot in the text format of the
+//     schulze.ParseBallot function
+//   - !unvote: remove the ballot
+//   - !close: post the final results and remove the poll
+//
+// Room messages are visible to all room members, so polls with secret
+// ballots should be held in rooms where that is acceptable, or voters should
+// redact their messages.
+package matrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+// pollTimeout is the long polling timeout of the sync endpoint.
+const pollTimeout = 30 * time.Second
+
+// Option configures the Bot.
+type Option func(*Bot)
+
+// WithHTTPClient sets the HTTP client of the homeserver API calls. It must
+// not time out before the long polling timeout of 30 seconds.
+func WithHTTPClient(c *http.Client) Option {
+	return func(b *Bot) {
+		b.client = c
+	}
+}
+
+// WithVoterRegistry sets the registry that maps identities of Matrix users,
+// of the form "matrix:<user id>", to voters. Only users that are registered
+// can vote. By default, every user can vote and the identity is the voter.
+func WithVoterRegistry(r *schulze.VoterRegistry[string]) Option {
+	return func(b *Bot) {
+		b.registry = r
+	}
+}
+
+// WithElectionConfig sets the configuration of created polls. Choices are
+// replaced by the choices of the !poll command.
+func WithElectionConfig(c schulze.ElectionConfig[string]) Option {
+	return func(b *Bot) {
+		b.config = c
+	}
+}
+
+// Bot runs polls in Matrix rooms. Polls are created in the Manager with
+// names of the form "matrix:<room id>".
+type Bot struct {
+	homeserver  string
+	accessToken string
+	manager     *schulze.Manager[string, string]
+	registry    *schulze.VoterRegistry[string]
+	config      schulze.ElectionConfig[string]
+	client      *http.Client
+	userID      string
+
+	mu sync.Mutex
+	// event IDs of pinned results messages by room
+	results map[string]string
+	txnID   int64
+}
+
+// NewBot returns a new Bot that uses the access token of the bot account on
+// the homeserver with the URL, such as "https://matrix.example.com".
+func NewBot(homeserver, accessToken string, m *schulze.Manager[string, string], opts ...Option) *Bot {
+	b := &Bot{
+		homeserver:  strings.TrimSuffix(homeserver, "/"),
+		accessToken: accessToken,
+		manager:     m,
+		client:      &http.Client{Timeout: 2 * pollTimeout},
+		results:     make(map[string]string),
+		txnID:       time.Now().UnixNano(),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Run synchronizes with the homeserver and handles room events until the
+// context is canceled. Events from before the start are not handled. Errors
+// of handling individual events are reported to the optional errors
+// function and Run continues, while it returns on errors of synchronization,
+// other than the context cancellation.
+func (b *Bot) Run(ctx context.Context, errs func(error)) error {
+	var whoami struct {
+		UserID string `json:"user_id"`
+	}
+	if err := b.call(ctx, http.MethodGet, "/account/whoami", nil, &whoami); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	b.userID = whoami.UserID
+
+	var since string
+	for first := true; ; first = false {
+		q := url.Values{"timeout": {strconv.FormatInt(pollTimeout.Milliseconds(), 10)}}
+		if first {
+			q.Set("timeout", "0")
+		}
+		if since != "" {
+			q.Set("since", since)
+		}
+		var s syncResponse
+		if err := b.call(ctx, http.MethodGet, "/sync?"+q.Encode(), nil, &s); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		since = s.NextBatch
+		for roomID := range s.Rooms.Invite {
+			if err := b.call(ctx, http.MethodPost, "/join/"+url.PathEscape(roomID), struct{}{}, nil); err != nil && errs != nil && ctx.Err() == nil {
+				errs(err)
+			}
+		}
+		if first {
+			continue
+		}
+		for roomID, room := range s.Rooms.Join {
+			for _, e := range room.Timeline.Events {
+				if e.Type != "m.room.message" || e.Sender == b.userID {
+					continue
+				}
+				if err := b.handle(ctx, roomID, e); err != nil && errs != nil && ctx.Err() == nil {
+					errs(err)
+				}
+			}
+		}
+	}
+}
+
+func (b *Bot) handle(ctx context.Context, roomID string, e event) error {
+	command, text, _ := strings.Cut(strings.TrimSpace(e.Content.Body), " ")
+	text = strings.TrimSpace(text)
+	switch command {
+	case "!poll":
+		return b.createPoll(ctx, roomID, text)
+	case "!vote":
+		return b.vote(ctx, roomID, e.Sender, text)
+	case "!unvote":
+		return b.unvote(ctx, roomID, e.Sender)
+	case "!close":
+		return b.closePoll(ctx, roomID)
+	}
+	return nil
+}
+
+func (b *Bot) createPoll(ctx context.Context, roomID, text string) error {
+	var choices []string
+	for _, c := range strings.Split(text, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			choices = append(choices, c)
+		}
+	}
+	if len(choices) < 2 {
+		_, err := b.send(ctx, roomID, "A poll needs at least two choices separated by commas, for example: !poll Pizza, Sushi, Tacos")
+		return err
+	}
+	config := b.config
+	config.Choices = choices
+	if err := b.manager.Create(pollName(roomID), config); err != nil {
+		var derr *schulze.DuplicateElectionError
+		if errors.As(err, &derr) {
+			_, err = b.send(ctx, roomID, "There is already a poll in this room.")
+		}
+		return err
+	}
+	text, err := b.resultsText(roomID)
+	if err != nil {
+		return err
+	}
+	eventID, err := b.send(ctx, roomID, text)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.results[roomID] = eventID
+	b.mu.Unlock()
+	return b.call(ctx, http.MethodPut, "/rooms/"+url.PathEscape(roomID)+"/state/m.room.pinned_events", map[string][]string{
+		"pinned": {eventID},
+	}, nil)
+}
+
+func (b *Bot) vote(ctx context.Context, roomID, sender, text string) error {
+	ballot, err := schulze.ParseBallot(text)
+	if err != nil {
+		return b.reply(ctx, roomID, sender, err)
+	}
+	voter, err := b.voter(sender)
+	if err != nil {
+		return b.reply(ctx, roomID, sender, err)
+	}
+	var record schulze.Record[string]
+	if err := b.manager.Do(pollName(roomID), func(e *schulze.Election[string, string]) (err error) {
+		record, err = e.Vote(voter, ballot)
+		return err
+	}); err != nil {
+		return b.reply(ctx, roomID, sender, err)
+	}
+	if _, err := b.send(ctx, roomID, sender+": your ballot is recorded: "+schulze.FormatRecord(record)); err != nil {
+		return err
+	}
+	return b.updateResults(ctx, roomID)
+}
+
+func (b *Bot) unvote(ctx context.Context, roomID, sender string) error {
+	voter, err := b.voter(sender)
+	if err != nil {
+		return b.reply(ctx, roomID, sender, err)
+	}
+	if err := b.manager.Do(pollName(roomID), func(e *schulze.Election[string, string]) error {
+		return e.Unvote(voter)
+	}); err != nil {
+		return b.reply(ctx, roomID, sender, err)
+	}
+	if _, err := b.send(ctx, roomID, sender+": your ballot is removed"); err != nil {
+		return err
+	}
+	return b.updateResults(ctx, roomID)
+}
+
+func (b *Bot) closePoll(ctx context.Context, roomID string) error {
+	text, err := b.resultsText(roomID)
+	if err != nil {
+		return b.reply(ctx, roomID, "", err)
+	}
+	b.manager.Remove(pollName(roomID))
+	b.mu.Lock()
+	eventID, pinned := b.results[roomID]
+	delete(b.results, roomID)
+	b.mu.Unlock()
+	if pinned {
+		if err := b.edit(ctx, roomID, eventID, "The poll is closed.\n\n"+text); err != nil {
+			return err
+		}
+	}
+	_, err = b.send(ctx, roomID, "The poll is closed.\n\n"+text)
+	return err
+}
+
+// updateResults edits the pinned results message with the current results.
+func (b *Bot) updateResults(ctx context.Context, roomID string) error {
+	b.mu.Lock()
+	eventID, ok := b.results[roomID]
+	b.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	text, err := b.resultsText(roomID)
+	if err != nil {
+		return err
+	}
+	return b.edit(ctx, roomID, eventID, text)
+}
+
+// resultsText returns the text of the results message.
+func (b *Bot) resultsText(roomID string) (string, error) {
+	var text string
+	err := b.manager.Do(pollName(roomID), func(e *schulze.Election[string, string]) error {
+		text = "Poll: " + strings.Join(e.Choices(), ", ") + "\n\n"
+		results, duels, _, err := e.Compute()
+		switch {
+		case errors.Is(err, schulze.ErrSealed):
+			text += "The results are sealed."
+		case err != nil:
+			return err
+		case e.VotersCount() == 0:
+			text += "No votes yet. Vote with: !vote " + strings.Join(e.Choices(), " > ")
+		default:
+			text += strings.TrimSpace(schulze.Explain(results, duels, schulze.ExplainText))
+		}
+		text += fmt.Sprintf("\n\nVoters: %d", e.VotersCount())
+		return nil
+	})
+	return text, err
+}
+
+// reply sends the error message to the sender.
+func (b *Bot) reply(ctx context.Context, roomID, sender string, err error) error {
+	var uerr *schulze.UnknownElectionError
+	var text string
+	switch {
+	case errors.As(err, &uerr):
+		text = "There is no poll in this room. Create one with: !poll A, B, C"
+	case errors.Is(err, schulze.ErrVoterNotRegistered):
+		text = "You are not registered to vote."
+	default:
+		text = "Error: " + strings.TrimPrefix(err.Error(), "schulze: ")
+	}
+	if sender != "" {
+		text = sender + ": " + text
+	}
+	_, err = b.send(ctx, roomID, text)
+	return err
+}
+
+func (b *Bot) voter(userID string) (string, error) {
+	identity := "matrix:" + userID
+	if b.registry == nil {
+		return identity, nil
+	}
+	return b.registry.Voter(identity)
+}
+
+// send sends the notice message to the room and returns its event ID.
+func (b *Bot) send(ctx context.Context, roomID, text string) (string, error) {
+	return b.sendContent(ctx, roomID, messageContent{MsgType: "m.notice", Body: text})
+}
+
+// edit replaces the content of the message with the event ID.
+func (b *Bot) edit(ctx context.Context, roomID, eventID, text string) error {
+	_, err := b.sendContent(ctx, roomID, messageContent{
+		MsgType:    "m.notice",
+		Body:       "* " + text,
+		NewContent: &messageContent{MsgType: "m.notice", Body: text},
+		RelatesTo:  &relatesTo{RelType: "m.replace", EventID: eventID},
+	})
+	return err
+}
+
+func (b *Bot) sendContent(ctx context.Context, roomID string, content messageContent) (string, error) {
+	b.mu.Lock()
+	b.txnID++
+	txnID := strconv.FormatInt(b.txnID, 10)
+	b.mu.Unlock()
+	var r struct {
+		EventID string `json:"event_id"`
+	}
+	if err := b.call(ctx, http.MethodPut, "/rooms/"+url.PathEscape(roomID)+"/send/m.room.message/"+txnID, content, &r); err != nil {
+		return "", err
+	}
+	return r.EventID, nil
+}
+
+func pollName(roomID string) string {
+	return "matrix:" + roomID
+}
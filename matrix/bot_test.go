@@ -0,0 +1,188 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrix_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/matrix"
+)
+
+const (
+	botUserID = "@bot:example.org"
+	roomID    = "!room:example.org"
+)
+
+type call struct {
+	method string
+	path   string
+	body   map[string]any
+}
+
+// fakeHomeserver serves queued room events in sync responses and records
+// all other calls.
+type fakeHomeserver struct {
+	events chan map[string]any
+	calls  chan call
+
+	mu     sync.Mutex
+	nextID int
+}
+
+func newFakeHomeserver(t *testing.T) (*fakeHomeserver, *httptest.Server) {
+	t.Helper()
+	h := &fakeHomeserver{
+		events: make(chan map[string]any, 10),
+		calls:  make(chan call, 100),
+	}
+	s := httptest.NewServer(http.HandlerFunc(h.serve))
+	t.Cleanup(s.Close)
+	return h, s
+}
+
+func (h *fakeHomeserver) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]any{"errcode": "M_UNKNOWN_TOKEN", "error": "Invalid access token"})
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/_matrix/client/v3")
+	var result any = map[string]any{}
+	switch path {
+	case "/account/whoami":
+		result = map[string]any{"user_id": botUserID}
+	case "/sync":
+		if r.URL.Query().Get("since") == "" {
+			// the initial sync with the history and an invite
+			result = map[string]any{
+				"next_batch": "s1",
+				"rooms": map[string]any{
+					"join":   timeline(map[string]any{"type": "m.room.message", "sender": "@old:example.org", "content": map[string]any{"body": "!poll X, Y"}}),
+					"invite": map[string]any{roomID: map[string]any{}},
+				},
+			}
+			break
+		}
+		var events []map[string]any
+		select {
+		case e := <-h.events:
+			events = append(events, e)
+		case <-time.After(20 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		result = map[string]any{"next_batch": "s2", "rooms": map[string]any{"join": timeline(events...)}}
+	default:
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		h.mu.Lock()
+		h.nextID++
+		result = map[string]any{"event_id": fmt.Sprintf("$e%d", h.nextID)}
+		h.mu.Unlock()
+		h.calls <- call{method: r.Method, path: path, body: body}
+	}
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func timeline(events ...map[string]any) map[string]any {
+	if events == nil {
+		events = []map[string]any{}
+	}
+	return map[string]any{roomID: map[string]any{"timeline": map[string]any{"events": events}}}
+}
+
+func (h *fakeHomeserver) next(t *testing.T) call {
+	t.Helper()
+	select {
+	case c := <-h.calls:
+		return c
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for a call")
+	}
+	return call{}
+}
+
+func TestBot(t *testing.T) {
+	h, s := newFakeHomeserver(t)
+	m := schulze.NewManager[string, string]()
+	b := matrix.NewBot(s.URL, "token", m)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- b.Run(ctx, func(err error) { t.Error(err) }) }()
+
+	message := func(sender, body string) {
+		h.events <- map[string]any{"type": "m.room.message", "sender": sender, "event_id": "$m", "content": map[string]any{"msgtype": "m.text", "body": body}}
+	}
+
+	if c := h.next(t); c.method != http.MethodPost || c.path != "/join/"+roomID {
+		t.Fatalf("got join call %+v", c)
+	}
+
+	message("@alice:example.org", "!poll A, B, C")
+	c := h.next(t)
+	if !strings.HasPrefix(c.path, "/rooms/"+roomID+"/send/m.room.message/") || !strings.Contains(c.body["body"].(string), "No votes yet") {
+		t.Fatalf("got results message call %+v", c)
+	}
+	resultsID := "$e2"
+	c = h.next(t)
+	if c.path != "/rooms/"+roomID+"/state/m.room.pinned_events" || fmt.Sprint(c.body["pinned"]) != "["+resultsID+"]" {
+		t.Fatalf("got pin call %+v", c)
+	}
+
+	// messages of the bot are ignored
+	message(botUserID, "!vote A")
+	message("@alice:example.org", "!vote B > A")
+	if c := h.next(t); c.body["body"] != "@alice:example.org: your ballot is recorded: B>A" {
+		t.Fatalf("got vote reply %+v", c)
+	}
+	c = h.next(t)
+	newContent, _ := c.body["m.new_content"].(map[string]any)
+	relatesTo, _ := c.body["m.relates_to"].(map[string]any)
+	if relatesTo["rel_type"] != "m.replace" || relatesTo["event_id"] != resultsID || !strings.Contains(newContent["body"].(string), "B is the winner") {
+		t.Fatalf("got results edit call %+v", c)
+	}
+
+	message("@bob:example.org", "!vote D")
+	if c := h.next(t); !strings.HasPrefix(c.body["body"].(string), "@bob:example.org: Error: ") || !strings.Contains(c.body["body"].(string), "unknown choice D") {
+		t.Fatalf("got vote error reply %+v", c)
+	}
+
+	message("@alice:example.org", "!close")
+	if c := h.next(t); c.body["m.relates_to"] == nil || !strings.HasPrefix(c.body["m.new_content"].(map[string]any)["body"].(string), "The poll is closed.") {
+		t.Fatalf("got closed results edit call %+v", c)
+	}
+	if c := h.next(t); !strings.HasPrefix(c.body["body"].(string), "The poll is closed.") {
+		t.Fatalf("got close message call %+v", c)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Names(); len(got) != 0 {
+		t.Errorf("got polls %v, want none", got)
+	}
+}
+
+func TestBot_Run_error(t *testing.T) {
+	_, s := newFakeHomeserver(t)
+	b := matrix.NewBot(s.URL, "invalid", schulze.NewManager[string, string]())
+
+	err := b.Run(context.Background(), nil)
+	want := "matrix: 401 M_UNKNOWN_TOKEN: Invalid access token"
+	if err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
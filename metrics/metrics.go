@@ -0,0 +1,308 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package metrics provides Prometheus metrics for services that run many live
+// polls with the schulze package.
+//
+// Votings are created with the NewVoting function that registers metrics of
+// the poll in the Registry. The Registry serves all metrics in the Prometheus
+// text exposition format as an http.Handler, without a dependency on the
+// Prometheus client library. The following metrics are exposed, all with the
+// poll label:
+//
+//   - schulze_ballots_total: counter of cast ballots
+//   - schulze_unvotes_total: counter of removed ballots
+//   - schulze_compute_duration_seconds: histogram of results computation
+//     durations
+//   - schulze_choices: gauge of the number of choices
+//   - schulze_last_winner_change_timestamp_seconds: gauge of the Unix time
+//     when the computed winner changed
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+// DefaultBuckets are the upper bounds in seconds of the compute duration
+// histogram buckets, the same as the Prometheus client default buckets.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Registry holds metrics of multiple polls.
+type Registry struct {
+	buckets []float64
+	mu      sync.Mutex
+	polls   map[string]*pollMetrics
+}
+
+// NewRegistry returns a new Registry with compute duration histogram buckets.
+// If no buckets are provided, DefaultBuckets are used.
+func NewRegistry(buckets ...float64) *Registry {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Registry{
+		buckets: buckets,
+		polls:   make(map[string]*pollMetrics),
+	}
+}
+
+// Unregister removes metrics of the poll, for example when the poll is
+// finished.
+func (r *Registry) Unregister(poll string) {
+	r.mu.Lock()
+	delete(r.polls, poll)
+	r.mu.Unlock()
+}
+
+func (r *Registry) register(poll string) *pollMetrics {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.polls[poll]
+	if !ok {
+		m = &pollMetrics{pollValues: pollValues{bucketCounts: make([]uint64, len(r.buckets))}}
+		r.polls[poll] = m
+	}
+	return m
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = r.WriteTo(w)
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	polls := make([]string, 0, len(r.polls))
+	for poll := range r.polls {
+		polls = append(polls, poll)
+	}
+	sort.Strings(polls)
+	snapshots := make([]pollValues, len(polls))
+	for i, poll := range polls {
+		snapshots[i] = r.polls[poll].snapshot()
+	}
+	r.mu.Unlock()
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+
+	fmt.Fprintln(cw, "# HELP schulze_ballots_total Number of cast ballots.")
+	fmt.Fprintln(cw, "# TYPE schulze_ballots_total counter")
+	for i, m := range snapshots {
+		fmt.Fprintf(cw, "schulze_ballots_total{poll=%s} %v\n", quote(polls[i]), m.ballots)
+	}
+
+	fmt.Fprintln(cw, "# HELP schulze_unvotes_total Number of removed ballots.")
+	fmt.Fprintln(cw, "# TYPE schulze_unvotes_total counter")
+	for i, m := range snapshots {
+		fmt.Fprintf(cw, "schulze_unvotes_total{poll=%s} %v\n", quote(polls[i]), m.unvotes)
+	}
+
+	fmt.Fprintln(cw, "# HELP schulze_compute_duration_seconds Duration of results computations.")
+	fmt.Fprintln(cw, "# TYPE schulze_compute_duration_seconds histogram")
+	for i, m := range snapshots {
+		poll := quote(polls[i])
+		var cumulative uint64
+		for j, b := range r.buckets {
+			cumulative += m.bucketCounts[j]
+			fmt.Fprintf(cw, "schulze_compute_duration_seconds_bucket{poll=%s,le=%q} %v\n", poll, formatFloat(b), cumulative)
+		}
+		fmt.Fprintf(cw, "schulze_compute_duration_seconds_bucket{poll=%s,le=\"+Inf\"} %v\n", poll, m.computeCount)
+		fmt.Fprintf(cw, "schulze_compute_duration_seconds_sum{poll=%s} %s\n", poll, formatFloat(m.computeSum))
+		fmt.Fprintf(cw, "schulze_compute_duration_seconds_count{poll=%s} %v\n", poll, m.computeCount)
+	}
+
+	fmt.Fprintln(cw, "# HELP schulze_choices Number of choices.")
+	fmt.Fprintln(cw, "# TYPE schulze_choices gauge")
+	for i, m := range snapshots {
+		fmt.Fprintf(cw, "schulze_choices{poll=%s} %v\n", quote(polls[i]), m.choices)
+	}
+
+	fmt.Fprintln(cw, "# HELP schulze_last_winner_change_timestamp_seconds Unix time when the computed winner changed.")
+	fmt.Fprintln(cw, "# TYPE schulze_last_winner_change_timestamp_seconds gauge")
+	for i, m := range snapshots {
+		fmt.Fprintf(cw, "schulze_last_winner_change_timestamp_seconds{poll=%s} %s\n", quote(polls[i]), formatFloat(m.winnerChange))
+	}
+
+	if err := cw.w.Flush(); err != nil {
+		return cw.n, err
+	}
+	return cw.n, cw.err
+}
+
+type pollMetrics struct {
+	mu sync.Mutex
+	pollValues
+}
+
+type pollValues struct {
+	ballots      uint64
+	unvotes      uint64
+	bucketCounts []uint64
+	computeCount uint64
+	computeSum   float64
+	choices      int
+	winnerChange float64
+}
+
+func (m *pollMetrics) snapshot() pollValues {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return pollValues{
+		ballots:      m.ballots,
+		unvotes:      m.unvotes,
+		bucketCounts: append([]uint64(nil), m.bucketCounts...),
+		computeCount: m.computeCount,
+		computeSum:   m.computeSum,
+		choices:      m.choices,
+		winnerChange: m.winnerChange,
+	}
+}
+
+func (m *pollMetrics) update(f func(m *pollMetrics)) {
+	m.mu.Lock()
+	f(m)
+	m.mu.Unlock()
+}
+
+// Voting wraps the schulze.Voting, updating metrics of its poll. Methods on
+// the Voting type are not safe for concurrent calls, while metrics can be
+// served concurrently.
+type Voting[C comparable] struct {
+	voting    *schulze.Voting[C]
+	registry  *Registry
+	metrics   *pollMetrics
+	now       func() time.Time
+	hasWinner bool
+	winner    C
+}
+
+// NewVoting creates a new schulze.Voting with the choices and options, and
+// registers its metrics in the registry under the poll name. If the poll is
+// already registered, its metrics are shared.
+func NewVoting[C comparable](r *Registry, poll string, choices []C, opts ...schulze.Option[C]) *Voting[C] {
+	m := r.register(poll)
+	m.update(func(m *pollMetrics) {
+		m.choices = len(choices)
+	})
+	return &Voting[C]{
+		voting:   schulze.NewVoting(choices, opts...),
+		registry: r,
+		metrics:  m,
+		now:      time.Now,
+	}
+}
+
+// Voting returns the wrapped schulze.Voting. Calls to its methods are not
+// reflected in metrics.
+func (v *Voting[C]) Voting() *schulze.Voting[C] {
+	return v.voting
+}
+
+// Vote calls the schulze.Voting Vote method and counts the ballot.
+func (v *Voting[C]) Vote(b schulze.Ballot[C]) (schulze.Record[C], error) {
+	r, err := v.voting.Vote(b)
+	if err != nil {
+		return nil, err
+	}
+	v.metrics.update(func(m *pollMetrics) {
+		m.ballots++
+	})
+	return r, nil
+}
+
+// Unvote calls the schulze.Voting Unvote method and counts the removed
+// ballot.
+func (v *Voting[C]) Unvote(r schulze.Record[C]) error {
+	if err := v.voting.Unvote(r); err != nil {
+		return err
+	}
+	v.metrics.update(func(m *pollMetrics) {
+		m.unvotes++
+	})
+	return nil
+}
+
+// SetChoices calls the schulze.Voting SetChoices method and updates the
+// number of choices.
+func (v *Voting[C]) SetChoices(updated []C) {
+	v.voting.SetChoices(updated)
+	v.metrics.update(func(m *pollMetrics) {
+		m.choices = len(updated)
+	})
+}
+
+// Compute calls the schulze.Voting Compute method, observing its duration and
+// the change of the winner.
+func (v *Voting[C]) Compute() (results []schulze.Result[C], duels schulze.DuelsIterator[C], tie bool) {
+	start := v.now()
+	results, duels, tie = v.voting.Compute()
+	end := v.now()
+
+	changed := false
+	if len(results) > 0 && (!v.hasWinner || results[0].Choice != v.winner) {
+		v.hasWinner = true
+		v.winner = results[0].Choice
+		changed = true
+	}
+
+	duration := end.Sub(start).Seconds()
+	buckets := v.registry.buckets
+	v.metrics.update(func(m *pollMetrics) {
+		if i := sort.SearchFloat64s(buckets, duration); i < len(buckets) {
+			m.bucketCounts[i]++
+		}
+		m.computeCount++
+		m.computeSum += duration
+		if changed {
+			m.winnerChange = float64(end.UnixNano()) / 1e9
+		}
+	})
+	return results, duels, tie
+}
+
+// quote returns the label value quoted and escaped as required by the
+// Prometheus text exposition format.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	w.err = err
+	return n, err
+}
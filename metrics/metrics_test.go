@@ -0,0 +1,90 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package metrics_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/metrics"
+)
+
+func TestVoting(t *testing.T) {
+	r := metrics.NewRegistry(1, 5)
+
+	v := metrics.NewVoting(r, `poll "1"`, []string{"A", "B"})
+	other := metrics.NewVoting(r, "poll 2", []string{"A", "B", "C"})
+
+	record, err := v.Vote(schulze.Ballot[string]{"A": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"X": 1}); err == nil {
+		t.Fatal("expected unknown choice error")
+	}
+	if err := v.Unvote(record); err != nil {
+		t.Fatal(err)
+	}
+	if results, _, _ := v.Compute(); results[0].Choice != "B" {
+		t.Fatalf("got winner %v, want %v", results[0].Choice, "B")
+	}
+	v.SetChoices([]string{"A", "B", "C", "D"})
+
+	if _, err := other.Vote(schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if got, want := rec.Header().Get("Content-Type"), "text/plain; version=0.0.4; charset=utf-8"; got != want {
+		t.Errorf("got content type %q, want %q", got, want)
+	}
+	body, err := io.ReadAll(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(body)
+
+	for _, want := range []string{
+		"# TYPE schulze_ballots_total counter\n",
+		`schulze_ballots_total{poll="poll \"1\""} 2` + "\n",
+		`schulze_ballots_total{poll="poll 2"} 1` + "\n",
+		`schulze_unvotes_total{poll="poll \"1\""} 1` + "\n",
+		`schulze_unvotes_total{poll="poll 2"} 0` + "\n",
+		"# TYPE schulze_compute_duration_seconds histogram\n",
+		`schulze_compute_duration_seconds_bucket{poll="poll \"1\"",le="1"} 1` + "\n",
+		`schulze_compute_duration_seconds_bucket{poll="poll \"1\"",le="5"} 1` + "\n",
+		`schulze_compute_duration_seconds_bucket{poll="poll \"1\"",le="+Inf"} 1` + "\n",
+		`schulze_compute_duration_seconds_count{poll="poll \"1\""} 1` + "\n",
+		`schulze_compute_duration_seconds_count{poll="poll 2"} 0` + "\n",
+		`schulze_choices{poll="poll \"1\""} 4` + "\n",
+		`schulze_choices{poll="poll 2"} 3` + "\n",
+		`schulze_last_winner_change_timestamp_seconds{poll="poll 2"} 0` + "\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `schulze_last_winner_change_timestamp_seconds{poll="poll \"1\""} 0`+"\n") {
+		t.Errorf("winner change timestamp not set:\n%s", got)
+	}
+
+	r.Unregister("poll 2")
+
+	var b strings.Builder
+	if _, err := r.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(b.String(), "poll 2") {
+		t.Errorf("unregistered poll metrics written:\n%s", b.String())
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// Nomination is a proposal of a choice to be added to the ballot, together
+// with voters that seconded it.
+type Nomination[V, C comparable] struct {
+	Choice    C
+	Nominator V
+	// Voters that seconded the nomination, in the order of seconding.
+	Seconds []V
+}
+
+// Nominate proposes a choice to be added to the ballot in the nomination
+// phase, before the election opens. Nominations that are seconded by at
+// least the NominationThreshold number of voters from the election
+// configuration are added to the choices when the election opens, in the
+// order of nomination, and the others are discarded. The choices are added by
+// the first vote, or any other change of ballots, after the opening time.
+// ErrNominationsClosed is returned after the election opens or if it does not
+// have the opening time.
+func (e *Election[V, C]) Nominate(nominator V, c C) error {
+	if err := e.checkNominations(); err != nil {
+		return err
+	}
+	if getChoiceIndex(e.voting.choices, c) >= 0 || e.nominationIndex(c) >= 0 {
+		return &DuplicateChoiceError[C]{Choice: c}
+	}
+	e.nominations = append(e.nominations, Nomination[V, C]{
+		Choice:    c,
+		Nominator: nominator,
+	})
+	return nil
+}
+
+// Second adds voter's support to the nomination of the choice. Seconding the
+// same nomination again or by the nominator has no effect.
+// ErrNominationsClosed is returned after the election opens.
+func (e *Election[V, C]) Second(voter V, c C) error {
+	if err := e.checkNominations(); err != nil {
+		return err
+	}
+	i := e.nominationIndex(c)
+	if i < 0 {
+		return &UnknownChoiceError[C]{Choice: c}
+	}
+	n := &e.nominations[i]
+	if n.Nominator == voter {
+		return nil
+	}
+	for _, s := range n.Seconds {
+		if s == voter {
+			return nil
+		}
+	}
+	n.Seconds = append(n.Seconds, voter)
+	return nil
+}
+
+// Nominations returns all nominations that are not yet added to the choices,
+// in the order of nomination.
+func (e *Election[V, C]) Nominations() []Nomination[V, C] {
+	nominations := make([]Nomination[V, C], len(e.nominations))
+	for i, n := range e.nominations {
+		n.Seconds = append([]V(nil), n.Seconds...)
+		nominations[i] = n
+	}
+	return nominations
+}
+
+// checkNominations returns an error if nominations are not accepted.
+func (e *Election[V, C]) checkNominations() error {
+	if e.config.Schedule.Opens == nil || e.Phase() != PhaseNotOpen {
+		return ErrNominationsClosed
+	}
+	return nil
+}
+
+func (e *Election[V, C]) nominationIndex(c C) int {
+	for i, n := range e.nominations {
+		if n.Choice == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// admitNominations adds the choices of nominations that reached the threshold
+// to the choices of the election and discards all nominations. It must be
+// called only after the election opens.
+func (e *Election[V, C]) admitNominations() {
+	if len(e.nominations) == 0 {
+		return
+	}
+	choices := append(make([]C, 0, len(e.voting.choices)+len(e.nominations)), e.voting.choices...)
+	var admitted []string
+	for _, n := range e.nominations {
+		if len(n.Seconds) < e.config.NominationThreshold {
+			continue
+		}
+		choices = append(choices, n.Choice)
+		admitted = append(admitted, fmt.Sprint(n.Choice))
+	}
+	e.nominations = nil
+	if len(admitted) == 0 {
+		return
+	}
+	e.voting.SetChoices(choices)
+	e.addAuditEntry(AuditEntry{
+		Action:  AuditAdmitNominations,
+		Choices: admitted,
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_nominations(t *testing.T) {
+	opens := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:             []string{"A"},
+		NominationThreshold: 2,
+		Schedule: schulze.Schedule{
+			Opens: &opens,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := opens.Add(-time.Hour)
+	e.SetNow(func() time.Time { return now })
+
+	for _, n := range []struct{ voter, choice string }{
+		{voter: "alice", choice: "B"},
+		{voter: "bob", choice: "C"},
+		{voter: "carol", choice: "D"},
+	} {
+		if err := e.Nominate(n.voter, n.choice); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var derr *schulze.DuplicateChoiceError[string]
+	if err := e.Nominate("dave", "A"); !errors.As(err, &derr) {
+		t.Errorf("got error %v, want DuplicateChoiceError", err)
+	}
+	if err := e.Nominate("dave", "B"); !errors.As(err, &derr) {
+		t.Errorf("got error %v, want DuplicateChoiceError", err)
+	}
+	var uerr *schulze.UnknownChoiceError[string]
+	if err := e.Second("dave", "X"); !errors.As(err, &uerr) {
+		t.Errorf("got error %v, want UnknownChoiceError", err)
+	}
+
+	for _, s := range []struct{ voter, choice string }{
+		{voter: "bob", choice: "B"},
+		{voter: "carol", choice: "B"},
+		// seconding again or by the nominator has no effect
+		{voter: "carol", choice: "B"},
+		{voter: "bob", choice: "C"},
+		{voter: "alice", choice: "C"},
+		{voter: "alice", choice: "D"},
+		{voter: "bob", choice: "D"},
+	} {
+		if err := e.Second(s.voter, s.choice); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []schulze.Nomination[string, string]{
+		{Choice: "B", Nominator: "alice", Seconds: []string{"bob", "carol"}},
+		{Choice: "C", Nominator: "bob", Seconds: []string{"alice"}},
+		{Choice: "D", Nominator: "carol", Seconds: []string{"alice", "bob"}},
+	}
+	nominations := e.Nominations()
+	if !reflect.DeepEqual(nominations, want) {
+		t.Errorf("got nominations %+v, want %+v", nominations, want)
+	}
+	nominations[0].Seconds[0] = "mallory"
+	if got := e.Nominations()[0].Seconds[0]; got != "bob" {
+		t.Errorf("nominations mutated through the returned value, got second %v", got)
+	}
+
+	if got := e.Choices(); !reflect.DeepEqual(got, []string{"A"}) {
+		t.Errorf("got choices %v before the election opens", got)
+	}
+
+	now = opens
+
+	if err := e.Nominate("dave", "E"); !errors.Is(err, schulze.ErrNominationsClosed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNominationsClosed)
+	}
+	if err := e.Second("dave", "C"); !errors.Is(err, schulze.ErrNominationsClosed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNominationsClosed)
+	}
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"D": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.Choices(), []string{"A", "B", "D"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got choices %v, want %v", got, want)
+	}
+	if got := e.Nominations(); len(got) != 0 {
+		t.Errorf("got nominations %+v after the election opened", got)
+	}
+
+	log := e.AuditLog()
+	if len(log) != 1 {
+		t.Fatalf("got %v audit entries, want %v", len(log), 1)
+	}
+	if log[0].Action != schulze.AuditAdmitNominations || !reflect.DeepEqual(log[0].Choices, []string{"B", "D"}) {
+		t.Errorf("got audit entry %+v", log[0])
+	}
+
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "D" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "D")
+	}
+}
+
+func TestElection_nominationsWithoutOpeningTime(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A"})
+
+	if err := e.Nominate("alice", "B"); !errors.Is(err, schulze.ErrNominationsClosed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNominationsClosed)
+	}
+}
@@ -0,0 +1,40 @@
+# Reference loader of election bundles written by the
+# resenje.org/schulze/notebook Go package.
+
+import json
+
+BUNDLE_VERSION = 1
+
+
+def load_bundle(path):
+    """Returns the bundle decoded from the JSON file."""
+    with open(path, encoding="utf-8") as f:
+        bundle = json.load(f)
+    if bundle.get("bundleVersion") != BUNDLE_VERSION:
+        raise ValueError("unsupported bundle version %r" % bundle.get("bundleVersion"))
+    return bundle
+
+
+def preferences_frame(bundle):
+    """Returns the pairwise preferences as a pandas DataFrame, where the value
+    in the row of one choice and the column of another one is the number of
+    ballots that prefer the former choice over the latter."""
+    import pandas as pd
+
+    d = bundle["document"]
+    choices = [str(c) for c in d["choices"]]
+    return pd.DataFrame(d["preferences"], index=choices, columns=choices)
+
+
+def ballots_frame(bundle):
+    """Returns the ballots as a pandas DataFrame in the long format, with
+    columns ballot, choice and rank, where rank is None for unranked
+    choices."""
+    import pandas as pd
+
+    rows = []
+    for i, b in enumerate(bundle["ballots"]):
+        for rank, group in enumerate(b["ranking"], start=1):
+            rows.extend((i, str(c), rank) for c in group)
+        rows.extend((i, str(c), None) for c in b.get("unranked", []))
+    return pd.DataFrame(rows, columns=["ballot", "choice", "rank"])
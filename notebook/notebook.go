@@ -0,0 +1,109 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package notebook exports elections as self-contained JSON bundles with the
+// ballots, the pairwise matrices, the results and the method parameters, to
+// load election data into R and Python analysis notebooks reproducibly.
+//
+// The results are included as the schulze.ResultDocument, and ballots
+// without voter identities in the order they were cast. Reference loaders
+// that decode bundles into pandas data frames and R matrices are provided in
+// the PythonLoader and RLoader variables, and they can be saved next to the
+// notebook.
+package notebook
+
+import (
+	_ "embed"
+	"encoding/json"
+	"io"
+
+	"resenje.org/schulze"
+)
+
+// BundleVersion is the version of the Bundle JSON schema, checked by the
+// reference loaders.
+const BundleVersion = 1
+
+// PythonLoader is the source of the Python module with functions that load
+// the bundle and convert it to pandas data frames.
+//
+//go:embed loader.py
+var PythonLoader string
+
+// RLoader is the source of the R script with functions that load the bundle
+// with jsonlite and convert it to a matrix and a data frame.
+//
+//go:embed loader.R
+var RLoader string
+
+// Bundle is the self-contained representation of the election.
+type Bundle[C comparable] struct {
+	// Version of the bundle schema, BundleVersion.
+	BundleVersion int `json:"bundleVersion"`
+	// Results with the choices, matrices and the method.
+	Document schulze.ResultDocument[C] `json:"document"`
+	// Seed of the random tie-break, required to reproduce the results.
+	RandomSeed int64 `json:"randomSeed"`
+	// Ballots in the order they were cast.
+	Ballots []Ballot[C] `json:"ballots"`
+}
+
+// Ballot is a single ballot of the Bundle.
+type Ballot[C comparable] struct {
+	// Groups of choices with the same rank, starting with the most preferred.
+	Ranking [][]C `json:"ranking"`
+	// Choices that are not ranked.
+	Unranked []C          `json:"unranked,omitempty"`
+	Tags     schulze.Tags `json:"tags,omitempty"`
+}
+
+// NewBundle returns the Bundle of the current state of the election.
+// schulze.ErrSealed is returned if the results of the election are sealed.
+func NewBundle[V, C comparable](e *schulze.Election[V, C]) (*Bundle[C], error) {
+	d, err := e.ResultDocument()
+	if err != nil {
+		return nil, err
+	}
+	s := e.Snapshot()
+	b := &Bundle[C]{
+		BundleVersion: BundleVersion,
+		Document:      d,
+		RandomSeed:    s.Config.RandomSeed,
+		Ballots:       make([]Ballot[C], 0, len(s.Ballots)),
+	}
+	for _, sb := range s.Ballots {
+		ballot := Ballot[C]{
+			Ranking: make([][]C, 0, len(sb.Record)),
+		}
+		if len(sb.Tags) > 0 {
+			ballot.Tags = sb.Tags
+		}
+		for i, choices := range sb.Record {
+			if i < len(sb.Record)-1 {
+				ballot.Ranking = append(ballot.Ranking, choices)
+			} else if len(choices) > 0 {
+				ballot.Unranked = choices
+			}
+		}
+		b.Ballots = append(b.Ballots, ballot)
+	}
+	return b, nil
+}
+
+// Write writes the bundle as indented JSON.
+func Write[C comparable](w io.Writer, b *Bundle[C]) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(b)
+}
+
+// Read decodes the bundle from JSON.
+func Read[C comparable](r io.Reader) (*Bundle[C], error) {
+	var b Bundle[C]
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package notebook_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/notebook"
+)
+
+func TestBundle(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:       "board",
+		Choices:    []string{"A", "B", "C"},
+		TieBreak:   schulze.TieBreakRandom,
+		RandomSeed: 42,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteTagged("alice", schulze.Ballot[string]{"B": 1, "A": 2, "C": 3}, schulze.Tags{"region": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := notebook.NewBundle(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.BundleVersion != notebook.BundleVersion || b.RandomSeed != 42 {
+		t.Errorf("got bundle version %v and random seed %v", b.BundleVersion, b.RandomSeed)
+	}
+	if b.Document.Name != "board" || b.Document.Ranking[0].Choice != "B" {
+		t.Errorf("got document %+v", b.Document)
+	}
+	if len(b.Ballots) != 2 {
+		t.Fatalf("got %v ballots, want 2", len(b.Ballots))
+	}
+	for _, ballot := range b.Ballots {
+		if len(ballot.Tags) > 0 {
+			if want := [][]string{{"B"}, {"A"}, {"C"}}; !reflect.DeepEqual(ballot.Ranking, want) {
+				t.Errorf("got ranking %v, want %v", ballot.Ranking, want)
+			}
+			continue
+		}
+		if len(ballot.Ranking) != 1 || len(ballot.Unranked) != 2 {
+			t.Errorf("got ballot %+v", ballot)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := notebook.Write(&buf, b); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := notebook.Read[string](&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Ballots, b.Ballots) || !reflect.DeepEqual(decoded.Document.Preferences, b.Document.Preferences) {
+		t.Errorf("got decoded bundle %+v, want %+v", decoded, b)
+	}
+
+	if !strings.Contains(notebook.PythonLoader, "def load_bundle") || !strings.Contains(notebook.RLoader, "load_bundle <- function") {
+		t.Error("loaders are not embedded")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "time"
+
+// EventType identifies the kind of the Event.
+type EventType string
+
+// Types of events delivered to a Notifier.
+const (
+	// EventAnomaly is delivered when an AnomalyMonitor detects an anomalous
+	// change of pairwise preferences, with the Anomaly as the event data.
+	EventAnomaly EventType = "anomaly"
+	// EventOpened is delivered when the election opens by its schedule.
+	EventOpened EventType = "opened"
+	// EventClosed is delivered when the election closes by its schedule.
+	EventClosed EventType = "closed"
+	// EventWinnerChanged is delivered when the winners of the election
+	// change, with the WinnerChange as the event data.
+	EventWinnerChanged EventType = "winner-changed"
+	// EventCertified is delivered when the election results are certified,
+	// with the Certificate as the event data.
+	EventCertified EventType = "certified"
+)
+
+// Event is a notification about a change of a voting or an election that
+// requires attention of operators.
+type Event struct {
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+	// Name of the election from its configuration, if the event is about an
+	// election.
+	Election string `json:"election,omitempty"`
+	Message  string `json:"message"`
+	// Data with the details of the event that depends on its type.
+	Data any `json:"data,omitempty"`
+}
+
+// Notifier delivers events, for example to operators by email or chat, or
+// to an incident management system.
+type Notifier interface {
+	Notify(Event)
+}
+
+// NotifierFunc is an adapter to use ordinary functions as Notifier.
+type NotifierFunc func(Event)
+
+// Notify calls f(e).
+func (f NotifierFunc) Notify(e Event) {
+	f(e)
+}
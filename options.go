@@ -0,0 +1,92 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "math/rand"
+
+// Option configures optional behavior of the Compute function and the Voting
+// type.
+type Option[C comparable] func(*options[C])
+
+type options[C comparable] struct {
+	collation            func(a, b C) int
+	pathStrengthComputer PathStrengthComputer
+	progress             func(done, total int)
+	resultsCacheSize     int
+	incremental          bool
+	strengthVariant      StrengthVariant
+	tieBreak             TieBreak
+	randomSeed           int64
+	randSource           rand.Source
+	dualRun              bool
+	onDivergence         func(err *DivergenceError)
+	tracer               Tracer
+	descendingRanks      bool
+	choicesCapacity      int
+	strengthsLayout      StrengthsLayout
+}
+
+func newOptions[C comparable](opts []Option[C]) options[C] {
+	var o options[C]
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithCollation sets the function that orders choices of equal standing, with
+// the same number of wins and strength, in the results. The function must
+// return a negative number if a should be displayed before b, a positive
+// number if b should be displayed before a, and zero if their order is not
+// relevant, in which case they are ordered by their indexes. By default,
+// choices of equal standing are ordered by their indexes.
+//
+// For locale-aware ordering of string choices, the CompareString method of
+// the golang.org/x/text/collate Collator can be used.
+func WithCollation[C comparable](collation func(a, b C) int) Option[C] {
+	return func(o *options[C]) {
+		o.collation = collation
+	}
+}
+
+func withProgress[C comparable](progress func(done, total int)) Option[C] {
+	return func(o *options[C]) {
+		o.progress = progress
+	}
+}
+
+// WithRandomSeed sets the seed for features that use randomness, such as the
+// TieBreakRandom tie-break, so that the same seed always produces the same
+// results. Every use of randomness starts from a new source with the seed.
+// The default seed is zero.
+func WithRandomSeed[C comparable](seed int64) Option[C] {
+	return func(o *options[C]) {
+		o.randomSeed = seed
+		o.randSource = nil
+	}
+}
+
+// WithRandSource sets the random source for all features that use
+// randomness, such as the TieBreakRandom tie-break and NoisyPreferences
+// methods, instead of the seed set by WithRandomSeed. The source is shared by
+// all uses of randomness, so it must not be used concurrently. Whole election
+// runs are reproducible if the source is reproducible, which also makes the
+// noise of NoisyPreferences predictable, so such sources should be used with
+// it only for audits and tests.
+func WithRandSource[C comparable](src rand.Source) Option[C] {
+	return func(o *options[C]) {
+		o.randSource = src
+	}
+}
+
+// rand returns the random generator configured by WithRandSource or
+// WithRandomSeed options.
+func (o options[C]) rand() *rand.Rand {
+	if o.randSource != nil {
+		return rand.New(o.randSource)
+	}
+	return rand.New(rand.NewSource(o.randomSeed))
+}
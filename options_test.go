@@ -0,0 +1,113 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestWithCollation(t *testing.T) {
+	choices := []string{"d", "B", "c", "a"}
+	ballots := []schulze.Ballot[string]{
+		{"B": 1, "c": 1, "a": 1},
+	}
+	collation := func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+	want := []string{"a", "B", "c", "d"}
+
+	choiceValues := func(results []schulze.Result[string]) []string {
+		values := make([]string, 0, len(results))
+		for _, r := range results {
+			values = append(values, r.Choice)
+		}
+		return values
+	}
+
+	t.Run("functional", func(t *testing.T) {
+		preferences := schulze.NewPreferences(len(choices))
+		for _, b := range ballots {
+			if _, err := schulze.Vote(preferences, choices, b); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		results, _, tie := schulze.Compute(preferences, choices)
+		if got := choiceValues(results); !reflect.DeepEqual(got, []string{"B", "c", "a", "d"}) {
+			t.Errorf("got default order %v", got)
+		}
+
+		results, _, collatedTie := schulze.Compute(preferences, choices, schulze.WithCollation(collation))
+		if got := choiceValues(results); !reflect.DeepEqual(got, want) {
+			t.Errorf("got order %v, want %v", got, want)
+		}
+		if tie != collatedTie {
+			t.Errorf("got tie %v, want %v", collatedTie, tie)
+		}
+	})
+	t.Run("Voting", func(t *testing.T) {
+		v := schulze.NewVoting(choices, schulze.WithCollation(collation))
+		for _, b := range ballots {
+			if _, err := v.Vote(b); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		results, _, _ := v.Compute()
+		if got := choiceValues(results); !reflect.DeepEqual(got, want) {
+			t.Errorf("got order %v, want %v", got, want)
+		}
+	})
+}
+
+func TestWithRandSource(t *testing.T) {
+	choices := newChoices(20)
+	// all choices are tied
+	preferences := schulze.NewPreferences(len(choices))
+
+	run := func(seed int64) (results [][]schulze.Result[string], noisy [][]int) {
+		src := rand.NewSource(seed)
+		v := schulze.NewVoting(choices,
+			schulze.WithTieBreak[string](schulze.TieBreakRandom),
+			schulze.WithRandSource[string](src),
+		)
+		for i := 0; i < 3; i++ {
+			r, _, tie := schulze.Compute(preferences, choices,
+				schulze.WithTieBreak[string](schulze.TieBreakRandom),
+				schulze.WithRandSource[string](src),
+			)
+			if tie {
+				t.Fatal("got tie with random tie-break")
+			}
+			results = append(results, r)
+
+			n, err := v.NoisyPreferences(1, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			noisy = append(noisy, n)
+		}
+		return results, noisy
+	}
+
+	results1, noisy1 := run(3)
+	results2, noisy2 := run(3)
+	if !reflect.DeepEqual(results1, results2) {
+		t.Error("got different results for the same source seed")
+	}
+	if !reflect.DeepEqual(noisy1, noisy2) {
+		t.Error("got different noisy preferences for the same source seed")
+	}
+	// the shared source advances between computations
+	if reflect.DeepEqual(results1[0], results1[1]) {
+		t.Error("got the same results from the advanced source")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "math"
+
+// intFromInt64 converts the value to int, returning false if it does not fit
+// in int on the current platform.
+func intFromInt64(v int64) (int, bool) {
+	if v < math.MinInt || v > math.MaxInt {
+		return 0, false
+	}
+	return int(v), true
+}
+
+// addInts returns the sum of the values and false if it overflows int.
+func addInts(a, b int) (int, bool) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, false
+	}
+	return c, true
+}
+
+// hasWrappedPreferences returns true if any of the preferences wrapped to the
+// minimal int value, as preferences are incremented by one and they can not
+// reach it otherwise.
+func hasWrappedPreferences(preferences []int) bool {
+	for _, p := range preferences {
+		if p == math.MinInt {
+			return true
+		}
+	}
+	return false
+}
+
+// hasWrappedPreferences64 returns true if any of the preferences wrapped to
+// the minimal int64 value, just as hasWrappedPreferences does for int values.
+func hasWrappedPreferences64(preferences []int64) bool {
+	for _, p := range preferences {
+		if p == math.MinInt64 {
+			return true
+		}
+	}
+	return false
+}
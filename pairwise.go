@@ -0,0 +1,133 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// ImportPairwise validates and converts a pairwise tally matrix, produced by
+// another system, to preferences that can be used by the Compute function.
+// Element matrix[i][j] is the number of ballots that prefer the choice with
+// index i over the choice with index j. The matrix must be square, with the
+// number of rows equal to the number of choices, and with no negative values.
+//
+// Diagonal values represent the number of ballots that ranked the choice over
+// the unranked choices, which is used only when choices are added with
+// SetChoices, and can be zero if such information is not available.
+func ImportPairwise[C comparable](matrix [][]int, choices []C) ([]int, error) {
+	choicesCount := len(choices)
+	if len(matrix) != choicesCount {
+		return nil, fmt.Errorf("%w: got %v rows for %v choices", ErrInvalidPairwiseMatrix, len(matrix), choicesCount)
+	}
+	preferences := NewPreferences(choicesCount)
+	for i, row := range matrix {
+		if len(row) != choicesCount {
+			return nil, fmt.Errorf("%w: got %v columns in row %v for %v choices", ErrInvalidPairwiseMatrix, len(row), i, choicesCount)
+		}
+		for j, v := range row {
+			if v < 0 {
+				return nil, fmt.Errorf("%w: negative value %v in row %v column %v", ErrInvalidPairwiseMatrix, v, i, j)
+			}
+			preferences[i*choicesCount+j] = v
+		}
+	}
+	return preferences, nil
+}
+
+// ImportPairwise replaces the voting preferences with a pairwise tally matrix
+// for the current choices, validated in the same way as the ImportPairwise
+// function does.
+func (v *Voting[C]) ImportPairwise(matrix [][]int) error {
+	preferences, err := ImportPairwise(matrix, v.choices)
+	if err != nil {
+		return err
+	}
+	v.invalidate()
+	v.preferences = preferences
+	return nil
+}
+
+// ClonePreferences returns a copy of the preferences.
+func ClonePreferences(preferences []int) []int {
+	if preferences == nil {
+		return nil
+	}
+	c := make([]int, len(preferences))
+	copy(c, preferences)
+	return c
+}
+
+// MergePreferences returns new preferences with summed values of all provided
+// preferences, for example tallies of different shards of the same voting.
+// All preferences must be for the same choices in the same order.
+// ErrTallyOverflow is returned if a sum does not fit in int.
+func MergePreferences(preferences ...[]int) ([]int, error) {
+	if len(preferences) == 0 {
+		return nil, nil
+	}
+	if err := validatePreferencesLength(preferences[0]); err != nil {
+		return nil, err
+	}
+	merged := ClonePreferences(preferences[0])
+	for _, p := range preferences[1:] {
+		if len(p) != len(merged) {
+			return nil, fmt.Errorf("%w: got length %v, want %v", ErrPreferencesLengthMismatch, len(p), len(merged))
+		}
+		for i, v := range p {
+			sum, ok := addInts(merged[i], v)
+			if !ok {
+				return nil, fmt.Errorf("%w: preferences at index %v", ErrTallyOverflow, i)
+			}
+			merged[i] = sum
+		}
+	}
+	return merged, nil
+}
+
+// SubtractPreferences returns new preferences with values of preferences b
+// subtracted from preferences a, for example to remove the tally of expired
+// ballots from the all-time tally. Both preferences must be for the same
+// choices in the same order and b must not contain more votes than a for any
+// pair of choices.
+func SubtractPreferences(a, b []int) ([]int, error) {
+	if err := validatePreferencesLength(a); err != nil {
+		return nil, err
+	}
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("%w: got length %v, want %v", ErrPreferencesLengthMismatch, len(b), len(a))
+	}
+	choicesCount := intSqrt(len(a))
+	r := make([]int, len(a))
+	for i, v := range a {
+		r[i] = v - b[i]
+		if r[i] < 0 {
+			return nil, fmt.Errorf("%w: negative value %v in row %v column %v", ErrInvalidPairwiseMatrix, r[i], i/choicesCount, i%choicesCount)
+		}
+	}
+	return r, nil
+}
+
+func validatePreferencesLength(preferences []int) error {
+	l := len(preferences)
+	if s := intSqrt(l); s*s != l {
+		return fmt.Errorf("%w: length %v is not a square of the number of choices", ErrInvalidPairwiseMatrix, l)
+	}
+	return nil
+}
+
+// intSqrt returns the floor of the square root of a non-negative integer.
+func intSqrt(x int) int {
+	if x < 2 {
+		return x
+	}
+	r := x
+	for {
+		n := (r + x/r) / 2
+		if n >= r {
+			return r
+		}
+		r = n
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestImportPairwise(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+
+	v := schulze.NewVoting(choices)
+	for _, b := range randomBallots(t, choices, 100) {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantResults, _, wantTie := v.Compute()
+
+	m := matrix(v.Preferences())
+
+	t.Run("functional", func(t *testing.T) {
+		preferences, err := schulze.ImportPairwise(m, choices)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results, _, tie := schulze.Compute(preferences, choices)
+		if tie != wantTie {
+			t.Errorf("got tie %v, want %v", tie, wantTie)
+		}
+		if !reflect.DeepEqual(results, wantResults) {
+			t.Errorf("got results %+v, want %+v", results, wantResults)
+		}
+	})
+
+	t.Run("Voting", func(t *testing.T) {
+		imported := schulze.NewVoting(choices)
+		if err := imported.ImportPairwise(m); err != nil {
+			t.Fatal(err)
+		}
+		results, _, tie := imported.Compute()
+		if tie != wantTie {
+			t.Errorf("got tie %v, want %v", tie, wantTie)
+		}
+		if !reflect.DeepEqual(results, wantResults) {
+			t.Errorf("got results %+v, want %+v", results, wantResults)
+		}
+	})
+}
+
+func TestImportPairwise_invalid(t *testing.T) {
+	choices := []string{"A", "B"}
+
+	for _, tc := range []struct {
+		name   string
+		matrix [][]int
+	}{
+		{name: "nil", matrix: nil},
+		{name: "too many rows", matrix: [][]int{{0, 1}, {1, 0}, {0, 0}}},
+		{name: "too few columns", matrix: [][]int{{0, 1}, {1}}},
+		{name: "negative value", matrix: [][]int{{0, -1}, {1, 0}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := schulze.ImportPairwise(tc.matrix, choices); !errors.Is(err, schulze.ErrInvalidPairwiseMatrix) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidPairwiseMatrix)
+			}
+			v := schulze.NewVoting(choices)
+			if err := v.ImportPairwise(tc.matrix); !errors.Is(err, schulze.ErrInvalidPairwiseMatrix) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidPairwiseMatrix)
+			}
+		})
+	}
+}
+
+func TestMergePreferences(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	ballots := randomBallots(t, choices, 100)
+
+	all := schulze.NewPreferences(len(choices))
+	shards := [][]int{
+		schulze.NewPreferences(len(choices)),
+		schulze.NewPreferences(len(choices)),
+		schulze.NewPreferences(len(choices)),
+	}
+	for i, b := range ballots {
+		if _, err := schulze.Vote(all, choices, b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := schulze.Vote(shards[i%len(shards)], choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	merged, err := schulze.MergePreferences(shards...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(merged, all) {
+		t.Errorf("got merged preferences\n%s\nwant\n%s", sprintPreferences(choices, merged), sprintPreferences(choices, all))
+	}
+
+	// windowed tally
+	window, err := schulze.SubtractPreferences(all, shards[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := schulze.MergePreferences(shards[1:]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(window, want) {
+		t.Errorf("got subtracted preferences\n%s\nwant\n%s", sprintPreferences(choices, window), sprintPreferences(choices, want))
+	}
+
+	clone := schulze.ClonePreferences(all)
+	clone[1]++
+	if reflect.DeepEqual(clone, all) {
+		t.Error("clone shares values with the original preferences")
+	}
+}
+
+func TestMergePreferences_invalid(t *testing.T) {
+	if _, err := schulze.MergePreferences([]int{1, 2, 3}); !errors.Is(err, schulze.ErrInvalidPairwiseMatrix) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidPairwiseMatrix)
+	}
+	if _, err := schulze.MergePreferences([]int{1, 2, 3, 4}, []int{1}); !errors.Is(err, schulze.ErrPreferencesLengthMismatch) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrPreferencesLengthMismatch)
+	}
+	if _, err := schulze.MergePreferences([]int{1, math.MaxInt, 3, 4}, []int{1, 1, 0, 0}); !errors.Is(err, schulze.ErrTallyOverflow) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrTallyOverflow)
+	}
+	if _, err := schulze.SubtractPreferences([]int{1, 2, 3, 4}, []int{1}); !errors.Is(err, schulze.ErrPreferencesLengthMismatch) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrPreferencesLengthMismatch)
+	}
+	if _, err := schulze.SubtractPreferences([]int{1, 2, 3, 4}, []int{1, 3, 3, 4}); !errors.Is(err, schulze.ErrInvalidPairwiseMatrix) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidPairwiseMatrix)
+	}
+}
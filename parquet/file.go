@@ -0,0 +1,236 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Parquet physical types, repetition types, encodings and other enumerations
+// from the Parquet format specification.
+const (
+	typeInt64     = 2
+	typeByteArray = 6
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+
+	convertedTypeUTF8 = 0
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	codecUncompressed = 0
+
+	pageTypeData = 0
+)
+
+const magic = "PAR1"
+
+// column is a single column of a table with either int64 or string values.
+// Null values of optional columns are marked in the nulls slice and they are
+// not present in the values.
+type column struct {
+	name     string
+	strings  bool
+	optional bool
+	int64s   []int64
+	texts    []string
+	nulls    []bool
+}
+
+func int64Column(name string) *column {
+	return &column{name: name}
+}
+
+func optionalInt64Column(name string) *column {
+	return &column{name: name, optional: true}
+}
+
+func stringColumn(name string) *column {
+	return &column{name: name, strings: true}
+}
+
+func (c *column) appendInt64(v int64) {
+	c.int64s = append(c.int64s, v)
+	if c.optional {
+		c.nulls = append(c.nulls, false)
+	}
+}
+
+func (c *column) appendNull() {
+	c.nulls = append(c.nulls, true)
+}
+
+func (c *column) appendString(v string) {
+	c.texts = append(c.texts, v)
+}
+
+func (c *column) len() int {
+	if c.optional {
+		return len(c.nulls)
+	}
+	if c.strings {
+		return len(c.texts)
+	}
+	return len(c.int64s)
+}
+
+func (c *column) physicalType() int32 {
+	if c.strings {
+		return typeByteArray
+	}
+	return typeInt64
+}
+
+// pageData returns the data of the single data page of the column, with
+// definition levels for optional columns, followed by plainly encoded
+// values.
+func (c *column) pageData() []byte {
+	var data []byte
+	if c.optional {
+		levels := rleBitWidth1(c.nulls)
+		data = binary.LittleEndian.AppendUint32(data, uint32(len(levels)))
+		data = append(data, levels...)
+	}
+	if c.strings {
+		for _, v := range c.texts {
+			data = binary.LittleEndian.AppendUint32(data, uint32(len(v)))
+			data = append(data, v...)
+		}
+	} else {
+		for _, v := range c.int64s {
+			data = binary.LittleEndian.AppendUint64(data, uint64(v))
+		}
+	}
+	return data
+}
+
+// rleBitWidth1 encodes definition levels, one for non-null values and zero
+// for nulls, with the RLE run length encoding of the RLE/bit-packing hybrid
+// encoding with the bit width of one.
+func rleBitWidth1(nulls []bool) []byte {
+	var data []byte
+	for i := 0; i < len(nulls); {
+		j := i + 1
+		for j < len(nulls) && nulls[j] == nulls[i] {
+			j++
+		}
+		data = binary.AppendUvarint(data, uint64(j-i)<<1)
+		if nulls[i] {
+			data = append(data, 0)
+		} else {
+			data = append(data, 1)
+		}
+		i = j
+	}
+	return data
+}
+
+// writeFile writes a Parquet file with a single row group and a single
+// uncompressed data page per column.
+func writeFile(w io.Writer, columns ...*column) error {
+	rows := 0
+	if len(columns) > 0 {
+		rows = columns[0].len()
+	}
+	for _, c := range columns {
+		if c.len() != rows {
+			return fmt.Errorf("parquet: column %s has %v rows, want %v", c.name, c.len(), rows)
+		}
+	}
+
+	file := []byte(magic)
+
+	type chunk struct {
+		offset int64
+		size   int64
+	}
+	chunks := make([]chunk, 0, len(columns))
+	for _, c := range columns {
+		data := c.pageData()
+
+		var h thriftWriter
+		h.structBegin(0)
+		h.i32(1, pageTypeData)
+		h.i32(2, int32(len(data)))
+		h.i32(3, int32(len(data)))
+		h.structBegin(5)
+		h.i32(1, int32(rows))
+		h.i32(2, encodingPlain)
+		h.i32(3, encodingRLE)
+		h.i32(4, encodingRLE)
+		h.structEnd()
+		h.structEnd()
+
+		offset := int64(len(file))
+		file = append(file, h.buf...)
+		file = append(file, data...)
+		chunks = append(chunks, chunk{offset: offset, size: int64(len(file)) - offset})
+	}
+
+	var m thriftWriter
+	m.structBegin(0)
+	m.i32(1, 1)
+	m.listHeader(2, thriftStruct, len(columns)+1)
+	m.structBegin(0)
+	m.string(4, "schema")
+	m.i32(5, int32(len(columns)))
+	m.structEnd()
+	for _, c := range columns {
+		m.structBegin(0)
+		m.i32(1, c.physicalType())
+		if c.optional {
+			m.i32(3, repetitionOptional)
+		} else {
+			m.i32(3, repetitionRequired)
+		}
+		m.string(4, c.name)
+		if c.strings {
+			m.i32(6, convertedTypeUTF8)
+		}
+		m.structEnd()
+	}
+	m.i64(3, int64(rows))
+	m.listHeader(4, thriftStruct, 1)
+	m.structBegin(0)
+	m.listHeader(1, thriftStruct, len(columns))
+	var totalSize int64
+	for i, c := range columns {
+		totalSize += chunks[i].size
+		m.structBegin(0)
+		m.i64(2, chunks[i].offset)
+		m.structBegin(3)
+		m.i32(1, c.physicalType())
+		if c.optional {
+			m.i32List(2, encodingPlain, encodingRLE)
+		} else {
+			m.i32List(2, encodingPlain)
+		}
+		m.stringList(3, c.name)
+		m.i32(4, codecUncompressed)
+		m.i64(5, int64(rows))
+		m.i64(6, chunks[i].size)
+		m.i64(7, chunks[i].size)
+		m.i64(9, chunks[i].offset)
+		m.structEnd()
+		m.structEnd()
+	}
+	m.i64(2, totalSize)
+	m.i64(3, int64(rows))
+	m.structEnd()
+	m.string(6, "resenje.org/schulze/parquet")
+	m.structEnd()
+
+	file = append(file, m.buf...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(m.buf)))
+	file = append(file, magic...)
+
+	_, err := w.Write(file)
+	return err
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package parquet exports ballot records, pairwise matrices and results of
+// the Schulze method as Apache Parquet files, so that elections can be
+// analyzed with tools like pandas, Apache Arrow and DuckDB without custom
+// conversion.
+//
+// Files are written with a single row group and uncompressed, plainly encoded
+// columns, which every Parquet reader supports. Choices and voters are
+// written as UTF-8 strings formatted with the fmt.Sprint function.
+//
+// Only this subset of the Parquet format is written: file metadata version
+// 1, a single version 1 data page per column, INT64 and BYTE_ARRAY values
+// with the UTF8 converted type, required columns and optional INT64 columns
+// with RLE encoded definition levels, without nested columns, dictionaries,
+// statistics or compression. Golden files of every table are kept in the
+// testdata directory and compared byte by byte by the tests. The
+// testdata/check.py script reads them with pyarrow and duckdb to check them
+// against reference readers, which the Go tests do not do, so it has to be
+// run after the golden files change.
+package parquet
+
+import (
+	"fmt"
+	"io"
+
+	"resenje.org/schulze"
+)
+
+// WriteRecords writes the records as a table in the long format, with one row
+// for every choice of every record, and columns:
+//
+//   - ballot: 0-based index of the record in the records slice
+//   - choice: the choice
+//   - rank: 1-based rank of the choice, or null for unranked choices
+func WriteRecords[C comparable](w io.Writer, records []schulze.Record[C]) error {
+	ballots := int64Column("ballot")
+	choices := stringColumn("choice")
+	ranks := optionalInt64Column("rank")
+	for i, r := range records {
+		for rank, group := range r {
+			for _, c := range group {
+				ballots.appendInt64(int64(i))
+				choices.appendString(fmt.Sprint(c))
+				if rank == len(r)-1 {
+					ranks.appendNull()
+				} else {
+					ranks.appendInt64(int64(rank + 1))
+				}
+			}
+		}
+	}
+	return writeFile(w, ballots, choices, ranks)
+}
+
+// WriteMatrix writes the pairwise preferences and the strongest paths
+// strengths, computed with the options, as a table in the long format, with
+// one row for every ordered pair of different choices, and columns:
+//
+//   - from: the choice that is preferred
+//   - to: the choice over which the first one is preferred
+//   - preferences: number of ballots that prefer the from choice
+//   - strength: strength of the strongest path from the from choice
+func WriteMatrix[C comparable](w io.Writer, preferences []int, choices []C, opts ...schulze.Option[C]) error {
+	n := len(choices)
+	if len(preferences) != n*n {
+		return fmt.Errorf("%w: got length %v, want %v", schulze.ErrPreferencesLengthMismatch, len(preferences), n*n)
+	}
+	strengths := schulze.PairwiseStrengths(preferences, choices, opts...)
+
+	from := stringColumn("from")
+	to := stringColumn("to")
+	prefs := int64Column("preferences")
+	strength := int64Column("strength")
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j {
+				continue
+			}
+			from.appendString(fmt.Sprint(choices[i]))
+			to.appendString(fmt.Sprint(choices[j]))
+			prefs.appendInt64(int64(preferences[i*n+j]))
+			strength.appendInt64(int64(strengths[i*n+j]))
+		}
+	}
+	return writeFile(w, from, to, prefs, strength)
+}
+
+// WriteResults writes the results returned by the Compute function as a
+// table with one row for every choice, in the order of the results, and
+// columns position, choice, index, wins, strength and advantage, where
+// position is 1-based position of the choice in the results.
+func WriteResults[C comparable](w io.Writer, results []schulze.Result[C]) error {
+	positions := int64Column("position")
+	choices := stringColumn("choice")
+	indexes := int64Column("index")
+	wins := int64Column("wins")
+	strengths := int64Column("strength")
+	advantages := int64Column("advantage")
+	for i, r := range results {
+		positions.appendInt64(int64(i + 1))
+		choices.appendString(fmt.Sprint(r.Choice))
+		indexes.appendInt64(int64(r.Index))
+		wins.appendInt64(int64(r.Wins))
+		strengths.appendInt64(int64(r.Strength))
+		advantages.appendInt64(int64(r.Advantage))
+	}
+	return writeFile(w, positions, choices, indexes, wins, strengths, advantages)
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+// update rewrites the golden files in testdata, which are checked with
+// reference readers by testdata/check.py.
+var update = flag.Bool("update", false, "update golden files")
+
+func TestWriteRecords(t *testing.T) {
+	records := []schulze.Record[string]{
+		{{"A"}, {"B", "C"}, {}},
+		{{"C"}, {"A", "B"}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteRecords(&buf, records); err != nil {
+		t.Fatal(err)
+	}
+
+	assertGolden(t, "records.parquet", buf.Bytes())
+
+	got := readFile(t, buf.Bytes())
+	want := map[string][]any{
+		"ballot": {int64(0), int64(0), int64(0), int64(1), int64(1), int64(1)},
+		"choice": {"A", "B", "C", "C", "A", "B"},
+		"rank":   {int64(1), int64(2), int64(2), int64(1), nil, nil},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got table %v, want %v", got, want)
+	}
+}
+
+func TestWriteMatrix(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"B": 1, "C": 2},
+		{"A": 1},
+	} {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMatrix(&buf, preferences, choices); err != nil {
+		t.Fatal(err)
+	}
+
+	assertGolden(t, "matrix.parquet", buf.Bytes())
+
+	got := readFile(t, buf.Bytes())
+	want := map[string][]any{
+		"from":        {"A", "A", "B", "B", "C", "C"},
+		"to":          {"B", "C", "A", "C", "A", "B"},
+		"preferences": {int64(2), int64(2), int64(1), int64(2), int64(1), int64(0)},
+		"strength":    {int64(2), int64(2), int64(0), int64(2), int64(0), int64(0)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got table %v, want %v", got, want)
+	}
+}
+
+func TestWriteResults(t *testing.T) {
+	results := []schulze.Result[string]{
+		{Choice: "B", Index: 1, Wins: 1, Strength: 3, Advantage: 2},
+		{Choice: "A", Index: 0, Wins: 0},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteResults(&buf, results); err != nil {
+		t.Fatal(err)
+	}
+
+	assertGolden(t, "results.parquet", buf.Bytes())
+
+	got := readFile(t, buf.Bytes())
+	want := map[string][]any{
+		"position":  {int64(1), int64(2)},
+		"choice":    {"B", "A"},
+		"index":     {int64(1), int64(0)},
+		"wins":      {int64(1), int64(0)},
+		"strength":  {int64(3), int64(0)},
+		"advantage": {int64(2), int64(0)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got table %v, want %v", got, want)
+	}
+}
+
+// assertGolden compares the file with the golden file in testdata, or
+// rewrites the golden file with the -update flag.
+func assertGolden(t *testing.T, name string, data []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("file differs from the golden file %s", path)
+	}
+}
+
+// readFile decodes the Parquet file written by this package into columns of
+// values, validating the file structure and the metadata.
+func readFile(t *testing.T, data []byte) map[string][]any {
+	t.Helper()
+
+	if !bytes.HasPrefix(data, []byte(magic)) || !bytes.HasSuffix(data, []byte(magic)) {
+		t.Fatal("missing magic bytes")
+	}
+	metadataLength := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	metadata := newThriftReader(data[len(data)-8-metadataLength : len(data)-8]).readStruct()
+
+	schema := metadata[2].([]any)
+	rowsCount := metadata[3].(int64)
+	rowGroups := metadata[4].([]any)
+	if len(rowGroups) != 1 {
+		t.Fatalf("got %v row groups, want 1", len(rowGroups))
+	}
+	columns := rowGroups[0].(map[int16]any)[1].([]any)
+	if got := schema[0].(map[int16]any)[5].(int64); int(got) != len(columns) {
+		t.Fatalf("got %v schema children, want %v", got, len(columns))
+	}
+
+	table := make(map[string][]any)
+	for i, c := range columns {
+		element := schema[i+1].(map[int16]any)
+		name := string(element[4].([]byte))
+		optional := element[3].(int64) == repetitionOptional
+
+		meta := c.(map[int16]any)[3].(map[int16]any)
+		if got := meta[5].(int64); got != rowsCount {
+			t.Fatalf("%s: got %v values, want %v", name, got, rowsCount)
+		}
+		offset := meta[9].(int64)
+
+		r := newThriftReader(data[offset:])
+		header := r.readStruct()
+		size := header[3].(int64)
+		page := r.data[r.pos : r.pos+int(size)]
+		if dataPage := header[5].(map[int16]any); dataPage[1].(int64) != rowsCount {
+			t.Fatalf("%s: got %v page values, want %v", name, dataPage[1], rowsCount)
+		}
+
+		defined := make([]bool, rowsCount)
+		for i := range defined {
+			defined[i] = true
+		}
+		if optional {
+			l := int(binary.LittleEndian.Uint32(page))
+			levels := page[4 : 4+l]
+			page = page[4+l:]
+			var pos int
+			for len(levels) > 0 {
+				header, n := binary.Uvarint(levels)
+				count := int(header >> 1)
+				for j := 0; j < count; j++ {
+					defined[pos+j] = levels[n] == 1
+				}
+				pos += count
+				levels = levels[n+1:]
+			}
+		}
+
+		var values []any
+		for _, d := range defined {
+			if !d {
+				values = append(values, nil)
+				continue
+			}
+			switch element[1].(int64) {
+			case typeInt64:
+				values = append(values, int64(binary.LittleEndian.Uint64(page)))
+				page = page[8:]
+			case typeByteArray:
+				l := binary.LittleEndian.Uint32(page)
+				values = append(values, string(page[4:4+l]))
+				page = page[4+l:]
+			}
+		}
+		if len(page) != 0 {
+			t.Fatalf("%s: got %v trailing bytes", name, len(page))
+		}
+		table[name] = values
+	}
+	return table
+}
+
+// thriftReader decodes the Thrift compact protocol structs into maps of field
+// ids to values, for validation of the written files.
+type thriftReader struct {
+	data []byte
+	pos  int
+}
+
+func newThriftReader(data []byte) *thriftReader {
+	return &thriftReader{data: data}
+}
+
+func (r *thriftReader) byte() byte {
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) varint() int64 {
+	v := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *thriftReader) readStruct() map[int16]any {
+	fields := make(map[int16]any)
+	var lastID int16
+	for {
+		b := r.byte()
+		if b == 0 {
+			return fields
+		}
+		typ := b & 0x0f
+		id := lastID + int16(b>>4)
+		if b>>4 == 0 {
+			id = int16(r.varint())
+		}
+		lastID = id
+		fields[id] = r.readValue(typ)
+	}
+}
+
+func (r *thriftReader) readValue(typ byte) any {
+	switch typ {
+	case thriftI32, thriftI64:
+		return r.varint()
+	case thriftBinary:
+		l := int(r.uvarint())
+		v := r.data[r.pos : r.pos+l]
+		r.pos += l
+		return v
+	case thriftList:
+		b := r.byte()
+		size := int(b >> 4)
+		if size == 15 {
+			size = int(r.uvarint())
+		}
+		values := make([]any, 0, size)
+		for i := 0; i < size; i++ {
+			values = append(values, r.readValue(b&0x0f))
+		}
+		return values
+	case thriftStruct:
+		return r.readStruct()
+	}
+	panic(fmt.Sprintf("unsupported thrift type %v", typ))
+}
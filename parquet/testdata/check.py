@@ -0,0 +1,66 @@
+# Checks the golden files written by the resenje.org/schulze/parquet Go
+# package with reference Parquet readers, pyarrow and, if it is installed,
+# duckdb. Run from the parquet directory after the golden files are updated
+# with "go test -update":
+#
+#     python3 testdata/check.py
+
+import os
+import sys
+
+import pyarrow as pa
+import pyarrow.parquet as pq
+
+DIR = os.path.dirname(os.path.abspath(__file__))
+
+WANT = {
+    "records.parquet": {
+        "ballot": (pa.int64(), False, [0, 0, 0, 1, 1, 1]),
+        "choice": (pa.string(), False, ["A", "B", "C", "C", "A", "B"]),
+        "rank": (pa.int64(), True, [1, 2, 2, 1, None, None]),
+    },
+    "matrix.parquet": {
+        "from": (pa.string(), False, ["A", "A", "B", "B", "C", "C"]),
+        "to": (pa.string(), False, ["B", "C", "A", "C", "A", "B"]),
+        "preferences": (pa.int64(), False, [2, 2, 1, 2, 1, 0]),
+        "strength": (pa.int64(), False, [2, 2, 0, 2, 0, 0]),
+    },
+    "results.parquet": {
+        "position": (pa.int64(), False, [1, 2]),
+        "choice": (pa.string(), False, ["B", "A"]),
+        "index": (pa.int64(), False, [1, 0]),
+        "wins": (pa.int64(), False, [1, 0]),
+        "strength": (pa.int64(), False, [3, 0]),
+        "advantage": (pa.int64(), False, [2, 0]),
+    },
+}
+
+
+def check_pyarrow(path, want):
+    table = pq.read_table(path)
+    if table.column_names != list(want):
+        raise AssertionError("%s: got columns %r" % (path, table.column_names))
+    for name, (typ, nullable, values) in want.items():
+        field = table.schema.field(name)
+        if field.type != typ or field.nullable != nullable:
+            raise AssertionError("%s: got field %r" % (path, field))
+        if table.column(name).to_pylist() != values:
+            raise AssertionError("%s: got column %s %r" % (path, name, table.column(name).to_pylist()))
+
+
+def check_duckdb(path, want):
+    try:
+        import duckdb
+    except ImportError:
+        print("duckdb is not installed, skipping", file=sys.stderr)
+        return
+    rows = duckdb.sql("SELECT * FROM read_parquet('%s')" % path).fetchall()
+    if rows != list(zip(*(values for _, _, values in want.values()))):
+        raise AssertionError("%s: got rows %r" % (path, rows))
+
+
+for name, want in WANT.items():
+    path = os.path.join(DIR, name)
+    check_pyarrow(path, want)
+    check_duckdb(path, want)
+    print("ok", name)
@@ -0,0 +1,99 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parquet
+
+import "encoding/binary"
+
+// Thrift compact protocol types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes values with the Thrift compact protocol, which is
+// used by Parquet for page headers and file metadata.
+type thriftWriter struct {
+	buf     []byte
+	lastIDs []int16
+	lastID  int16
+}
+
+func (w *thriftWriter) fieldHeader(id int16, typ byte) {
+	if delta := id - w.lastID; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.varint(int64(id))
+	}
+	w.lastID = id
+}
+
+func (w *thriftWriter) varint(v int64) {
+	w.buf = binary.AppendUvarint(w.buf, uint64((v<<1)^(v>>63)))
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) string(id int16, v string) {
+	w.fieldHeader(id, thriftBinary)
+	w.rawString(v)
+}
+
+func (w *thriftWriter) rawString(v string) {
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *thriftWriter) listHeader(id int16, elemType byte, size int) {
+	w.fieldHeader(id, thriftList)
+	if size < 15 {
+		w.buf = append(w.buf, byte(size)<<4|elemType)
+	} else {
+		w.buf = append(w.buf, 0xf0|elemType)
+		w.buf = binary.AppendUvarint(w.buf, uint64(size))
+	}
+}
+
+func (w *thriftWriter) i32List(id int16, values ...int32) {
+	w.listHeader(id, thriftI32, len(values))
+	for _, v := range values {
+		w.varint(int64(v))
+	}
+}
+
+func (w *thriftWriter) stringList(id int16, values ...string) {
+	w.listHeader(id, thriftBinary, len(values))
+	for _, v := range values {
+		w.rawString(v)
+	}
+}
+
+// structBegin starts a struct that is a field or a list element, with id 0
+// for list elements that have no field header.
+func (w *thriftWriter) structBegin(id int16) {
+	if id > 0 {
+		w.fieldHeader(id, thriftStruct)
+	}
+	w.lastIDs = append(w.lastIDs, w.lastID)
+	w.lastID = 0
+}
+
+func (w *thriftWriter) structEnd() {
+	w.buf = append(w.buf, 0)
+	w.lastID = w.lastIDs[len(w.lastIDs)-1]
+	w.lastIDs = w.lastIDs[:len(w.lastIDs)-1]
+}
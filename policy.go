@@ -0,0 +1,96 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"fmt"
+	"time"
+)
+
+// PolicyEngine decides if a vote is allowed, so that complex eligibility
+// rules can be evaluated by an external policy engine, such as Open Policy
+// Agent, from the JSON encoded PolicyInput.
+type PolicyEngine[V, C comparable] interface {
+	Evaluate(input PolicyInput[V, C]) (PolicyDecision, error)
+}
+
+// PolicyFunc is an adapter to use ordinary functions as PolicyEngine.
+type PolicyFunc[V, C comparable] func(input PolicyInput[V, C]) (PolicyDecision, error)
+
+// Evaluate calls f(input).
+func (f PolicyFunc[V, C]) Evaluate(input PolicyInput[V, C]) (PolicyDecision, error) {
+	return f(input)
+}
+
+// PolicyInput is the vote that the PolicyEngine evaluates.
+type PolicyInput[V, C comparable] struct {
+	Voter  V         `json:"voter"`
+	Ballot Ballot[C] `json:"ballot"`
+	Tags   Tags      `json:"tags,omitempty"`
+	// True if the vote replaces a previous ballot of the voter.
+	Revote   bool              `json:"revote"`
+	Election PolicyElection[C] `json:"election"`
+}
+
+// PolicyElection is the metadata of the election in the PolicyInput.
+type PolicyElection[C comparable] struct {
+	Name        string        `json:"name,omitempty"`
+	Phase       ElectionPhase `json:"phase"`
+	Choices     []C           `json:"choices"`
+	VotersCount int           `json:"votersCount"`
+	Time        time.Time     `json:"time"`
+}
+
+// PolicyDecision is the result of the policy evaluation.
+type PolicyDecision struct {
+	Allow bool `json:"allow"`
+	// Reason of the decision that is returned to the voter when the vote
+	// is denied.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SetPolicyEngine sets the policy engine that is evaluated before every
+// ballot is tallied, after the schedule is checked, including revealed
+// committed ballots, accepted provisional ballots, ballots of imported
+// station batches and ballots cast by proxies, which are evaluated for their
+// principals. Votes that the engine denies are rejected with the
+// PolicyDeniedError and votes are rejected if the evaluation fails. A nil
+// engine allows all votes.
+func (e *Election[V, C]) SetPolicyEngine(p PolicyEngine[V, C]) {
+	e.policy = p
+}
+
+// checkPolicy evaluates the vote with the policy engine.
+func (e *Election[V, C]) checkPolicy(voter V, b Ballot[C], tags Tags) error {
+	if e.policy == nil {
+		return nil
+	}
+	_, revote := e.records[voter]
+	ballot := make(Ballot[C], len(b))
+	for c, rank := range b {
+		ballot[c] = rank
+	}
+	d, err := e.policy.Evaluate(PolicyInput[V, C]{
+		Voter:  voter,
+		Ballot: ballot,
+		Tags:   copyTags(tags),
+		Revote: revote,
+		Election: PolicyElection[C]{
+			Name:        e.config.Name,
+			Phase:       e.Phase(),
+			Choices:     append([]C(nil), e.voting.choices...),
+			VotersCount: e.VotersCount(),
+			Time:        e.now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("schulze: policy evaluation: %w", err)
+	}
+	if !d.Allow {
+		return &PolicyDeniedError{Reason: d.Reason}
+	}
+	return nil
+}
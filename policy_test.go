@@ -0,0 +1,176 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_SetPolicyEngine(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:    "board",
+		Choices: []string{"A", "B", "C"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var inputs []schulze.PolicyInput[string, string]
+	e.SetPolicyEngine(schulze.PolicyFunc[string, string](func(input schulze.PolicyInput[string, string]) (schulze.PolicyDecision, error) {
+		inputs = append(inputs, input)
+		switch {
+		case input.Voter == "mallory":
+			return schulze.PolicyDecision{}, errors.New("engine unavailable")
+		case input.Tags["member"] != "yes":
+			return schulze.PolicyDecision{Reason: "only members can vote"}, nil
+		case input.Revote:
+			return schulze.PolicyDecision{Reason: "votes can not be changed"}, nil
+		}
+		return schulze.PolicyDecision{Allow: true}, nil
+	}))
+
+	member := schulze.Tags{"member": "yes"}
+	if _, err := e.VoteTagged("alice", schulze.Ballot[string]{"A": 1}, member); err != nil {
+		t.Fatal(err)
+	}
+
+	var perr *schulze.PolicyDeniedError
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); !errors.As(err, &perr) || perr.Reason != "only members can vote" {
+		t.Errorf("got error %v, want policy denied error", err)
+	}
+	if _, err := e.VoteTagged("alice", schulze.Ballot[string]{"B": 1}, member); !errors.As(err, &perr) || perr.Reason != "votes can not be changed" {
+		t.Errorf("got error %v, want policy denied error", err)
+	}
+	if _, err := e.VoteTagged("mallory", schulze.Ballot[string]{"B": 1}, member); err == nil || errors.As(err, &perr) {
+		t.Errorf("got error %v, want evaluation error", err)
+	}
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v, want %v", got, 1)
+	}
+
+	data, err := json.Marshal(inputs[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var input map[string]any
+	if err := json.Unmarshal(data, &input); err != nil {
+		t.Fatal(err)
+	}
+	election := input["election"].(map[string]any)
+	if election["name"] != "board" || election["phase"] != "open" || election["votersCount"] != float64(1) {
+		t.Errorf("got election input %v", election)
+	}
+
+	e.SetPolicyEngine(nil)
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestElection_SetPolicyEngine_allBallots(t *testing.T) {
+	choices := []string{"A", "B"}
+	denyMallory := schulze.PolicyFunc[string, string](func(input schulze.PolicyInput[string, string]) (schulze.PolicyDecision, error) {
+		if input.Voter == "mallory" {
+			return schulze.PolicyDecision{Reason: "not eligible"}, nil
+		}
+		return schulze.PolicyDecision{Allow: true}, nil
+	})
+	isDenied := func(err error) bool {
+		var perr *schulze.PolicyDeniedError
+		return errors.As(err, &perr) && perr.Reason == "not eligible"
+	}
+
+	t.Run("reveal", func(t *testing.T) {
+		closes := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+			Choices:      choices,
+			CommitReveal: true,
+			Schedule:     schulze.Schedule{Closes: &closes},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := closes.Add(-time.Hour)
+		e.SetNow(func() time.Time { return now })
+		e.SetPolicyEngine(denyMallory)
+
+		b, salt := schulze.Ballot[string]{"A": 1}, []byte("mallory-salt-val")
+		c, err := schulze.BallotCommitment(choices, b, salt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Commit("mallory", c); err != nil {
+			t.Fatal(err)
+		}
+		now = closes
+		if _, err := e.Reveal("mallory", b, salt); !isDenied(err) {
+			t.Errorf("got error %v, want policy denied error", err)
+		}
+	})
+
+	t.Run("provisional", func(t *testing.T) {
+		e := schulze.NewElection[string](choices)
+		e.SetPolicyEngine(denyMallory)
+
+		if err := e.VoteProvisional("mallory", schulze.Ballot[string]{"A": 1}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := e.AcceptProvisional("mallory"); !isDenied(err) {
+			t.Errorf("got error %v, want policy denied error", err)
+		}
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		e := schulze.NewElection[string](choices)
+		e.SetPolicyEngine(denyMallory)
+
+		s, err := schulze.NewStation[string](e.StationBundle("hall"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Vote("mallory", schulze.Ballot[string]{"A": 1}); err != nil {
+			t.Fatal(err)
+		}
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch, err := s.Batch(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := e.ImportBatch(batch, pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Rejected) != 1 || result.Rejected[0].Voter != "mallory" || !isDenied(result.Rejected[0].Err) {
+			t.Errorf("got import %+v, want denied ballot of mallory", result)
+		}
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		e := schulze.NewElection[string](choices)
+		e.SetPolicyEngine(denyMallory)
+
+		if _, err := e.Vote("bob", schulze.Ballot[string]{"A": 1}); err != nil {
+			t.Fatal(err)
+		}
+		// the ballot of the proxy is evaluated for the principal
+		if err := e.AppointProxy("mallory", "bob", ""); !isDenied(err) {
+			t.Errorf("got error %v, want policy denied error", err)
+		}
+		if _, ok := e.Record("mallory"); ok {
+			t.Error("got denied ballot cast by the proxy")
+		}
+	})
+}
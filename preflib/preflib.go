@@ -0,0 +1,229 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package preflib writes ballot records in the PrefLib data formats, with the
+// metadata header, so that datasets collected with the Schulze method can be
+// contributed to public preference data repositories, such as preflib.org.
+//
+// Orders with ties are written in the .toc format, and strict orders in the
+// .soc format. Both formats require complete orders, so unranked choices of
+// a record are written as tied in the last place. Alternatives are numbered
+// from one in the order of the choices, and they are named with the
+// fmt.Sprint function. Identical orders are written once with the number of
+// voters that cast them, starting with the most frequent order.
+package preflib
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+// Data types of the PrefLib formats.
+const (
+	// TOC is the data type of orders with ties.
+	TOC = "toc"
+	// SOC is the data type of strict orders.
+	SOC = "soc"
+)
+
+// ErrNotStrict is returned by WriteSOC when a record has choices with the
+// same rank.
+var ErrNotStrict = errors.New("preflib: order is not strict")
+
+// Metadata is the header of the PrefLib file. Counts of alternatives,
+// voters and unique orders are written from the records.
+type Metadata struct {
+	// Name of the file in the dataset, such as "00001-00000001.toc".
+	FileName    string
+	Title       string
+	Description string
+	// Type of the modification of the original data, "original" if empty.
+	ModificationType string
+	// Names of the files that the data relates to if it is modified.
+	RelatesTo    string
+	RelatedFiles string
+	// Dates written without the time, or empty if they are zero.
+	PublicationDate  time.Time
+	ModificationDate time.Time
+}
+
+// WriteTOC writes the records in the PrefLib .toc format of complete orders
+// with ties. An error is returned if a record ranks a choice that is not in
+// the choices.
+func WriteTOC[C comparable](w io.Writer, records []schulze.Record[C], choices []C, m Metadata) error {
+	return write(w, TOC, records, choices, m)
+}
+
+// WriteSOC writes the records in the PrefLib .soc format of complete strict
+// orders. ErrNotStrict is returned if a record has choices with the same
+// rank, including more than one unranked choice.
+func WriteSOC[C comparable](w io.Writer, records []schulze.Record[C], choices []C, m Metadata) error {
+	return write(w, SOC, records, choices, m)
+}
+
+// order is a unique order with the number of voters that cast it.
+type order struct {
+	key   string
+	count int
+}
+
+func write[C comparable](w io.Writer, dataType string, records []schulze.Record[C], choices []C, m Metadata) error {
+	alternatives := make(map[C]int, len(choices))
+	for i, c := range choices {
+		alternatives[c] = i + 1
+	}
+
+	var orders []*order
+	index := make(map[string]*order)
+	for i, r := range records {
+		groups, err := recordOrder(r, alternatives)
+		if err != nil {
+			return fmt.Errorf("record %v: %w", i, err)
+		}
+		if dataType == SOC {
+			for _, g := range groups {
+				if len(g) > 1 {
+					return fmt.Errorf("record %v: %w", i, ErrNotStrict)
+				}
+			}
+		}
+		key := formatOrder(groups)
+		o, ok := index[key]
+		if !ok {
+			o = &order{key: key}
+			index[key] = o
+			orders = append(orders, o)
+		}
+		o.count++
+	}
+	sort.SliceStable(orders, func(i, j int) bool {
+		return orders[i].count > orders[j].count
+	})
+
+	bw := bufio.NewWriter(w)
+	modificationType := m.ModificationType
+	if modificationType == "" {
+		modificationType = "original"
+	}
+	header := []struct {
+		name  string
+		value string
+	}{
+		{"FILE NAME", m.FileName},
+		{"TITLE", m.Title},
+		{"DESCRIPTION", m.Description},
+		{"DATA TYPE", dataType},
+		{"MODIFICATION TYPE", modificationType},
+		{"RELATES TO", m.RelatesTo},
+		{"RELATED FILES", m.RelatedFiles},
+		{"PUBLICATION DATE", formatDate(m.PublicationDate)},
+		{"MODIFICATION DATE", formatDate(m.ModificationDate)},
+		{"NUMBER ALTERNATIVES", strconv.Itoa(len(choices))},
+		{"NUMBER VOTERS", strconv.Itoa(len(records))},
+		{"NUMBER UNIQUE ORDERS", strconv.Itoa(len(orders))},
+	}
+	for _, h := range header {
+		writeHeader(bw, h.name, h.value)
+	}
+	for i, c := range choices {
+		writeHeader(bw, "ALTERNATIVE NAME "+strconv.Itoa(i+1), fmt.Sprint(c))
+	}
+	for _, o := range orders {
+		fmt.Fprintf(bw, "%d: %s\n", o.count, o.key)
+	}
+	return bw.Flush()
+}
+
+// recordOrder returns the groups of alternatives of the record, with choices
+// that are not in the record tied in the last group.
+func recordOrder[C comparable](r schulze.Record[C], alternatives map[C]int) ([][]int, error) {
+	seen := make(map[int]struct{}, len(alternatives))
+	groups := make([][]int, 0, len(r)+1)
+	for _, choices := range r {
+		if len(choices) == 0 {
+			continue
+		}
+		group := make([]int, 0, len(choices))
+		for _, c := range choices {
+			a, ok := alternatives[c]
+			if !ok {
+				return nil, fmt.Errorf("preflib: unknown choice %v", c)
+			}
+			if _, ok := seen[a]; ok {
+				continue
+			}
+			seen[a] = struct{}{}
+			group = append(group, a)
+		}
+		if len(group) > 0 {
+			sort.Ints(group)
+			groups = append(groups, group)
+		}
+	}
+	var missing []int
+	for _, a := range alternatives {
+		if _, ok := seen[a]; !ok {
+			missing = append(missing, a)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Ints(missing)
+		groups = append(groups, missing)
+	}
+	return groups, nil
+}
+
+// formatOrder formats the groups of alternatives, enclosing tied
+// alternatives in braces, such as "1,{2,3},4".
+func formatOrder(groups [][]int) string {
+	var b strings.Builder
+	for i, g := range groups {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if len(g) > 1 {
+			b.WriteByte('{')
+		}
+		for j, a := range g {
+			if j > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.Itoa(a))
+		}
+		if len(g) > 1 {
+			b.WriteByte('}')
+		}
+	}
+	return b.String()
+}
+
+func writeHeader(w *bufio.Writer, name, value string) {
+	if value == "" {
+		fmt.Fprintf(w, "# %s:\n", name)
+		return
+	}
+	fmt.Fprintf(w, "# %s: %s\n", name, singleLine(value))
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// singleLine replaces line breaks, which would end the header line, with
+// spaces.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
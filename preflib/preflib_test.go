@@ -0,0 +1,107 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package preflib_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/preflib"
+)
+
+func TestWriteTOC(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	records := []schulze.Record[string]{
+		{{"B"}, {"A"}, {"D", "C"}},
+		{{"C"}, {"A", "B"}, {"D"}, {}},
+		{{"B"}, {"A"}, {"C", "D"}},
+		// choice D is not in the record
+		{{"A"}, {"B"}, {"C"}},
+	}
+
+	var buf bytes.Buffer
+	if err := preflib.WriteTOC(&buf, records, choices, preflib.Metadata{
+		FileName:        "00001-00000001.toc",
+		Title:           "Board",
+		Description:     "Board election\nof 2026",
+		PublicationDate: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	want := `# FILE NAME: 00001-00000001.toc
+# TITLE: Board
+# DESCRIPTION: Board election of 2026
+# DATA TYPE: toc
+# MODIFICATION TYPE: original
+# RELATES TO:
+# RELATED FILES:
+# PUBLICATION DATE: 2026-05-01
+# MODIFICATION DATE:
+# NUMBER ALTERNATIVES: 4
+# NUMBER VOTERS: 4
+# NUMBER UNIQUE ORDERS: 3
+# ALTERNATIVE NAME 1: A
+# ALTERNATIVE NAME 2: B
+# ALTERNATIVE NAME 3: C
+# ALTERNATIVE NAME 4: D
+2: 2,1,{3,4}
+1: 3,{1,2},4
+1: 1,2,3,4
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if err := preflib.WriteTOC(&bytes.Buffer{}, []schulze.Record[string]{{{"E"}}}, choices, preflib.Metadata{}); err == nil {
+		t.Error("expected error for unknown choice")
+	}
+}
+
+func TestWriteSOC(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	var buf bytes.Buffer
+	if err := preflib.WriteSOC(&buf, []schulze.Record[string]{
+		{{"C"}, {"A"}, {"B"}},
+		{{"A"}, {"B"}, {"C"}},
+		{{"A"}, {"B"}, {"C"}, {}},
+	}, choices, preflib.Metadata{
+		ModificationType: "induced",
+		RelatesTo:        "00001-00000001.toc",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	want := `# FILE NAME:
+# TITLE:
+# DESCRIPTION:
+# DATA TYPE: soc
+# MODIFICATION TYPE: induced
+# RELATES TO: 00001-00000001.toc
+# RELATED FILES:
+# PUBLICATION DATE:
+# MODIFICATION DATE:
+# NUMBER ALTERNATIVES: 3
+# NUMBER VOTERS: 3
+# NUMBER UNIQUE ORDERS: 2
+# ALTERNATIVE NAME 1: A
+# ALTERNATIVE NAME 2: B
+# ALTERNATIVE NAME 3: C
+2: 1,2,3
+1: 3,1,2
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	// unranked choices are tied in the last place
+	err := preflib.WriteSOC(&bytes.Buffer{}, []schulze.Record[string]{{{"A"}, {"B", "C"}}}, choices, preflib.Metadata{})
+	if !errors.Is(err, preflib.ErrNotStrict) {
+		t.Errorf("got error %v, want %v", err, preflib.ErrNotStrict)
+	}
+}
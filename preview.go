@@ -0,0 +1,51 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// Preview holds coarse results that are safe to publish while the detailed
+// results, such as wins, strengths and the pairwise preferences, must remain
+// sealed.
+type Preview[C comparable] struct {
+	// Leading places of the results, each with choices that share the same
+	// number of wins, at most the requested number of places.
+	Places [][]C `json:"places"`
+	// True if there are multiple choices in the first place.
+	Tie bool `json:"tie"`
+}
+
+// NewPreview returns a Preview with at most the limit of leading places from
+// the results returned by the Compute function. A limit of one place previews
+// only the winner, or the tied winners.
+func NewPreview[C comparable](results []Result[C], tie bool, limit int) Preview[C] {
+	p := Preview[C]{
+		Places: make([][]C, 0),
+		Tie:    tie,
+	}
+	for i, r := range results {
+		if i == 0 || r.Wins != results[i-1].Wins || (i == 1 && !tie) {
+			if len(p.Places) >= limit {
+				break
+			}
+			p.Places = append(p.Places, nil)
+		}
+		p.Places[len(p.Places)-1] = append(p.Places[len(p.Places)-1], r.Choice)
+	}
+	return p
+}
+
+// Preview computes the results and returns only the coarse Preview with at
+// most the limit of leading places.
+func (v *Voting[C]) Preview(limit int) Preview[C] {
+	v.compute()
+	return NewPreview(v.results, v.tie, limit)
+}
+
+// Preview computes the results and returns only the coarse Preview with at
+// most the limit of leading places. The preview is available even if the
+// results of the election are sealed, as it is intended to be public.
+func (e *Election[V, C]) Preview(limit int) Preview[C] {
+	return e.voting.Preview(limit)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_Preview(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		ballots []schulze.Ballot[string]
+		opts    []schulze.Option[string]
+		limit   int
+		want    string
+	}{
+		{
+			name:    "winner",
+			ballots: []schulze.Ballot[string]{{"A": 1, "B": 2, "C": 3}},
+			limit:   1,
+			want:    `{"places":[["A"]],"tie":false}`,
+		},
+		{
+			name:    "top three",
+			ballots: []schulze.Ballot[string]{{"A": 1, "B": 2, "C": 3}},
+			limit:   3,
+			want:    `{"places":[["A"],["B"],["C"]],"tie":false}`,
+		},
+		{
+			name:    "tied winners",
+			ballots: []schulze.Ballot[string]{{"A": 1, "B": 1}},
+			limit:   1,
+			want:    `{"places":[["A","B"]],"tie":true}`,
+		},
+		{
+			name:    "tied last place",
+			ballots: []schulze.Ballot[string]{{"A": 1}},
+			limit:   3,
+			want:    `{"places":[["A"],["B","C","D"]],"tie":false}`,
+		},
+		{
+			name:    "resolved tie",
+			ballots: []schulze.Ballot[string]{{"A": 1, "B": 1}},
+			opts:    []schulze.Option[string]{schulze.WithTieBreak[string](schulze.TieBreakIndex)},
+			limit:   2,
+			want:    `{"places":[["A"],["B"]],"tie":false}`,
+		},
+		{
+			name:  "no limit",
+			limit: 0,
+			want:  `{"places":[],"tie":true}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := schulze.NewVoting([]string{"A", "B", "C", "D"}, tc.opts...)
+			for _, b := range tc.ballots {
+				if _, err := v.Vote(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+			data, err := json.Marshal(v.Preview(tc.limit))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tc.want {
+				t.Errorf("got preview %s, want %s", data, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// NoisyPreferences returns a copy of the preferences with Laplace noise added
+// to every pairwise preference, calibrated for epsilon-differential privacy
+// of individual ballots, so that running tallies can be published during the
+// voting without revealing the behavior of individual voters in small
+// electorates, while the exact preferences stay private.
+//
+// A single ballot changes at most one of the two preferences of every pair of
+// choices by one, so the noise scale is the number of pairs divided by
+// epsilon. Smaller epsilon values give stronger privacy and more noise.
+// Values are rounded and values less than zero are set to zero. The diagonal
+// values are set to zero as well, so the returned preferences are suitable
+// only for publishing, not for further voting. Every call adds new noise, and
+// publishing multiple noisy versions of the same tally reduces the privacy.
+//
+// The random source must be unpredictable to the readers of the noisy
+// preferences. If it is nil, a source seeded from crypto/rand is used.
+// ErrInvalidEpsilon is returned if epsilon is not a positive number.
+func NoisyPreferences[C comparable](preferences []int, choices []C, epsilon float64, src rand.Source) ([]int, error) {
+	if !(epsilon > 0) || math.IsInf(epsilon, 1) {
+		return nil, ErrInvalidEpsilon
+	}
+	choicesCount := len(choices)
+	if want := choicesCount * choicesCount; len(preferences) != want {
+		return nil, fmt.Errorf("%w: got length %v, want %v", ErrPreferencesLengthMismatch, len(preferences), want)
+	}
+
+	if src == nil {
+		var err error
+		src, err = cryptoSeededSource()
+		if err != nil {
+			return nil, err
+		}
+	}
+	r := rand.New(src)
+	scale := float64(choicesCount*(choicesCount-1)/2) / epsilon
+
+	noisy := make([]int, len(preferences))
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			v := math.Round(float64(preferences[i*choicesCount+j]) + laplace(r, scale))
+			switch {
+			case v >= float64(math.MaxInt):
+				// conversion of values that do not fit in int is platform
+				// dependent
+				noisy[i*choicesCount+j] = math.MaxInt
+			case v > 0:
+				noisy[i*choicesCount+j] = int(v)
+			}
+		}
+	}
+	return noisy, nil
+}
+
+// NoisyPreferences returns a copy of the voting preferences with Laplace
+// noise, just as the NoisyPreferences function does. If the source is nil,
+// the source set by the WithRandSource option is used, and if it is not set, a
+// source seeded from crypto/rand. The WithRandomSeed option is not used, as
+// the seeded noise would be predictable.
+func (v *Voting[C]) NoisyPreferences(epsilon float64, src rand.Source) ([]int, error) {
+	if src == nil {
+		src = v.options.randSource
+	}
+	return NoisyPreferences(v.preferences, v.choices, epsilon, src)
+}
+
+// NoisyPreferences returns a copy of the election preferences with Laplace
+// noise, just as the Voting NoisyPreferences method does. While the results
+// of the election are sealed, ErrSealed is returned, unless the
+// SealedNoiseEpsilon is configured, in which case ErrInvalidEpsilon is
+// returned for a greater epsilon, and the source seeded from crypto/rand is
+// always used, ignoring the passed source and the WithRandSource option, as
+// the noise from a known source can be removed.
+func (e *Election[V, C]) NoisyPreferences(epsilon float64, src rand.Source) ([]int, error) {
+	if err := e.checkSealed(); err != nil {
+		max := e.config.SealedNoiseEpsilon
+		if max == 0 {
+			return nil, err
+		}
+		if epsilon > max {
+			return nil, fmt.Errorf("%w: %v is greater than the maximal epsilon %v of sealed results", ErrInvalidEpsilon, epsilon, max)
+		}
+		return NoisyPreferences(e.voting.preferences, e.voting.choices, epsilon, nil)
+	}
+	return e.voting.NoisyPreferences(epsilon, src)
+}
+
+// cryptoSeededSource returns a new random source with an unpredictable seed.
+func cryptoSeededSource() (rand.Source, error) {
+	var seed [8]byte
+	if _, err := cryptorand.Read(seed[:]); err != nil {
+		return nil, err
+	}
+	return rand.NewSource(int64(binary.BigEndian.Uint64(seed[:]))), nil
+}
+
+// laplace returns a random value from the Laplace distribution centered at
+// zero with the provided scale.
+func laplace(r *rand.Rand, scale float64) float64 {
+	// u is uniformly distributed in the open interval (-0.5, 0.5)
+	u := r.Float64() - 0.5
+	for u == -0.5 {
+		u = r.Float64() - 0.5
+	}
+	if u < 0 {
+		return scale * math.Log(1+2*u)
+	}
+	return -scale * math.Log(1-2*u)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestNoisyPreferences(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	v := schulze.NewVoting(choices)
+	for i := 0; i < 1000; i++ {
+		if _, err := v.Vote(schulze.Ballot[string]{"A": 1, "B": 2}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	preferences := v.Preferences()
+
+	noisy, err := v.NoisyPreferences(1, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := v.NoisyPreferences(1, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(noisy, again) {
+		t.Error("got different noise from the same source seed")
+	}
+
+	if !reflect.DeepEqual(v.Preferences(), preferences) {
+		t.Error("preferences changed")
+	}
+
+	var noised bool
+	for i := range choices {
+		for j := range choices {
+			got, want := noisy[i*len(choices)+j], preferences[i*len(choices)+j]
+			if i == j {
+				if got != 0 {
+					t.Errorf("got diagonal value %v, want 0", got)
+				}
+				continue
+			}
+			if got < 0 {
+				t.Errorf("got negative value %v", got)
+			}
+			if got != want {
+				noised = true
+			}
+			// with the scale of 3, noise of this magnitude is extremely
+			// unlikely
+			if math.Abs(float64(got-want)) > 100 {
+				t.Errorf("got value %v too far from %v", got, want)
+			}
+		}
+	}
+	if !noised {
+		t.Error("no noise added")
+	}
+
+	// the winner of a large margin is preserved
+	results, _, _ := schulze.Compute(noisy, choices)
+	if results[0].Choice != "A" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+	}
+}
+
+func TestNoisyPreferences_errors(t *testing.T) {
+	choices := []string{"A", "B"}
+	for _, epsilon := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if _, err := schulze.NoisyPreferences(make([]int, 4), choices, epsilon, rand.NewSource(1)); !errors.Is(err, schulze.ErrInvalidEpsilon) {
+			t.Errorf("epsilon %v: got error %v, want %v", epsilon, err, schulze.ErrInvalidEpsilon)
+		}
+	}
+	if _, err := schulze.NoisyPreferences(make([]int, 3), choices, 1, rand.NewSource(1)); !errors.Is(err, schulze.ErrPreferencesLengthMismatch) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrPreferencesLengthMismatch)
+	}
+}
+
+func TestElection_NoisyPreferences_sealed(t *testing.T) {
+	choices := []string{"A", "B", "C", "D", "E"}
+	newElection := func(maxEpsilon float64) *schulze.Election[string, string] {
+		t.Helper()
+		e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+			Choices:            choices,
+			Sealed:             true,
+			UnsealTokenHash:    schulze.UnsealTokenHash("secret"),
+			SealedNoiseEpsilon: maxEpsilon,
+		}, schulze.WithRandSource[string](rand.NewSource(1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, voter := range []string{"alice", "bob"} {
+			if _, err := e.Vote(voter, schulze.Ballot[string]{"A": 1, "B": 2}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return e
+	}
+
+	if _, err := newElection(0).NoisyPreferences(1, nil); !errors.Is(err, schulze.ErrSealed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrSealed)
+	}
+
+	e := newElection(1)
+	if _, err := e.NoisyPreferences(1e15, nil); !errors.Is(err, schulze.ErrInvalidEpsilon) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidEpsilon)
+	}
+	// the passed source is ignored, so the noise can not be removed
+	noisy, err := e.NoisyPreferences(1, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := e.NoisyPreferences(1, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(noisy, again) {
+		t.Error("got the same noise from the same source seed while sealed")
+	}
+
+	if err := e.Unseal("secret"); err != nil {
+		t.Fatal(err)
+	}
+	noisy, err = e.NoisyPreferences(1, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err = e.NoisyPreferences(1, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(noisy, again) {
+		t.Error("got different noise from the same source seed after unsealing")
+	}
+
+	for _, epsilon := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+			Choices:            choices,
+			SealedNoiseEpsilon: epsilon,
+		}); !errors.Is(err, schulze.ErrInvalidElectionConfig) {
+			t.Errorf("epsilon %v: got error %v, want %v", epsilon, err, schulze.ErrInvalidElectionConfig)
+		}
+	}
+}
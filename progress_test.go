@@ -0,0 +1,85 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestComputeWithProgress(t *testing.T) {
+	choices := newChoices(10)
+	ballots := randomBallots(t, choices, 20)
+
+	type call struct{ done, total int }
+
+	t.Run("functional", func(t *testing.T) {
+		preferences := schulze.NewPreferences(len(choices))
+		for _, b := range ballots {
+			if _, err := schulze.Vote(preferences, choices, b); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		wantResults, _, wantTie := schulze.Compute(preferences, choices)
+
+		var calls []call
+		results, _, tie := schulze.ComputeWithProgress(preferences, choices, func(done, total int) {
+			calls = append(calls, call{done, total})
+		})
+		if tie != wantTie {
+			t.Errorf("got tie %v, want %v", tie, wantTie)
+		}
+		if !reflect.DeepEqual(results, wantResults) {
+			t.Errorf("got results %+v, want %+v", results, wantResults)
+		}
+
+		if len(calls) != len(choices) {
+			t.Fatalf("got %v progress calls, want %v", len(calls), len(choices))
+		}
+		for i, c := range calls {
+			if want := (call{i + 1, len(choices)}); c != want {
+				t.Errorf("got progress call %+v, want %+v", c, want)
+			}
+		}
+
+		calls = nil
+		_, _, _ = schulze.ComputeWithProgress(preferences, choices, func(done, total int) {
+			calls = append(calls, call{done, total})
+		}, schulze.WithPathStrengthComputer[string](schulze.ParallelPathStrengthComputer{}))
+		if want := []call{{len(choices), len(choices)}}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("got progress calls %+v, want %+v", calls, want)
+		}
+	})
+
+	t.Run("Voting", func(t *testing.T) {
+		v := schulze.NewVoting(choices)
+		for _, b := range ballots {
+			if _, err := v.Vote(b); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var calls []call
+		_, _, _ = v.ComputeWithProgress(func(done, total int) {
+			calls = append(calls, call{done, total})
+		})
+		if len(calls) != len(choices) {
+			t.Fatalf("got %v progress calls, want %v", len(calls), len(choices))
+		}
+
+		// cached computation
+		calls = nil
+		_, _, _ = v.ComputeWithProgress(func(done, total int) {
+			calls = append(calls, call{done, total})
+		})
+		if want := []call{{len(choices), len(choices)}}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("got progress calls %+v, want %+v", calls, want)
+		}
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// AppointProxy appoints the proxy whose ballot counts for the principal, as
+// in general meetings of associations. While the appointment lasts, the
+// Record of the proxy's ballot is tallied as the principal's ballot every
+// time the proxy votes, and it is removed when the proxy unvotes, spoils the
+// ballot or is erased. The appointment ends when it is revoked with
+// RevokeProxy, or when the principal votes, unvotes or spoils the ballot
+// personally, as the personal vote always takes precedence. A proxy may hold
+// appointments of many principals, but proxies can not be chained, so
+// ErrInvalidProxy is returned if the principal is a proxy of another voter,
+// if the proxy is a principal or if they are the same voter.
+// ErrVotedPersonally is returned if the principal already voted. A previous
+// appointment of the principal is replaced. The ballot of the proxy is
+// validated for the principal just as a personal ballot would be, and if it
+// is rejected, for example by the ballot policy or the policy engine, the
+// rejection is returned
+// and the principal is left without an appointment. Later ballots of the
+// proxy that are rejected for the principal are not counted for the
+// principal, without rejecting the ballot of the proxy. The appointment is
+// recorded in the audit log with the reason, without the identities of
+// voters.
+func (e *Election[V, C]) AppointProxy(principal, proxy V, reason string) error {
+	if principal == proxy {
+		return fmt.Errorf("%w: voter %v can not be own proxy", ErrInvalidProxy, principal)
+	}
+	if _, ok := e.proxies[proxy]; ok {
+		return fmt.Errorf("%w: proxy %v appointed a proxy", ErrInvalidProxy, proxy)
+	}
+	for _, p := range e.proxies {
+		if p == principal {
+			return fmt.Errorf("%w: principal %v is a proxy", ErrInvalidProxy, principal)
+		}
+	}
+	if e.votedPersonally(principal) {
+		return ErrVotedPersonally
+	}
+	if err := e.checkSchedule(); err != nil {
+		return err
+	}
+	if e.proxies == nil {
+		e.proxies = make(map[V]V)
+	}
+	e.proxies[principal] = proxy
+	ballotsCount, rejected, err := e.castProxyBallot(principal)
+	if err != nil {
+		return err
+	}
+	if rejected != nil {
+		delete(e.proxies, principal)
+		e.updateDisqualified()
+		return rejected
+	}
+	e.updateDisqualified()
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditAppointProxy,
+		Reason:       reason,
+		BallotsCount: ballotsCount,
+	})
+	return nil
+}
+
+// RevokeProxy ends the appointment of the principal's proxy and removes the
+// ballot that the proxy cast for the principal. The revocation is recorded in
+// the audit log with the reason. It is not an error to revoke the proxy of a
+// principal without one.
+func (e *Election[V, C]) RevokeProxy(principal V, reason string) error {
+	if _, ok := e.proxies[principal]; !ok {
+		return nil
+	}
+	if err := e.checkSchedule(); err != nil {
+		return err
+	}
+	ballotsCount := 0
+	if _, ok := e.proxied[principal]; ok {
+		if err := e.voting.Unvote(e.records[principal]); err != nil {
+			return err
+		}
+		e.removeRecord(principal)
+		ballotsCount++
+	}
+	delete(e.proxies, principal)
+	e.updateDisqualified()
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditRevokeProxy,
+		Reason:       reason,
+		BallotsCount: ballotsCount,
+	})
+	return nil
+}
+
+// Proxy returns the appointed proxy of the principal and a boolean reporting
+// if the proxy is appointed.
+func (e *Election[V, C]) Proxy(principal V) (proxy V, ok bool) {
+	proxy, ok = e.proxies[principal]
+	return proxy, ok
+}
+
+// VotedByProxy returns true if the voter's recorded ballot was cast by the
+// proxy.
+func (e *Election[V, C]) VotedByProxy(voter V) bool {
+	_, ok := e.proxied[voter]
+	return ok
+}
+
+// votedPersonally returns true if the voter has a ballot that is not cast by
+// a proxy, including a spoiled one.
+func (e *Election[V, C]) votedPersonally(voter V) bool {
+	if _, ok := e.spoiled[voter]; ok {
+		return true
+	}
+	_, ok := e.records[voter]
+	return ok && !e.VotedByProxy(voter)
+}
+
+// castProxyBallot replaces the ballot of the principal with the current
+// ballot of the appointed proxy, or removes it if the proxy has no ballot,
+// and returns the number of changed ballots. The ballot of the proxy is
+// validated for the principal just as a personal ballot, and if it is
+// rejected, the previous ballot of the principal is removed and the
+// rejection is returned as the rejected error.
+func (e *Election[V, C]) castProxyBallot(principal V) (ballotsCount int, rejected, err error) {
+	if r, ok := e.records[e.proxies[principal]]; ok {
+		_, err := e.tally(principal, e.voting.ranksOrder(recordBallot(r, e.voting.choices)), nil)
+		if err == nil {
+			delete(e.tags, principal)
+			e.addVetoes(principal, append([]C(nil), e.vetoes[e.proxies[principal]]...))
+			if e.proxied == nil {
+				e.proxied = make(map[V]struct{})
+			}
+			e.proxied[principal] = struct{}{}
+			return 1, nil, nil
+		}
+		rejected = err
+	}
+	if _, ok := e.proxied[principal]; ok {
+		if err := e.voting.Unvote(e.records[principal]); err != nil {
+			return 0, rejected, err
+		}
+		e.removeRecord(principal)
+		ballotsCount++
+	}
+	return ballotsCount, rejected, nil
+}
+
+// updateProxies applies the personal change of the voter's ballot to the
+// proxy appointments. The appointment of the voter as a principal ends, and
+// the ballots cast for principals of the voter as a proxy are updated.
+func (e *Election[V, C]) updateProxies(voter V) error {
+	if len(e.proxies) == 0 {
+		return nil
+	}
+	if _, ok := e.proxies[voter]; ok {
+		delete(e.proxies, voter)
+		delete(e.proxied, voter)
+		e.addAuditEntry(AuditEntry{
+			Action: AuditRevokeProxy,
+			Reason: "principal voted personally",
+		})
+		return nil
+	}
+	for principal, proxy := range e.proxies {
+		if proxy != voter {
+			continue
+		}
+		// rejected ballots are not counted for the principal, without
+		// rejecting the ballot of the proxy
+		if _, _, err := e.castProxyBallot(principal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateProxyVetoes replaces the vetoes of the ballots cast by the proxy for
+// its principals with the current vetoes of the proxy.
+func (e *Election[V, C]) updateProxyVetoes(proxy V) {
+	for principal, p := range e.proxies {
+		if p != proxy {
+			continue
+		}
+		if _, ok := e.proxied[principal]; !ok {
+			continue
+		}
+		e.removeVetoes(principal)
+		e.addVetoes(principal, append([]C(nil), e.vetoes[proxy]...))
+	}
+}
+
+// removeRecord deletes the voter's recorded ballot with its data, without
+// changing the tally.
+func (e *Election[V, C]) removeRecord(voter V) {
+	delete(e.records, voter)
+	delete(e.voted, voter)
+	delete(e.attributes, voter)
+	delete(e.tags, voter)
+	delete(e.scores, voter)
+	delete(e.proxied, voter)
+	e.removeVetoes(voter)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_AppointProxy(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	if err := e.AppointProxy("alice", "bob", "general meeting"); err != nil {
+		t.Fatal(err)
+	}
+	if proxy, ok := e.Proxy("alice"); !ok || proxy != "bob" {
+		t.Errorf("got proxy %q %v, want %q", proxy, ok, "bob")
+	}
+	if _, ok := e.Record("alice"); ok {
+		t.Error("got ballot of the principal before the proxy voted")
+	}
+
+	r, err := e.Vote("bob", schulze.Ballot[string]{"B": 1, "A": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProxyBallot(t, e, "alice", r)
+
+	// the proxy changes the ballot
+	r, err = e.Vote("bob", schulze.Ballot[string]{"C": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertProxyBallot(t, e, "alice", r)
+	if got, want := e.VotersCount(), 2; got != want {
+		t.Errorf("got voters count %v, want %v", got, want)
+	}
+
+	// the proxy unvotes
+	if err := e.Unvote("bob"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := e.Record("alice"); ok {
+		t.Error("got ballot of the principal after the proxy unvoted")
+	}
+	if _, ok := e.Proxy("alice"); !ok {
+		t.Error("proxy appointment ended after the proxy unvoted")
+	}
+
+	// the personal vote of the principal takes precedence
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+	personal, err := e.Vote("alice", schulze.Ballot[string]{"A": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := e.Proxy("alice"); ok {
+		t.Error("proxy appointment did not end after the personal vote")
+	}
+	if e.VotedByProxy("alice") {
+		t.Error("personal ballot is marked as voted by proxy")
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := e.Record("alice"); !reflect.DeepEqual(got, personal) {
+		t.Errorf("got record %v, want personal record %v", got, personal)
+	}
+	if err := e.AppointProxy("alice", "bob", ""); !errors.Is(err, schulze.ErrVotedPersonally) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrVotedPersonally)
+	}
+
+	// revocation removes the ballot cast by the proxy
+	if err := e.AppointProxy("carol", "bob", "general meeting"); err != nil {
+		t.Fatal(err)
+	}
+	if !e.VotedByProxy("carol") {
+		t.Error("ballot of the proxy is not cast for the principal")
+	}
+	if err := e.RevokeProxy("carol", "attending"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := e.Record("carol"); ok {
+		t.Error("got ballot of the principal after the revocation")
+	}
+	if err := e.RevokeProxy("carol", "not appointed"); err != nil {
+		t.Fatal(err)
+	}
+
+	log := e.AuditLog()
+	var got []schulze.AuditEntry
+	for _, entry := range log {
+		entry.Time = time.Time{}
+		got = append(got, entry)
+	}
+	want := []schulze.AuditEntry{
+		{Action: schulze.AuditAppointProxy, Reason: "general meeting"},
+		{Action: schulze.AuditRevokeProxy, Reason: "principal voted personally"},
+		{Action: schulze.AuditAppointProxy, Reason: "general meeting", BallotsCount: 1},
+		{Action: schulze.AuditRevokeProxy, Reason: "attending", BallotsCount: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got audit log %+v, want %+v", got, want)
+	}
+}
+
+func TestElection_AppointProxy_invalid(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+
+	if err := e.AppointProxy("alice", "alice", ""); !errors.Is(err, schulze.ErrInvalidProxy) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidProxy)
+	}
+	if err := e.AppointProxy("alice", "bob", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.AppointProxy("bob", "carol", ""); !errors.Is(err, schulze.ErrInvalidProxy) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidProxy)
+	}
+	if err := e.AppointProxy("carol", "alice", ""); !errors.Is(err, schulze.ErrInvalidProxy) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidProxy)
+	}
+}
+
+func TestElection_AppointProxy_rejected(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SuspendChoice("B", "appeal"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the ballot of the proxy is validated for the principal
+	var suspendedErr *schulze.SuspendedChoiceError[string]
+	if err := e.AppointProxy("alice", "bob", ""); !errors.As(err, &suspendedErr) {
+		t.Errorf("got error %v, want suspended choice error", err)
+	}
+	if _, ok := e.Proxy("alice"); ok {
+		t.Error("got proxy appointment with the rejected ballot")
+	}
+	if _, ok := e.Record("alice"); ok {
+		t.Error("got rejected ballot of the principal")
+	}
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v, want 1", got)
+	}
+}
+
+func TestElection_AppointProxy_erase(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+
+	if err := e.AppointProxy("alice", "bob", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, err := schulze.RestoreElection(e.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proxy, _ := restored.Proxy("alice"); proxy != "bob" || !restored.VotedByProxy("alice") {
+		t.Errorf("got restored proxy %q, voted by proxy %v", proxy, restored.VotedByProxy("alice"))
+	}
+
+	if err := e.EraseVoter("bob", "deletion request"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := e.Proxy("alice"); ok {
+		t.Error("proxy appointment of the erased proxy remains")
+	}
+	if _, ok := e.Record("alice"); ok {
+		t.Error("ballot cast by the erased proxy remains")
+	}
+	if got := e.VotersCount(); got != 0 {
+		t.Errorf("got voters count %v", got)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if r.Wins != 0 {
+			t.Errorf("got results %+v", results)
+		}
+	}
+}
+
+func assertProxyBallot(t *testing.T, e *schulze.Election[string, string], principal string, want schulze.Record[string]) {
+	t.Helper()
+
+	got, ok := e.Record(principal)
+	if !ok {
+		t.Fatal("no ballot of the principal")
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got principal record %v, want %v", got, want)
+	}
+	if !e.VotedByProxy(principal) {
+		t.Error("ballot is not marked as voted by proxy")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"context"
+	"sync"
+)
+
+// VoteQueue ingests ballots asynchronously into a Voting through a bounded
+// queue processed by a single worker goroutine, smoothing bursty loads, such
+// as rushes before the voting closes. Ballots are tallied in the order they
+// were queued, so the order of ballots of any voter is preserved. While the
+// queue is running, the worker owns the Voting and all other operations on it
+// must be run with the Do method. Methods on the VoteQueue type are safe for
+// concurrent calls.
+type VoteQueue[C comparable] struct {
+	voting *Voting[C]
+	jobs   chan func()
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+// VoteResult is the outcome of an asynchronously tallied ballot.
+type VoteResult[C comparable] struct {
+	Record Record[C]
+	Err    error
+}
+
+// NewVoteQueue starts the worker that tallies ballots into the voting from a
+// queue that holds at most size ballots that are not yet tallied. The Close
+// method must be called to stop the worker.
+func NewVoteQueue[C comparable](v *Voting[C], size int) *VoteQueue[C] {
+	if size < 0 {
+		size = 0
+	}
+	q := &VoteQueue[C]{
+		voting: v,
+		jobs:   make(chan func(), size),
+		done:   make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+func (q *VoteQueue[C]) run() {
+	defer close(q.done)
+	for job := range q.jobs {
+		job()
+	}
+}
+
+// VoteAsync queues the ballot and returns a channel that receives the result
+// of the Voting Vote method once the ballot is tallied. If the queue is full,
+// it blocks until there is space in the queue, applying backpressure to the
+// caller, or until the context is done, returning the context error.
+// ErrVoteQueueClosed is returned if the queue is closed.
+func (q *VoteQueue[C]) VoteAsync(ctx context.Context, b Ballot[C]) (<-chan VoteResult[C], error) {
+	result := make(chan VoteResult[C], 1)
+	if err := q.enqueue(ctx, func() {
+		r, err := q.voting.Vote(b)
+		result <- VoteResult[C]{Record: r, Err: err}
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Do calls the function with the voting after all previously queued ballots
+// are tallied and waits for it to return, so that other operations, such as
+// Compute or Unvote, can be called safely while the queue is running.
+// ErrVoteQueueClosed is returned if the queue is closed.
+func (q *VoteQueue[C]) Do(ctx context.Context, f func(v *Voting[C])) error {
+	done := make(chan struct{})
+	if err := q.enqueue(ctx, func() {
+		defer close(done)
+		f(q.voting)
+	}); err != nil {
+		return err
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *VoteQueue[C]) enqueue(ctx context.Context, job func()) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return ErrVoteQueueClosed
+	}
+	select {
+	case q.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting new ballots and waits for all queued ballots to be
+// tallied. After Close returns, the Voting can be used directly again.
+func (q *VoteQueue[C]) Close() {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+	<-q.done
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestVoteQueue(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	ballots := []schulze.Ballot[string]{
+		{"A": 1},
+		{"B": 1, "C": 2},
+		{"C": 1},
+		{"X": 1},
+	}
+
+	q := schulze.NewVoteQueue(schulze.NewVoting(choices), 2)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, b := range ballots {
+				result, err := q.VoteAsync(ctx, b)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				r := <-result
+				if _, ok := b["X"]; ok {
+					var cerr *schulze.UnknownChoiceError[string]
+					if !errors.As(r.Err, &cerr) {
+						t.Errorf("got error %v, want UnknownChoiceError", r.Err)
+					}
+					continue
+				}
+				if r.Err != nil {
+					t.Error(r.Err)
+				}
+				if r.Record == nil {
+					t.Error("got nil record")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var results []schulze.Result[string]
+	if err := q.Do(ctx, func(v *schulze.Voting[string]) {
+		results, _, _ = v.Compute()
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	q.Close()
+	q.Close()
+
+	if _, err := q.VoteAsync(ctx, schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrVoteQueueClosed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrVoteQueueClosed)
+	}
+
+	v := schulze.NewVoting(choices)
+	for i := 0; i < 10; i++ {
+		for _, b := range ballots[:3] {
+			if _, err := v.Vote(b); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	want, _, _ := v.Compute()
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("got results %+v, want %+v", results, want)
+	}
+}
+
+func TestVoteQueue_backpressure(t *testing.T) {
+	q := schulze.NewVoteQueue(schulze.NewVoting([]string{"A", "B"}), 1)
+	defer q.Close()
+
+	// block the worker
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_ = q.Do(context.Background(), func(*schulze.Voting[string]) {
+			close(started)
+			<-release
+		})
+	}()
+	<-started
+
+	// fill the queue
+	if _, err := q.VoteAsync(context.Background(), schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.VoteAsync(ctx, schulze.Ballot[string]{"B": 1}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+}
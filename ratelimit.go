@@ -0,0 +1,130 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimit is the token bucket rate of calls, where Rate tokens per second
+// are added to the bucket of the Burst capacity and every call takes one
+// token. A zero Rate does not limit calls.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// rateLimiter holds the token buckets of the election.
+type rateLimiter[V comparable] struct {
+	voter  RateLimit
+	global RateLimit
+	// bucket of all calls
+	all tokenBucket
+	// buckets of voters that are not full
+	voters map[V]*tokenBucket
+	calls  int
+}
+
+// tokenBucket holds the number of tokens at the time of the last update.
+type tokenBucket struct {
+	tokens float64
+	time   time.Time
+}
+
+// rateLimiterSweepInterval is the number of calls between removals of full
+// voter buckets.
+const rateLimiterSweepInterval = 1024
+
+// SetRateLimit limits the rate of Vote and Unvote calls of every voter and
+// the rate of calls of all voters together, to protect public polls from
+// scripted ballot stuffing. Calls over the limits are rejected with
+// RateLimitError, which matches ErrRateLimited with errors.Is. Only the Vote
+// methods and Unvote are limited, and revealed committed ballots, accepted
+// provisional ballots, ballots of imported station batches and ballots cast
+// by proxies for their principals are not. Zero rate limits remove the
+// limits.
+func (e *Election[V, C]) SetRateLimit(voter, global RateLimit) {
+	if voter.Rate <= 0 && global.Rate <= 0 {
+		e.rateLimiter = nil
+		return
+	}
+	now := e.now()
+	e.rateLimiter = &rateLimiter[V]{
+		voter:  voter,
+		global: global,
+		all:    tokenBucket{tokens: float64(global.Burst), time: now},
+		voters: make(map[V]*tokenBucket),
+	}
+}
+
+// checkRateLimit takes a token of the voter and the global bucket, or
+// returns RateLimitError if either is empty.
+func (e *Election[V, C]) checkRateLimit(voter V) error {
+	l := e.rateLimiter
+	if l == nil {
+		return nil
+	}
+	now := e.now()
+
+	l.calls++
+	if l.calls%rateLimiterSweepInterval == 0 {
+		for v, b := range l.voters {
+			if b.refill(l.voter, now) >= float64(l.voter.Burst) {
+				delete(l.voters, v)
+			}
+		}
+	}
+
+	var wait time.Duration
+	if l.global.Rate > 0 {
+		if l.all.refill(l.global, now) < 1 {
+			wait = l.all.wait(l.global)
+		}
+	}
+	var vb *tokenBucket
+	if l.voter.Rate > 0 {
+		vb = l.voters[voter]
+		if vb == nil {
+			vb = &tokenBucket{tokens: float64(l.voter.Burst), time: now}
+		}
+		if vb.refill(l.voter, now) < 1 {
+			if w := vb.wait(l.voter); w > wait {
+				wait = w
+			}
+		}
+	}
+	if wait > 0 {
+		return &RateLimitError{RetryAfter: wait}
+	}
+	if l.global.Rate > 0 {
+		l.all.tokens--
+	}
+	if vb != nil {
+		vb.tokens--
+		l.voters[voter] = vb
+	}
+	return nil
+}
+
+// refill adds tokens for the time since the last update and returns the
+// number of tokens.
+func (b *tokenBucket) refill(l RateLimit, now time.Time) float64 {
+	if elapsed := now.Sub(b.time); elapsed > 0 {
+		b.tokens = math.Min(float64(l.Burst), b.tokens+elapsed.Seconds()*l.Rate)
+		b.time = now
+	}
+	return b.tokens
+}
+
+// wait returns the duration until the bucket has a token.
+func (b *tokenBucket) wait(l RateLimit) time.Duration {
+	if l.Burst < 1 {
+		// the bucket can never hold a token
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(math.Ceil((1 - b.tokens) / l.Rate * float64(time.Second)))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_SetRateLimit(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	e.SetNow(func() time.Time { return now })
+
+	e.SetRateLimit(
+		schulze.RateLimit{Rate: 1, Burst: 2},
+		schulze.RateLimit{Rate: 10, Burst: 4},
+	)
+
+	vote := func(voter string) error {
+		_, err := e.Vote(voter, schulze.Ballot[string]{"A": 1})
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := vote("alice"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := e.Unvote("alice")
+	if !errors.Is(err, schulze.ErrRateLimited) {
+		t.Fatalf("got error %v, want %v", err, schulze.ErrRateLimited)
+	}
+	var rerr *schulze.RateLimitError
+	if !errors.As(err, &rerr) || rerr.RetryAfter != time.Second {
+		t.Errorf("got error %v, want retry after %v", err, time.Second)
+	}
+
+	// the global limit of other voters
+	if err := vote("bob"); err != nil {
+		t.Fatal(err)
+	}
+	if err := vote("carol"); err != nil {
+		t.Fatal(err)
+	}
+	if err := vote("dave"); !errors.Is(err, schulze.ErrRateLimited) {
+		t.Fatalf("got error %v, want %v", err, schulze.ErrRateLimited)
+	}
+
+	now = now.Add(time.Second)
+	if err := e.Unvote("alice"); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.VotersCount(); got != 2 {
+		t.Errorf("got voters count %v, want %v", got, 2)
+	}
+
+	e.SetRateLimit(schulze.RateLimit{}, schulze.RateLimit{})
+	for i := 0; i < 10; i++ {
+		if err := vote("alice"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestElection_SetRateLimit_exempt(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	e.SetNow(func() time.Time { return now })
+
+	s, err := schulze.NewStation[string](e.StationBundle("hall"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, voter := range []string{"carol", "dave"} {
+		if _, err := s.Vote(voter, schulze.Ballot[string]{"B": 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch, err := s.Batch(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e.SetRateLimit(
+		schulze.RateLimit{Rate: 1, Burst: 1},
+		schulze.RateLimit{Rate: 1, Burst: 1},
+	)
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("erin", schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrRateLimited) {
+		t.Fatalf("got error %v, want %v", err, schulze.ErrRateLimited)
+	}
+
+	// ballots cast by proxies and imported from stations are not limited
+	if err := e.AppointProxy("alice", "bob", ""); err != nil {
+		t.Fatal(err)
+	}
+	if !e.VotedByProxy("alice") {
+		t.Error("ballot of the proxy is not cast for the principal")
+	}
+	result, err := e.ImportBatch(batch, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Imported != 2 {
+		t.Errorf("got import %+v", result)
+	}
+	if got := e.VotersCount(); got != 4 {
+		t.Errorf("got voters count %v, want %v", got, 4)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// RecountReport is a structured comparison of the recount results with the
+// live results.
+type RecountReport[C comparable] struct {
+	// Number of recounted ballots.
+	BallotsCount int
+	// Results of the recount.
+	Results []Result[C]
+	Tie     bool
+	// Pairwise preferences that differ between the live tally and the
+	// recount.
+	PreferenceDiffs []PreferenceDiff[C]
+	// Choices with different positions or numbers of wins in the live and the
+	// recount results.
+	RankingDiffs []RankingDiff[C]
+}
+
+// Match returns true if the recount tally and results are the same as the
+// live ones.
+func (r RecountReport[C]) Match() bool {
+	return len(r.PreferenceDiffs) == 0 && len(r.RankingDiffs) == 0
+}
+
+// PreferenceDiff is the difference in the number of ballots that preferred
+// one choice over another.
+type PreferenceDiff[C comparable] struct {
+	From, To C
+	// Number of ballots in the live tally.
+	Live int
+	// Number of ballots in the recount.
+	Recount int
+}
+
+// RankingDiff is the difference in the standing of a choice. Positions are
+// 1-based indexes in the sorted results and they are zero if the choice is
+// not in the results.
+type RankingDiff[C comparable] struct {
+	Choice          C
+	LivePosition    int
+	RecountPosition int
+	LiveWins        int
+	RecountWins     int
+}
+
+// Recount re-tallies the Records of the archived ballots into new
+// preferences, computes the results with the reference implementation of the
+// strongest paths algorithm, instead of the optimized or custom one, and
+// compares them with the live preferences and results of the voting. Choices
+// in the records that are not choices of the voting are ignored.
+func (v *Voting[C]) Recount(records []Record[C]) RecountReport[C] {
+	choices := v.choices
+	preferences := NewPreferences(len(choices))
+	for _, r := range records {
+		// ballot is constructed only from the known choices so the error is
+		// not possible
+		_, _ = Vote(preferences, choices, recordBallot(r, choices))
+	}
+
+	report := RecountReport[C]{
+		BallotsCount: len(records),
+	}
+	report.Results, report.Tie = v.referenceResults(preferences)
+
+	choicesCount := len(choices)
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			live, recount := v.preferences[i*choicesCount+j], preferences[i*choicesCount+j]
+			if live != recount {
+				report.PreferenceDiffs = append(report.PreferenceDiffs, PreferenceDiff[C]{
+					From:    choices[i],
+					To:      choices[j],
+					Live:    live,
+					Recount: recount,
+				})
+			}
+		}
+	}
+
+	v.compute()
+	live := make([]RankingDiff[C], choicesCount)
+	for position, r := range v.results {
+		live[r.Index].LivePosition = position + 1
+		live[r.Index].LiveWins = r.Wins
+	}
+	for position, r := range report.Results {
+		live[r.Index].RecountPosition = position + 1
+		live[r.Index].RecountWins = r.Wins
+	}
+	for i, d := range live {
+		if d.LivePosition != d.RecountPosition || d.LiveWins != d.RecountWins {
+			d.Choice = choices[i]
+			report.RankingDiffs = append(report.RankingDiffs, d)
+		}
+	}
+	return report
+}
+
+// Recount re-tallies the Records of the archived ballots, as the Voting
+// Recount method does. ErrSealed is returned if the results of the election
+// are sealed.
+func (e *Election[V, C]) Recount(records []Record[C]) (RecountReport[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return RecountReport[C]{}, err
+	}
+	return e.voting.Recount(records), nil
+}
+
+// referenceResults calculates results from the preferences with the
+// reference strongest paths algorithm, excluding the suspended, disqualified
+// and withdrawn choices.
+func (v *Voting[C]) referenceResults(preferences []int) (results []Result[C], tie bool) {
+	eligible := make([]C, 0, len(v.choices))
+	indexes := make([]int, 0, len(v.choices))
+	for i, c := range v.choices {
+		if !v.excluded(c) {
+			eligible = append(eligible, c)
+			indexes = append(indexes, i)
+		}
+	}
+	preferences = SetChoices(preferences, v.choices, eligible)
+	if v.options.strengthVariant == StrengthMargins {
+		preferences = marginsPreferences(preferences, len(eligible))
+	}
+	results, tie = calculateResults(eligible, referencePathStrengths(preferences, len(eligible)), v.options)
+	for i := range results {
+		results[i].Index = indexes[results[i].Index]
+	}
+	return v.excludeWithdrawn(results, tie)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_Recount(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	var archive []schulze.Record[string]
+	for _, v := range []struct {
+		voter  string
+		ballot schulze.Ballot[string]
+	}{
+		{voter: "alice", ballot: schulze.Ballot[string]{"A": 1, "B": 2}},
+		{voter: "bob", ballot: schulze.Ballot[string]{"A": 1}},
+		{voter: "carol", ballot: schulze.Ballot[string]{"B": 1, "C": 2}},
+	} {
+		r, err := e.Vote(v.voter, v.ballot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		archive = append(archive, r)
+	}
+
+	report, err := e.Recount(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Match() {
+		t.Errorf("got recount report mismatch %+v", report)
+	}
+	results, _, tie, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Results, results) || report.Tie != tie {
+		t.Errorf("got recount results %+v, want %+v", report.Results, results)
+	}
+	if report.BallotsCount != 3 {
+		t.Errorf("got %v recounted ballots, want %v", report.BallotsCount, 3)
+	}
+
+	// a ballot is missing from the archive and another is altered
+	report, err = e.Recount([]schulze.Record[string]{
+		archive[0],
+		{{"B"}, {"A", "C"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Match() {
+		t.Error("got recount report match")
+	}
+	if want := []schulze.PreferenceDiff[string]{
+		{From: "A", To: "B", Live: 2, Recount: 1},
+		{From: "A", To: "C", Live: 2, Recount: 1},
+		{From: "C", To: "A", Live: 1, Recount: 0},
+	}; !reflect.DeepEqual(report.PreferenceDiffs, want) {
+		t.Errorf("got preference diffs %+v, want %+v", report.PreferenceDiffs, want)
+	}
+	if want := []schulze.RankingDiff[string]{
+		{Choice: "A", LivePosition: 1, RecountPosition: 2, LiveWins: 2, RecountWins: 1},
+		{Choice: "B", LivePosition: 2, RecountPosition: 1, LiveWins: 1, RecountWins: 1},
+	}; !reflect.DeepEqual(report.RankingDiffs, want) {
+		t.Errorf("got ranking diffs %+v, want %+v", report.RankingDiffs, want)
+	}
+	if !report.Tie {
+		t.Error("got no recount tie")
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// Redact removes the choices from the Records of all ballots that ranked
+// them, for example when a candidate is removed for legal reasons, and
+// adjusts the preferences accordingly. Redacted choices that are still
+// choices of the election become unranked in the corrected Records, and they
+// can be removed from the election with SetChoices. Scores of the redacted
+// choices are removed as well. The ballot policy is not applied to the
+// corrected Records, and choices that were suspended or withdrawn after the
+// ballot was voted remain ranked in them. The redaction is recorded in the audit log with the
+// reason and the number of corrected ballots, which is also returned.
+func (e *Election[V, C]) Redact(reason string, choices ...C) (ballotsCount int, err error) {
+	redacted := make(map[C]struct{}, len(choices))
+	for _, c := range choices {
+		redacted[c] = struct{}{}
+	}
+
+	for voter, r := range e.records {
+		if !recordContainsAny(r, redacted) {
+			continue
+		}
+		b := recordBallot(r, e.voting.choices)
+		for c := range redacted {
+			delete(b, c)
+		}
+		// the corrected ballot is voted first, so that the record remains
+		// counted if it can not be voted
+		corrected, err := e.voting.voteRecorded(b)
+		if err != nil {
+			return ballotsCount, err
+		}
+		if err := e.voting.Unvote(r); err != nil {
+			return ballotsCount, err
+		}
+		e.records[voter] = corrected
+		if s, ok := e.scores[voter]; ok {
+			for c := range redacted {
+				delete(s, c)
+			}
+		}
+		ballotsCount++
+	}
+
+	formatted := make([]string, 0, len(choices))
+	for _, c := range choices {
+		formatted = append(formatted, fmt.Sprint(c))
+	}
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditRedact,
+		Reason:       reason,
+		Choices:      formatted,
+		BallotsCount: ballotsCount,
+	})
+	return ballotsCount, nil
+}
+
+// recordContainsAny returns true if any of the choices is ranked in the
+// record, excluding the last list of unranked choices.
+func recordContainsAny[C comparable](r Record[C], choices map[C]struct{}) bool {
+	if len(r) == 0 {
+		return false
+	}
+	for _, choices1 := range r[:len(r)-1] {
+		for _, c := range choices1 {
+			if _, ok := choices[c]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_Redact(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	e := schulze.NewElection[string](choices)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	e.SetNow(func() time.Time { return now })
+
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"B": 1, "A": 2},
+		"bob":   {"A": 1, "B": 1, "C": 2},
+		"carol": {"C": 1},
+	} {
+		if _, err := e.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	count, err := e.Redact("disqualified", "B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("got %v redacted ballots, want %v", count, 2)
+	}
+
+	for voter, want := range map[string]schulze.Record[string]{
+		"alice": {{"A"}, {"B", "C"}},
+		"bob":   {{"A"}, {"C"}, {"B"}},
+		"carol": {{"C"}, {"A", "B"}},
+	} {
+		r, _ := e.Record(voter)
+		if !reflect.DeepEqual(sortedRecord(r), want) {
+			t.Errorf("%s: got record %v, want %v", voter, r, want)
+		}
+	}
+
+	want := schulze.NewElection[string](choices)
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"A": 1},
+		"bob":   {"A": 1, "C": 2},
+		"carol": {"C": 1},
+	} {
+		if _, err := want.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(e.StateHash(), want.StateHash()) {
+		t.Error("preferences are not adjusted")
+	}
+	if err := e.SelfCheck(); err != nil {
+		t.Error(err)
+	}
+
+	// redact a choice that was already removed from the election
+	e.SetChoices([]string{"A", "B"})
+	count, err = e.Redact("withdrawn", "C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("got %v redacted ballots, want %v", count, 2)
+	}
+	if err := e.SelfCheck(); err != nil {
+		t.Error(err)
+	}
+
+	if got, want := e.AuditLog(), []schulze.AuditEntry{
+		{Time: now, Action: schulze.AuditRedact, Reason: "disqualified", Choices: []string{"B"}, BallotsCount: 2},
+		{Time: now, Action: schulze.AuditRedact, Reason: "withdrawn", Choices: []string{"C"}, BallotsCount: 2},
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got audit log %+v, want %+v", got, want)
+	}
+}
+
+func TestElection_Redact_suspended(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	e := schulze.NewElection[string](choices)
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1, "B": 2, "C": 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SuspendChoice("C", "under review"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the record ranks the choice that was suspended after it was voted
+	if _, err := e.Redact("disqualified", "B"); err != nil {
+		t.Fatal(err)
+	}
+	r, _ := e.Record("alice")
+	if want := (schulze.Record[string]{{"A"}, {"C"}, {"B"}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+	if err := e.SelfCheck(); err != nil {
+		t.Errorf("self check: %v", err)
+	}
+
+	if err := e.Unvote("alice"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.Voting().Preferences(), make([]int, len(choices)*len(choices)); !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v after unvote, want %v", got, want)
+	}
+}
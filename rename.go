@@ -0,0 +1,106 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// RenameChoice replaces the old choice with the new one, keeping all its
+// preferences, as the choice is the same but with a new label. SetChoices
+// would treat the rename as removal of the old choice and addition of the new
+// one, discarding the preferences of the old choice. UnknownChoiceError is
+// returned if the old choice does not exist and DuplicateChoiceError if the
+// new choice already exists.
+func (v *Voting[C]) RenameChoice(old, new C) error {
+	i := getChoiceIndex(v.choices, old)
+	if i < 0 {
+		return &UnknownChoiceError[C]{Choice: old}
+	}
+	if old == new {
+		return nil
+	}
+	if getChoiceIndex(v.choices, new) >= 0 {
+		return &DuplicateChoiceError[C]{Choice: new}
+	}
+	v.invalidate()
+	// copy the choices not to modify the slice passed by the caller
+	choices := append(make([]C, 0, len(v.choices)), v.choices...)
+	choices[i] = new
+	v.choices = choices
+	if _, ok := v.suspended[old]; ok {
+		delete(v.suspended, old)
+		v.suspended[new] = struct{}{}
+	}
+	if _, ok := v.disqualified[old]; ok {
+		delete(v.disqualified, old)
+		v.disqualified[new] = struct{}{}
+	}
+	if mode, ok := v.withdrawn[old]; ok {
+		delete(v.withdrawn, old)
+		v.withdrawn[new] = mode
+	}
+	return nil
+}
+
+// RenameChoice replaces the old choice with the new one in the election
+// choices, Records and scores of all ballots and presets, keeping all its
+// preferences, as the Voting RenameChoice method does. The rename is recorded
+// in the audit log.
+func (e *Election[V, C]) RenameChoice(old, new C) error {
+	if err := e.voting.RenameChoice(old, new); err != nil {
+		return err
+	}
+	if old == new {
+		return nil
+	}
+
+	var ballotsCount int
+	for _, r := range e.records {
+		if renameRecordChoice(r, old, new) {
+			ballotsCount++
+		}
+	}
+	for _, r := range e.presets {
+		renameRecordChoice(r, old, new)
+	}
+	for _, s := range e.scores {
+		if score, ok := s[old]; ok {
+			delete(s, old)
+			s[new] = score
+		}
+	}
+	for _, vetoes := range e.vetoes {
+		for i, c := range vetoes {
+			if c == old {
+				vetoes[i] = new
+			}
+		}
+	}
+	if count, ok := e.vetoCounts[old]; ok {
+		delete(e.vetoCounts, old)
+		e.vetoCounts[new] = count
+	}
+
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditRename,
+		Choices:      []string{fmt.Sprint(old), fmt.Sprint(new)},
+		BallotsCount: ballotsCount,
+	})
+	return nil
+}
+
+// renameRecordChoice replaces the old choice with the new one in the record
+// and returns true if the record contained the old choice.
+func renameRecordChoice[C comparable](r Record[C], old, new C) bool {
+	for _, choices := range r {
+		for i, c := range choices {
+			if c == old {
+				choices[i] = new
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_RenameChoice(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	v := schulze.NewVoting(choices)
+
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	preferences := v.Preferences()
+
+	if err := v.RenameChoice("B", "Bee"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(choices, []string{"A", "B", "C"}) {
+		t.Errorf("choices passed to the voting modified: %v", choices)
+	}
+	if got := v.Preferences(); !reflect.DeepEqual(got, preferences) {
+		t.Errorf("got preferences %v, want %v", got, preferences)
+	}
+	results, _, _ := v.Compute()
+	if results[0].Choice != "Bee" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "Bee")
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err == nil {
+		t.Error("expected unknown choice error for the old choice")
+	}
+
+	var uerr *schulze.UnknownChoiceError[string]
+	if err := v.RenameChoice("B", "D"); !errors.As(err, &uerr) {
+		t.Errorf("got error %v, want UnknownChoiceError", err)
+	}
+	var derr *schulze.DuplicateChoiceError[string]
+	if err := v.RenameChoice("A", "C"); !errors.As(err, &derr) {
+		t.Errorf("got error %v, want DuplicateChoiceError", err)
+	} else if derr.Choice != "C" {
+		t.Errorf("got duplicate choice %v, want %v", derr.Choice, "C")
+	}
+}
+
+func TestElection_RenameChoice(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	e.SetPreset("slate", schulze.Record[string]{{"B"}, {"A", "C"}})
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"B": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteScores("bob", schulze.ScoreBallot[string]{"B": 5, "C": 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("carol", schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.RenameChoice("B", "Bee"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := e.Choices(), []string{"A", "Bee", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got choices %v, want %v", got, want)
+	}
+	if r, _ := e.Record("alice"); !reflect.DeepEqual(r, schulze.Record[string]{{"Bee"}, {"A"}, {"C"}}) {
+		t.Errorf("got record %v", r)
+	}
+	if r, _ := e.Preset("slate"); !reflect.DeepEqual(r, schulze.Record[string]{{"Bee"}, {"A", "C"}}) {
+		t.Errorf("got preset %v", r)
+	}
+	scores, err := e.AverageScores()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range scores {
+		if s.Choice == "B" {
+			t.Errorf("got score of the old choice %+v", s)
+		}
+	}
+	if err := e.SelfCheck(); err != nil {
+		t.Error(err)
+	}
+
+	// voters can change votes made before the rename
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("bob"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SelfCheck(); err != nil {
+		t.Error(err)
+	}
+
+	log := e.AuditLog()
+	if len(log) != 1 {
+		t.Fatalf("got %v audit entries, want %v", len(log), 1)
+	}
+	if log[0].Action != schulze.AuditRename || !reflect.DeepEqual(log[0].Choices, []string{"B", "Bee"}) || log[0].BallotsCount != 3 {
+		t.Errorf("got audit entry %+v", log[0])
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "time"
+
+// Reset clears the preferences of all ballots, keeping the choices, the
+// options and the suspended, withdrawn and disqualified choices, as if no
+// ballots were voted, for example to restart a poll. The preferences are
+// cleared in place, without reallocation. Records of ballots voted before the
+// reset must not be unvoted after it. The Election Reset method clears the
+// Records of voters together with the preferences.
+func (v *Voting[C]) Reset() {
+	v.invalidate()
+	for i := range v.preferences {
+		v.preferences[i] = 0
+	}
+}
+
+// ResetChoice clears the preferences of the choice as if no ballots ranked
+// it, keeping the preferences between all other choices. Just as for a choice
+// added with SetChoices, the choice is not preferred over any other choice,
+// and every other choice is preferred over it by the ballots that ranked that
+// choice. Records of ballots that ranked the choice before the reset must not
+// be unvoted after it. UnknownChoiceError is returned if the choice does not
+// exist. The Election Redact method has the same result on the preferences,
+// and it also corrects the Records of voters that ranked the choice.
+func (v *Voting[C]) ResetChoice(c C) error {
+	i := int(getChoiceIndex(v.choices, c))
+	if i < 0 {
+		return &UnknownChoiceError[C]{Choice: c}
+	}
+	v.invalidate()
+	choicesCount := len(v.choices)
+	for j := 0; j < choicesCount; j++ {
+		v.preferences[i*choicesCount+j] = 0
+		if j != i {
+			// diagonal values are the numbers of ballots that ranked the
+			// choice over the unranked choices
+			v.preferences[j*choicesCount+i] = v.preferences[j*choicesCount+j]
+		}
+	}
+	return nil
+}
+
+// Reset removes all ballots from the election, keeping the choices, the
+// configuration, presets, proxy appointments and the suspended and withdrawn
+// choices, for example to restart a poll. Records, spoiled and provisional
+// ballots, commitments, tags, scores, vetoes, audit attributes and the tally
+// at the close of the election are removed, and the preferences are cleared
+// as the Voting Reset method does. Voting tokens remain used by their voters,
+// who can vote again with them, and imported batches can not be imported
+// again. The reset is recorded in the audit log with the reason and the
+// number of removed ballots.
+func (e *Election[V, C]) Reset(reason string) {
+	ballotsCount := len(e.records) + len(e.spoiled)
+	e.voting.Reset()
+	e.records = make(map[V]Record[C])
+	e.spoiled = make(map[V]struct{})
+	e.provisional = make(map[V]Ballot[C])
+	e.commitments = make(map[V][]byte)
+	e.tags = make(map[V]Tags)
+	e.scores = make(map[V]ScoreBallot[C])
+	e.voted = make(map[V]time.Time)
+	e.attributes = make(map[V]Attributes)
+	e.proxied = nil
+	e.vetoes = nil
+	e.vetoCounts = nil
+	e.closeTally = nil
+	e.closeVoters = 0
+	e.updateDisqualified()
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditReset,
+		Reason:       reason,
+		BallotsCount: ballotsCount,
+	})
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_Reset(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	v := schulze.NewVoting(choices)
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2},
+		{"C": 1},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := v.SuspendChoice("C"); err != nil {
+		t.Fatal(err)
+	}
+	v.Compute()
+
+	v.Reset()
+
+	if got, want := v.Preferences(), make([]int, 9); !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+	if got, want := v.SuspendedChoices(), []string{"C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got suspended choices %v, want %v", got, want)
+	}
+
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	results, _, tie := v.Compute()
+	wantResults, _, wantTie := schulze.ComputeWith(v.Preferences(), choices, func(c string) bool { return c != "C" })
+	if !reflect.DeepEqual(results, wantResults) || tie != wantTie {
+		t.Errorf("got results %v, tie %v, want %v, tie %v", results, tie, wantResults, wantTie)
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want B", results[0].Choice)
+	}
+}
+
+func TestVoting_ResetChoice(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	ballots := []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"C": 1, "B": 2},
+		{"B": 1, "A": 2},
+	}
+	v := schulze.NewVoting(choices)
+	for _, b := range ballots {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	v.Compute()
+
+	if err := v.ResetChoice("B"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the same ballots with the choice removed and added again
+	want := schulze.NewVoting(choices)
+	for _, b := range ballots {
+		if _, err := want.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want.SetChoices([]string{"A", "C"})
+	want.SetChoices(choices)
+	if got, want := v.Preferences(), want.Preferences(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+	gotResults, _, gotTie := v.Compute()
+	wantResults, _, wantTie := want.Compute()
+	if !reflect.DeepEqual(gotResults, wantResults) || gotTie != wantTie {
+		t.Errorf("got results %v, tie %v, want %v, tie %v", gotResults, gotTie, wantResults, wantTie)
+	}
+
+	var unknownErr *schulze.UnknownChoiceError[string]
+	if err := v.ResetChoice("D"); !errors.As(err, &unknownErr) || unknownErr.Choice != "D" {
+		t.Errorf("got error %v, want unknown choice D", err)
+	}
+}
+
+func TestElection_Reset(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:       choices,
+		VetoThreshold: 0.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.AppointProxy("alice", "bob", "general meeting"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteWithVetoes("bob", schulze.Ballot[string]{"B": 1}, []string{"A"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteTagged("carol", schulze.Ballot[string]{"A": 1}, schulze.Tags{"region": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Spoil("dave"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.DisqualifiedChoices(), []string{"A"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got disqualified choices %v, want %v", got, want)
+	}
+
+	e.Reset("restart")
+
+	if got := e.VotersCount(); got != 0 {
+		t.Errorf("got voters count %v, want 0", got)
+	}
+	for _, voter := range []string{"alice", "bob", "carol"} {
+		if _, ok := e.Record(voter); ok {
+			t.Errorf("got record of %v", voter)
+		}
+	}
+	if e.VotedByProxy("alice") {
+		t.Error("got ballot voted by proxy")
+	}
+	if got := e.Tags("carol"); got != nil {
+		t.Errorf("got tags %v", got)
+	}
+	if got := e.DisqualifiedChoices(); len(got) != 0 {
+		t.Errorf("got disqualified choices %v, want none", got)
+	}
+	if got, want := e.Voting().Preferences(), make([]int, 9); !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+	log := e.AuditLog()
+	if got := log[len(log)-1]; got.Action != schulze.AuditReset || got.Reason != "restart" || got.BallotsCount != 4 {
+		t.Errorf("got audit entry %+v", got)
+	}
+
+	// proxy appointments are kept for the restarted poll
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if !e.VotedByProxy("alice") {
+		t.Error("got no ballot voted by proxy")
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "C" {
+		t.Errorf("got winner %v, want C", results[0].Choice)
+	}
+}
+
+func TestElection_Redact_resetChoice(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	ballots := []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"C": 1, "B": 2},
+		{"B": 1, "A": 2},
+	}
+	e := schulze.NewElection[int](choices)
+	v := schulze.NewVoting(choices)
+	for i, b := range ballots {
+		if _, err := e.Vote(i, b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := e.Redact("reset", "B"); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.ResetChoice("B"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := e.Voting().Preferences(), v.Preferences(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+	// records remain consistent with the preferences
+	for i := range ballots {
+		if err := e.Unvote(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := e.Voting().Preferences(), make([]int, 9); !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v after unvote, want %v", got, want)
+	}
+}
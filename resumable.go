@@ -0,0 +1,159 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+)
+
+// StrengthsComputation is a computation of the strongest paths strengths that
+// can be paused and resumed, for very large votings where the computation has
+// to be checkpointed across process restarts. Its state is serialized with
+// the MarshalBinary method and restored with UnmarshalBinary. When the
+// computation is done, the strengths are ranked with the RankFromStrengths
+// function.
+type StrengthsComputation struct {
+	choicesCount int
+	next         int
+	strengths    []int
+}
+
+// NewStrengthsComputation initializes a computation of the strongest paths
+// strengths from the preferences, just as the PairwiseStrengths function
+// computes them. Options other than the strength variant are not used.
+func NewStrengthsComputation[C comparable](preferences []int, choices []C, opts ...Option[C]) *StrengthsComputation {
+	o := newOptions(opts)
+	if o.strengthVariant == StrengthMargins {
+		preferences = marginsPreferences(preferences, len(choices))
+	}
+	c := &StrengthsComputation{
+		choicesCount: len(choices),
+	}
+	if c.choicesCount > 0 {
+		c.strengths = initialStrengths(preferences, c.choicesCount)
+	}
+	return c
+}
+
+// Run continues the computation until it is done, or until the context is
+// done, in which case the context error is returned and the computation can
+// be resumed by calling Run again, possibly after restoring its serialized
+// state. The context is checked between iterations, each taking time
+// proportional to the square of the number of choices.
+func (c *StrengthsComputation) Run(ctx context.Context) error {
+	for !c.Done() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		c.Step()
+	}
+	return nil
+}
+
+// Step performs a single iteration of the computation, taking time
+// proportional to the square of the number of choices, and returns false if
+// the computation is done.
+func (c *StrengthsComputation) Step() bool {
+	if c.next >= c.choicesCount {
+		return false
+	}
+	strengthsIteration(c.strengths, c.choicesCount, c.next)
+	c.next++
+	return c.next < c.choicesCount
+}
+
+// Progress returns the number of completed iterations and the number of all
+// iterations, which is equal to the number of choices.
+func (c *StrengthsComputation) Progress() (done, total int) {
+	return c.next, c.choicesCount
+}
+
+// Done returns true if the computation is completed.
+func (c *StrengthsComputation) Done() bool {
+	return c.next >= c.choicesCount
+}
+
+// Strengths returns the computed strengths. They are partial if the
+// computation is not done.
+func (c *StrengthsComputation) Strengths() []int {
+	s := make([]int, len(c.strengths))
+	copy(s, c.strengths)
+	return s
+}
+
+// strengthsComputationVersion is the version of the binary encoding of the
+// StrengthsComputation state.
+const strengthsComputationVersion = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (c *StrengthsComputation) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 1+2*binary.MaxVarintLen64+len(c.strengths)*2)
+	data = append(data, strengthsComputationVersion)
+	data = binary.AppendUvarint(data, uint64(c.choicesCount))
+	data = binary.AppendUvarint(data, uint64(c.next))
+	for _, s := range c.strengths {
+		data = binary.AppendVarint(data, int64(s))
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// ErrInvalidStrengthsState is returned if the data is not valid.
+func (c *StrengthsComputation) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != strengthsComputationVersion {
+		return fmt.Errorf("%w: unsupported version", ErrInvalidStrengthsState)
+	}
+	data = data[1:]
+
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, fmt.Errorf("%w: malformed data", ErrInvalidStrengthsState)
+		}
+		data = data[n:]
+		return v, nil
+	}
+
+	choicesCount, err := readUvarint()
+	if err != nil {
+		return err
+	}
+	next, err := readUvarint()
+	if err != nil {
+		return err
+	}
+	// every strength is encoded with at least one byte
+	if choicesCount > uint64(len(data)) || choicesCount*choicesCount > uint64(len(data)) || next > choicesCount {
+		return fmt.Errorf("%w: malformed data", ErrInvalidStrengthsState)
+	}
+
+	var strengths []int
+	if choicesCount > 0 {
+		strengths = make([]int, choicesCount*choicesCount)
+	}
+	for i := range strengths {
+		v, n := binary.Varint(data)
+		if n <= 0 {
+			return fmt.Errorf("%w: malformed data", ErrInvalidStrengthsState)
+		}
+		data = data[n:]
+		s, ok := intFromInt64(v)
+		if !ok {
+			return fmt.Errorf("%w: strength does not fit in int", ErrInvalidStrengthsState)
+		}
+		strengths[i] = s
+	}
+	if len(data) != 0 {
+		return fmt.Errorf("%w: unexpected trailing data", ErrInvalidStrengthsState)
+	}
+
+	c.choicesCount = int(choicesCount)
+	c.next = int(next)
+	c.strengths = strengths
+	return nil
+}
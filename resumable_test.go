@@ -0,0 +1,122 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestStrengthsComputation(t *testing.T) {
+	choices := newChoices(40)
+
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range randomBallots(t, choices, 100) {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, opts := range [][]schulze.Option[string]{
+		nil,
+		{schulze.WithStrengthVariant[string](schulze.StrengthMargins)},
+	} {
+		want := schulze.PairwiseStrengths(preferences, choices, opts...)
+
+		c := schulze.NewStrengthsComputation(preferences, choices, opts...)
+
+		// pause the computation after every few iterations and resume it
+		// from the serialized state
+		for !c.Done() {
+			for i := 0; i < 7; i++ {
+				c.Step()
+			}
+
+			data, err := c.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			c = new(schulze.StrengthsComputation)
+			if err := c.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if done, total := c.Progress(); done != total || total != len(choices) {
+			t.Errorf("got progress %v/%v, want %v/%v", done, total, len(choices), len(choices))
+		}
+		if got := c.Strengths(); !reflect.DeepEqual(got, want) {
+			t.Errorf("got strengths %v, want %v", got, want)
+		}
+	}
+}
+
+func TestStrengthsComputation_Run(t *testing.T) {
+	choices := newChoices(10)
+
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range randomBallots(t, choices, 20) {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := schulze.NewStrengthsComputation(preferences, choices)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if done, _ := c.Progress(); done != 0 {
+		t.Errorf("got %v done iterations, want 0", done)
+	}
+
+	if err := c.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !c.Done() {
+		t.Error("computation not done")
+	}
+
+	wantResults, _, wantTie := schulze.Compute(preferences, choices)
+	results, _, tie := schulze.RankFromStrengths(c.Strengths(), choices)
+	if tie != wantTie {
+		t.Errorf("got tie %v, want %v", tie, wantTie)
+	}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("got results %+v, want %+v", results, wantResults)
+	}
+}
+
+func TestStrengthsComputation_UnmarshalBinary_invalid(t *testing.T) {
+	c := schulze.NewStrengthsComputation(schulze.NewPreferences(3), []string{"A", "B", "C"})
+	data, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "version", data: append([]byte{99}, data[1:]...)},
+		{name: "truncated", data: data[:len(data)-1]},
+		{name: "trailing", data: append(append([]byte{}, data...), 0)},
+		{name: "huge count", data: []byte{1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var c schulze.StrengthsComputation
+			if err := c.UnmarshalBinary(tc.data); !errors.Is(err, schulze.ErrInvalidStrengthsState) {
+				t.Errorf("got error %v, want %v", err, schulze.ErrInvalidStrengthsState)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// WithDescendingRanks sets the Voting to interpret ranks of ballots passed to
+// the Vote and SuggestCompletion methods in the descending order, where the
+// highest number is the most preferred, as in datasets and APIs with the "10
+// is best" convention. Ballots are translated before they are counted, so
+// Records always list the most preferred choices first. Ballots in the
+// ascending order, such as the ones returned by ParseBallot, CompressRanks
+// and the Wizard, must not be voted in such Voting without reversing them
+// with ReverseRanks.
+func WithDescendingRanks[C comparable]() Option[C] {
+	return func(o *options[C]) {
+		o.descendingRanks = true
+	}
+}
+
+// ReverseRanks translates the ballot with ranks in the descending order, where
+// the highest number is the most preferred, to the ballot with consecutive
+// ranks in the ascending order starting from one, which is the order of the
+// Ballot type. Reversing it again returns the original order of choices.
+// Choices in the LastPlace remain in the last place.
+func ReverseRanks[C comparable](b Ballot[C]) Ballot[C] {
+	return CompressRanks(descendingBallot(b))
+}
+
+// ranksOrder returns the ballot with ranks in the ascending order translated
+// to the order of ranks of ballots passed to the Vote method, such as the
+// ballots constructed from Records and scores.
+func (v *Voting[C]) ranksOrder(b Ballot[C]) Ballot[C] {
+	if !v.options.descendingRanks {
+		return b
+	}
+	return descendingBallot(b)
+}
+
+// descendingBallot returns the ballot with the order of ranks inverted
+// without an overflow, except for the LastPlace.
+func descendingBallot[C comparable](b Ballot[C]) Ballot[C] {
+	reversed := make(Ballot[C], len(b))
+	for c, rank := range b {
+		if rank == LastPlace {
+			reversed[c] = rank
+			continue
+		}
+		reversed[c] = ^rank
+	}
+	return reversed
+}
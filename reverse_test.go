@@ -0,0 +1,109 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestReverseRanks(t *testing.T) {
+	b := schulze.Ballot[string]{"A": 10, "B": 7, "C": 7, "D": -3}
+	want := schulze.Ballot[string]{"A": 1, "B": 2, "C": 2, "D": 3}
+	if got := schulze.ReverseRanks(b); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got, want := schulze.ReverseRanks(schulze.ReverseRanks(b)), schulze.CompressRanks(b); !reflect.DeepEqual(got, want) {
+		t.Errorf("got reversed twice %v, want %v", got, want)
+	}
+}
+
+func TestWithDescendingRanks(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	ballots := []schulze.Ballot[string]{
+		{"A": 10, "B": 7, "C": 7},
+		{"D": 3, "B": 1},
+		{"C": 10},
+		{},
+	}
+
+	descending := schulze.NewVoting(choices, schulze.WithDescendingRanks[string]())
+	ascending := schulze.NewVoting(choices)
+	for _, b := range ballots {
+		if _, err := descending.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ascending.Vote(schulze.ReverseRanks(b)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reflect.DeepEqual(descending.Preferences(), ascending.Preferences()) {
+		t.Errorf("got preferences %v, want %v", descending.Preferences(), ascending.Preferences())
+	}
+
+	s := descending.SuggestCompletion(schulze.Ballot[string]{"D": 5})
+	if want := ascending.SuggestCompletion(schulze.Ballot[string]{"D": 1}); !reflect.DeepEqual(s.Suggested, want.Suggested) ||
+		!reflect.DeepEqual(schulze.ReverseRanks(s.Ballot), want.Ballot) {
+		t.Errorf("got suggestion %+v, want reversed %+v", s, want)
+	}
+}
+
+func TestElection_descendingRanks(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	e := schulze.NewElection[string](choices, schulze.WithDescendingRanks[string]())
+
+	if _, err := e.VoteTagged("alice", schulze.Ballot[string]{"A": 3, "B": 2}, schulze.Tags{"region": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	r, err := e.VoteScores("bob", schulze.ScoreBallot[string]{"C": 9, "A": 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"C"}, {"A"}, {"B"}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("got scores record %v, want %v", r, want)
+	}
+	e.SetPreset("slate", schulze.Record[string]{{"B"}, {"A"}, {"C"}})
+	r, err = e.VotePreset("carol", "slate", schulze.Ballot[string]{"C": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"C"}, {"B"}, {"A"}, {}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("got preset record %v, want %v", r, want)
+	}
+
+	restored, err := schulze.RestoreElection(e.Snapshot(), schulze.WithDescendingRanks[string]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, voter := range []string{"alice", "bob", "carol"} {
+		got, _ := restored.Record(voter)
+		want, _ := e.Record(voter)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got restored record of %s %v, want %v", voter, got, want)
+		}
+	}
+
+	if _, err := e.Redact("legal", "B"); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := e.Record("alice"); !reflect.DeepEqual(got, schulze.Record[string]{{"A"}, {"B", "C"}}) {
+		t.Errorf("got redacted record %v", got)
+	}
+	_, segments, err := e.ComputeBy("region")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := schulze.NewVoting(choices)
+	if _, err := v.Vote(schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	want, _, _ := v.Compute()
+	if got := segments["north"].Results; !reflect.DeepEqual(got, want) {
+		t.Errorf("got segment results %+v, want %+v", got, want)
+	}
+}
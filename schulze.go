@@ -8,6 +8,7 @@ package schulze
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"unsafe"
 )
@@ -25,6 +26,14 @@ func NewPreferences(choicesLength int) []int {
 // have the same rank. Ranks do not have to be in consecutive order.
 type Ballot[C comparable] map[C]int
 
+// LastPlace is the rank of choices that are ranked strictly below all other
+// choices, including the ones that are not ranked by the ballot, to express
+// preferences such as "anyone but X" with an otherwise partial ballot.
+// Choices that are not ranked by such ballot are ranked equally above the
+// last place in its Record. Multiple choices in the last place are ranked
+// equally.
+const LastPlace = math.MaxInt
+
 // Record represents a single vote with ranked choices. It is a list of Ballot
 // values. The first ballot is the list with the first choices, the second
 // ballot is the list with the second choices, and so on. The last ballot is the
@@ -33,20 +42,27 @@ type Record[C comparable] [][]C
 
 // Vote updates the preferences passed as the first argument with the Ballot
 // values. A record of a complete and normalized preferences is returned that
-// can be used to unvote.
+// can be used to unvote. ErrTallyOverflow is returned, and the preferences
+// are not changed, if a preference would exceed the maximal int value, which
+// is possible only for huge electorates on 32-bit platforms.
 func Vote[C comparable](preferences []int, choices []C, b Ballot[C]) (Record[C], error) {
 	ranks, choicesCount, hasUnrankedChoices, err := ballotRanks(choices, b)
 	if err != nil {
 		return nil, fmt.Errorf("ballot ranks: %w", err)
 	}
 
+	// bitwise or of the incremented preferences, which is negative if any
+	// of them wrapped to the minimal int value on overflow
+	var incremented int
 	for rank, choices1 := range ranks {
 		rest := ranks[rank+1:]
 		for _, i := range choices1 {
 			icc := int(i) * choicesCount
 			for _, choices1 := range rest {
 				for _, j := range choices1 {
-					preferences[icc+int(j)] += 1
+					p := preferences[icc+int(j)] + 1
+					preferences[icc+int(j)] = p
+					incremented |= p
 				}
 			}
 		}
@@ -63,7 +79,9 @@ func Vote[C comparable](preferences []int, choices []C, b Ballot[C]) (Record[C],
 		if ranksLen > 0 {
 			for _, choices1 := range ranks[:ranksLen-1] {
 				for _, i := range choices1 {
-					preferences[int(i)*choicesCount+int(i)] += 1
+					p := preferences[int(i)*choicesCount+int(i)] + 1
+					preferences[int(i)*choicesCount+int(i)] = p
+					incremented |= p
 				}
 			}
 		}
@@ -71,10 +89,68 @@ func Vote[C comparable](preferences []int, choices []C, b Ballot[C]) (Record[C],
 		// all choices are ranked, tread diagonal values as a single not ranked
 		// choice, deprioritizing them for all existing choices
 		for i := 0; i < choicesCount; i++ {
-			preferences[int(i)*choicesCount+int(i)] += 1
+			p := preferences[int(i)*choicesCount+int(i)] + 1
+			preferences[int(i)*choicesCount+int(i)] = p
+			incremented |= p
+		}
+	}
+
+	r := ranksRecord(choices, ranks, hasUnrankedChoices)
+	if incremented < 0 && hasWrappedPreferences(preferences) {
+		// decrementing the wrapped preferences restores them
+		if err := Unvote(preferences, choices, r); err != nil {
+			return nil, err
 		}
+		return nil, ErrTallyOverflow
+	}
+	return r, nil
+}
+
+// voteFunc is the same as Vote, but instead of updating the preferences slice,
+// it calls the increment function with the index of every preferences element
+// that the Ballot increments. Vote does not use it as a function call for
+// every increment is noticeably slower for large ballots.
+func voteFunc[C comparable](choices []C, b Ballot[C], increment func(index int)) (Record[C], error) {
+	ranks, choicesCount, hasUnrankedChoices, err := ballotRanks(choices, b)
+	if err != nil {
+		return nil, fmt.Errorf("ballot ranks: %w", err)
 	}
 
+	for rank, choices1 := range ranks {
+		rest := ranks[rank+1:]
+		for _, i := range choices1 {
+			icc := int(i) * choicesCount
+			for _, choices1 := range rest {
+				for _, j := range choices1 {
+					increment(icc + int(j))
+				}
+			}
+		}
+	}
+
+	ranksLen := len(ranks)
+
+	if hasUnrankedChoices {
+		if ranksLen > 0 {
+			for _, choices1 := range ranks[:ranksLen-1] {
+				for _, i := range choices1 {
+					increment(int(i)*choicesCount + int(i))
+				}
+			}
+		}
+	} else {
+		for i := 0; i < choicesCount; i++ {
+			increment(int(i)*choicesCount + int(i))
+		}
+	}
+
+	return ranksRecord(choices, ranks, hasUnrankedChoices), nil
+}
+
+// ranksRecord constructs the Record from ballot ranks.
+func ranksRecord[C comparable](choices []C, ranks [][]choiceIndex, hasUnrankedChoices bool) Record[C] {
+	ranksLen := len(ranks)
+
 	// prepare results capacity to avoid allocation on appending the potential
 	// unranked choices
 	resultsCap := ranksLen
@@ -96,16 +172,25 @@ func Vote[C comparable](preferences []int, choices []C, b Ballot[C]) (Record[C],
 		r = append(r, make([]C, 0))
 	}
 
-	return r, nil
+	return r
 }
 
 // Unvote removes the Ballot values from the preferences.
 func Unvote[C comparable](preferences []int, choices []C, r Record[C]) error {
+	unvoteFunc(choices, r, func(index int) {
+		preferences[index] -= 1
+	})
+	return nil
+}
+
+// unvoteFunc calls the decrement function with the index of every preferences
+// element that the Record decrements.
+func unvoteFunc[C comparable](choices []C, r Record[C], decrement func(index int)) {
 	choicesCount := len(choices)
 
 	recordLength := len(r)
 	if recordLength == 0 {
-		return nil
+		return
 	}
 
 	for rank, choices1 := range r {
@@ -121,7 +206,7 @@ func Unvote[C comparable](preferences []int, choices []C, r Record[C]) error {
 					if j < 0 {
 						continue
 					}
-					preferences[int(i)*choicesCount+int(j)] -= 1
+					decrement(int(i)*choicesCount + int(j))
 				}
 			}
 		}
@@ -139,7 +224,7 @@ func Unvote[C comparable](preferences []int, choices []C, r Record[C]) error {
 			if i < 0 {
 				continue
 			}
-			preferences[int(i)*choicesCount+int(i)] -= 1
+			decrement(int(i)*choicesCount + int(i))
 			knownChoices.set(uint64(i))
 			rankedChoices.set(uint64(i))
 		}
@@ -158,13 +243,11 @@ func Unvote[C comparable](preferences []int, choices []C, r Record[C]) error {
 		if rankedChoices.isSet(i) {
 			for j := uint64(0); int(j) < choicesCount; j++ {
 				if !knownChoices.isSet(j) {
-					preferences[int(i)*choicesCount+int(j)] -= 1
+					decrement(int(i)*choicesCount + int(j))
 				}
 			}
 		}
 	}
-
-	return nil
 }
 
 // SetChoices updates the preferences passed as the first argument by changing
@@ -175,6 +258,10 @@ func SetChoices[C comparable](preferences []int, current, updated []C) []int {
 	currentLength := len(current)
 	updatedLength := len(updated)
 	updatedPreferences := NewPreferences(updatedLength)
+	if isAppended(current, updated) {
+		appendPreferences(updatedPreferences, preferences, currentLength, updatedLength)
+		return updatedPreferences
+	}
 	for iUpdated := 0; iUpdated < updatedLength; iUpdated++ {
 		iCurrent := int(getChoiceIndex(current, updated[iUpdated]))
 		for j := 0; j < updatedLength; j++ {
@@ -217,6 +304,12 @@ type Result[C comparable] struct {
 	// in pairwise comparisons to other choices votings. Strength does not
 	// effect the winner, and may be less then the Strength of the choice with
 	// more wins.
+	//
+	// Strength and Advantage are summed in 128 bits, so that they are exact
+	// whenever the sum fits in int, and they are saturated at the maximal
+	// int value otherwise, which is possible only for huge electorates on
+	// 32-bit platforms. Results are ordered by the exact sums. BigVoting
+	// provides exact values of any size.
 	Strength int
 	// Total number of preferred votes (difference between votes of the winner
 	// choice and the opponent choice) in the weakest link of the strongest path
@@ -231,13 +324,52 @@ type Result[C comparable] struct {
 // Compute calculates a sorted list of choices with the total number of wins for
 // each of them by reading preferences data previously populated by the Vote
 // function. If there are multiple winners, tie boolean parameter is true.
-func Compute[C comparable](preferences []int, choices []C) (results []Result[C], duels DuelsIterator[C], tie bool) {
-	strengths := calculatePairwiseStrengths(choices, preferences)
-	results, tie = calculateResults(choices, strengths)
+//
+// With no choices, Compute returns no results and no tie. Preferences must
+// be initialized for the choices by NewPreferences, and Compute panics with
+// the error of the ValidatePreferences function otherwise.
+func Compute[C comparable](preferences []int, choices []C, opts ...Option[C]) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	return RankFromStrengths(PairwiseStrengths(preferences, choices, opts...), choices, opts...)
+}
+
+// PairwiseStrengths calculates the strengths of the strongest paths between
+// every pair of choices from the preferences, which is the first step of the
+// Compute function. The returned strengths have the same layout as the
+// preferences, where the value at index i*len(choices)+j is the strength of
+// the strongest path from the choice i to the choice j. Values on the diagonal
+// are not relevant. Just as Compute, it panics if the preferences are not
+// initialized for the choices.
+func PairwiseStrengths[C comparable](preferences []int, choices []C, opts ...Option[C]) (strengths []int) {
+	if err := validatePreferencesCount(preferences, len(choices)); err != nil {
+		panic(err)
+	}
+	return pathStrengths(choices, preferences, newOptions(opts))
+}
+
+// RankFromStrengths calculates a sorted list of choices with the total number
+// of wins for each of them from the strengths returned by the
+// PairwiseStrengths function, which is the second step of the Compute
+// function. Strengths may be transformed or cached before ranking. If there
+// are multiple winners, tie boolean parameter is true.
+func RankFromStrengths[C comparable](strengths []int, choices []C, opts ...Option[C]) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	results, tie = calculateResults(choices, strengths, newOptions(opts))
 	return results, newDuelsIterator(choices, strengths), tie
 }
 
-// DuelsIterator is a function that returns the next Duel ordered by the choice indexes.
+// ComputeWithProgress is the same as Compute, but it calls the progress
+// function during the calculation of the strongest paths strengths, which
+// takes the most time for votings with a large number of choices. The done
+// argument is the number of completed iterations and total is the number of
+// all iterations, which is equal to the number of choices.
+func ComputeWithProgress[C comparable](preferences []int, choices []C, progress func(done, total int), opts ...Option[C]) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	return Compute(preferences, choices, append(opts[:len(opts):len(opts)], withProgress[C](progress))...)
+}
+
+// DuelsIterator is a function that returns the next Duel ordered by the choice
+// indexes. The order is stable, every pair of choices is returned once, with
+// the choice with the lower index as the Left choice, ordered by the Left
+// choice index and then by the Right choice index. Nil is returned when there
+// are no more duels.
 type DuelsIterator[C comparable] func() *Duel[C]
 
 func newDuelsIterator[C comparable](choices []C, strengths []int) (duels DuelsIterator[C]) {
@@ -366,7 +498,12 @@ func ballotRanks[C comparable](choices []C, b Ballot[C]) (ranks [][]choiceIndex,
 				unranked = append(unranked, choiceIndex(i))
 			}
 		}
-		if len(unranked) > 0 {
+		if n := len(rankNumbers); n > 0 && rankNumbers[n-1] == LastPlace {
+			// unranked choices are ranked equally above the last place
+			last := ranks[n-1]
+			ranks = append(ranks[:n-1], unranked, last)
+			hasUnrankedChoices = false
+		} else if len(unranked) > 0 {
 			ranks = append(ranks, unranked)
 		}
 	}
@@ -376,13 +513,35 @@ func ballotRanks[C comparable](choices []C, b Ballot[C]) (ranks [][]choiceIndex,
 
 const intSize = unsafe.Sizeof(int(0))
 
-func calculatePairwiseStrengths[C comparable](choices []C, preferences []int) []int {
-	choicesCount := uintptr(len(choices))
+func calculatePairwiseStrengths[C comparable](choices []C, preferences []int, layout StrengthsLayout, progress func(done, total int)) []int {
+	choicesCount := len(choices)
 
 	if choicesCount == 0 {
 		return nil
 	}
 
+	strengths := initialStrengths(preferences, choicesCount)
+
+	if useTiledLayout(layout, strengths, choicesCount) {
+		tiledStrengths(strengths, choicesCount, progress)
+		return strengths
+	}
+
+	for i := 0; i < choicesCount; i++ {
+		strengthsIteration(strengths, choicesCount, i)
+
+		if progress != nil {
+			progress(i+1, choicesCount)
+		}
+	}
+
+	return strengths
+}
+
+// initialStrengths returns the strengths of direct links between choices.
+func initialStrengths(preferences []int, count int) []int {
+	choicesCount := uintptr(count)
+
 	strengths := make([]int, choicesCount*choicesCount)
 
 	strengthsPtr := unsafe.Pointer(&strengths[0])
@@ -401,38 +560,46 @@ func calculatePairwiseStrengths[C comparable](choices []C, preferences []int) []
 		}
 	}
 
+	return strengths
+}
+
+// strengthsIteration performs a single iteration of the outer loop of the
+// Floyd–Warshall algorithm, widening paths through the choice with the index
+// i.
+func strengthsIteration(strengths []int, count, index int) {
+	choicesCount := uintptr(count)
+	i := uintptr(index)
+
+	strengthsPtr := unsafe.Pointer(&strengths[0])
+
 	// optimize most inner loop by loop unrolling
 	const step = 8
 
-	for i := uintptr(0); i < choicesCount; i++ {
-		icc := i * choicesCount
-
-		for j := uintptr(0); j < choicesCount; j++ {
-			jcc := j * choicesCount
-			ji := jcc + i
-			jip := *(*int)(unsafe.Add(strengthsPtr, ji*intSize))
-
-			ccMod := choicesCount % step
-			cc := choicesCount - ccMod
-			end := cc + icc
-			for ik, jk := icc, jcc; ik < end; ik, jk = ik+step, jk+step {
-				setStrengthValue(strengthsPtr, ik, jk, jip)
-				setStrengthValue(strengthsPtr, ik+1, jk+1, jip)
-				setStrengthValue(strengthsPtr, ik+2, jk+2, jip)
-				setStrengthValue(strengthsPtr, ik+3, jk+3, jip)
-				setStrengthValue(strengthsPtr, ik+4, jk+4, jip)
-				setStrengthValue(strengthsPtr, ik+5, jk+5, jip)
-				setStrengthValue(strengthsPtr, ik+6, jk+6, jip)
-				setStrengthValue(strengthsPtr, ik+7, jk+7, jip)
-			}
-			end = choicesCount + icc
-			for ik, jk := cc+icc, cc+jcc; ik < end; ik, jk = ik+1, jk+1 {
-				setStrengthValue(strengthsPtr, ik, jk, jip)
-			}
+	icc := i * choicesCount
+
+	for j := uintptr(0); j < choicesCount; j++ {
+		jcc := j * choicesCount
+		ji := jcc + i
+		jip := *(*int)(unsafe.Add(strengthsPtr, ji*intSize))
+
+		ccMod := choicesCount % step
+		cc := choicesCount - ccMod
+		end := cc + icc
+		for ik, jk := icc, jcc; ik < end; ik, jk = ik+step, jk+step {
+			setStrengthValue(strengthsPtr, ik, jk, jip)
+			setStrengthValue(strengthsPtr, ik+1, jk+1, jip)
+			setStrengthValue(strengthsPtr, ik+2, jk+2, jip)
+			setStrengthValue(strengthsPtr, ik+3, jk+3, jip)
+			setStrengthValue(strengthsPtr, ik+4, jk+4, jip)
+			setStrengthValue(strengthsPtr, ik+5, jk+5, jip)
+			setStrengthValue(strengthsPtr, ik+6, jk+6, jip)
+			setStrengthValue(strengthsPtr, ik+7, jk+7, jip)
+		}
+		end = choicesCount + icc
+		for ik, jk := cc+icc, cc+jcc; ik < end; ik, jk = ik+1, jk+1 {
+			setStrengthValue(strengthsPtr, ik, jk, jip)
 		}
 	}
-
-	return strengths
 }
 
 func setStrengthValue(strengthsPtr unsafe.Pointer, ik, jk uintptr, jip int) {
@@ -448,14 +615,17 @@ func setStrengthValue(strengthsPtr unsafe.Pointer, ik, jk uintptr, jip int) {
 	}
 }
 
-func calculateResults[C comparable](choices []C, strengths []int) (results []Result[C], tie bool) {
+func calculateResults[C comparable](choices []C, strengths []int, o options[C]) (results []Result[C], tie bool) {
 	choicesCount := len(choices)
 	results = make([]Result[C], 0, choicesCount)
+	// exact strength sums by the choice index, as the Strength field may be
+	// saturated
+	strengthSums := make([]wideSum, choicesCount)
 
 	for i := 0; i < choicesCount; i++ {
 		var wins int
-		var strength int
-		var advantage int
+		var strength wideSum
+		var advantage wideSum
 
 		for j := 0; j < choicesCount; j++ {
 			if i != j {
@@ -463,17 +633,18 @@ func calculateResults[C comparable](choices []C, strengths []int) (results []Res
 				sji := strengths[j*choicesCount+i]
 				if sij > sji {
 					wins++
-					strength += sij
-					advantage += sij - sji
+					strength.add(sij)
+					advantage.add(sij - sji)
 				}
 			}
 		}
+		strengthSums[i] = strength
 		results = append(results, Result[C]{
 			Choice:    choices[i],
 			Index:     i,
 			Wins:      wins,
-			Strength:  strength,
-			Advantage: advantage,
+			Strength:  strength.int(),
+			Advantage: advantage.int(),
 		})
 	}
 
@@ -481,8 +652,13 @@ func calculateResults[C comparable](choices []C, strengths []int) (results []Res
 		if results[i].Wins != results[j].Wins {
 			return results[i].Wins > results[j].Wins
 		}
-		if results[i].Strength != results[j].Strength {
-			return results[i].Strength > results[j].Strength
+		if c := strengthSums[results[i].Index].cmp(strengthSums[results[j].Index]); c != 0 {
+			return c > 0
+		}
+		if o.collation != nil {
+			if c := o.collation(results[i].Choice, results[j].Choice); c != 0 {
+				return c < 0
+			}
 		}
 		return results[i].Index < results[j].Index
 	})
@@ -491,6 +667,11 @@ func calculateResults[C comparable](choices []C, strengths []int) (results []Res
 		tie = results[0].Wins == results[1].Wins
 	}
 
+	if tie && o.tieBreak != TieBreakNone {
+		breakTie(results, o)
+		tie = false
+	}
+
 	return results, tie
 }
 
@@ -7,10 +7,13 @@ package schulze_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"reflect"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -580,6 +583,102 @@ func TestUnvote_afterSetChoices(t *testing.T) {
 	})
 }
 
+func TestVote_lastPlace(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+
+	for _, tc := range []struct {
+		name   string
+		ballot schulze.Ballot[string]
+		same   schulze.Ballot[string]
+		record schulze.Record[string]
+	}{
+		{
+			name:   "anyone but",
+			ballot: schulze.Ballot[string]{"D": schulze.LastPlace},
+			same:   schulze.Ballot[string]{"A": 1, "B": 1, "C": 1, "D": 2},
+			record: schulze.Record[string]{{"A", "B", "C"}, {"D"}, {}},
+		},
+		{
+			name:   "partial",
+			ballot: schulze.Ballot[string]{"B": 1, "C": schulze.LastPlace, "D": schulze.LastPlace},
+			same:   schulze.Ballot[string]{"B": 1, "A": 2, "C": 3, "D": 3},
+			record: schulze.Record[string]{{"B"}, {"A"}, {"C", "D"}, {}},
+		},
+		{
+			name:   "complete",
+			ballot: schulze.Ballot[string]{"A": 1, "B": 2, "C": 2, "D": schulze.LastPlace},
+			same:   schulze.Ballot[string]{"A": 1, "B": 2, "C": 2, "D": 3},
+			record: schulze.Record[string]{{"A"}, {"B", "C"}, {"D"}, {}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			preferences := schulze.NewPreferences(len(choices))
+			r, err := schulze.Vote(preferences, choices, tc.ballot)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, group := range r {
+				sort.Strings(group)
+			}
+			if !reflect.DeepEqual(r, tc.record) {
+				t.Errorf("got record %v, want %v", r, tc.record)
+			}
+			want := schulze.NewPreferences(len(choices))
+			if _, err := schulze.Vote(want, choices, tc.same); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(preferences, want) {
+				t.Errorf("got preferences %v, want %v", preferences, want)
+			}
+			if err := schulze.Unvote(preferences, choices, r); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(preferences, schulze.NewPreferences(len(choices))) {
+				t.Errorf("got preferences %v after unvote", preferences)
+			}
+		})
+	}
+}
+
+func TestVote_overflow(t *testing.T) {
+	choices := []string{"A", "B"}
+
+	preferences := []int{0, math.MaxInt, 0, 0}
+	if _, err := schulze.Vote(preferences, choices, schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrTallyOverflow) {
+		t.Fatalf("got error %v, want %v", err, schulze.ErrTallyOverflow)
+	}
+	if want := []int{0, math.MaxInt, 0, 0}; !reflect.DeepEqual(preferences, want) {
+		t.Errorf("got preferences %v, want %v", preferences, want)
+	}
+
+	for _, tc := range []struct {
+		name string
+		opts []schulze.Option[string]
+	}{
+		{name: "default"},
+		{name: "incremental", opts: []schulze.Option[string]{schulze.WithIncrementalCompute[string]()}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v := schulze.NewVoting(choices, tc.opts...)
+			if err := v.ImportPairwise([][]int{{0, math.MaxInt}, {0, 0}}); err != nil {
+				t.Fatal(err)
+			}
+			v.Compute()
+
+			if _, err := v.Vote(schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrTallyOverflow) {
+				t.Fatalf("got error %v, want %v", err, schulze.ErrTallyOverflow)
+			}
+			if got, want := v.Preferences(), []int{0, math.MaxInt, 0, 0}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got preferences %v, want %v", got, want)
+			}
+			results, _, _ := v.Compute()
+			if results[0].Choice != "A" || results[0].Strength != math.MaxInt {
+				t.Errorf("got result %+v", results[0])
+			}
+		})
+	}
+}
+
 func TestDuel_Outcome(t *testing.T) {
 	t.Run("tie", func(t *testing.T) {
 		winner, defeated := schulze.Duel[string]{
@@ -0,0 +1,86 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// ScoreBallot represents a single vote with scores, such as star ratings,
+// given to choices. Higher number represents the better score. Not all choices
+// have to be scored and multiple choices can have the same score.
+type ScoreBallot[C comparable] map[C]int
+
+// Ballot converts scores to a ranked Ballot, where choices with higher scores
+// have higher ranks and choices with the same score have the same rank.
+// Choices that are not scored are not ranked.
+func (s ScoreBallot[C]) Ballot() Ballot[C] {
+	b := make(Ballot[C], len(s))
+	for c, score := range s {
+		// lower rank number represents the higher rank
+		b[c] = -score
+	}
+	return b
+}
+
+// ChoiceScore holds aggregated raw scores of a single choice.
+type ChoiceScore[C comparable] struct {
+	// The choice value.
+	Choice C
+	// 0-based ordinal number of the choice in the choice slice.
+	Index int
+	// Number of ballots that scored the choice.
+	Count int
+	// Sum of all scores of the choice.
+	Sum int
+	// Average score of the choice by ballots that scored it, or zero if no
+	// ballot scored the choice.
+	Average float64
+}
+
+// VoteScores adds or replaces the voter's ballot with the ranked Ballot
+// converted from the scores. Raw scores are retained for reporting by the
+// AverageScores method.
+func (e *Election[V, C]) VoteScores(voter V, s ScoreBallot[C]) (Record[C], error) {
+	r, err := e.Vote(voter, e.voting.ranksOrder(s.Ballot()))
+	if err != nil {
+		return nil, err
+	}
+	scores := make(ScoreBallot[C], len(s))
+	for c, score := range s {
+		scores[c] = score
+	}
+	e.scores[voter] = scores
+	return r, nil
+}
+
+// AverageScores returns aggregated raw scores of ballots voted with the
+// VoteScores method for every choice, in the order of choices. Scores of
+// choices that are no longer in the election are not included. ErrSealed is
+// returned if the results of the election are sealed.
+func (e *Election[V, C]) AverageScores() ([]ChoiceScore[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, err
+	}
+	choices := e.voting.choices
+	scores := make([]ChoiceScore[C], len(choices))
+	for i, c := range choices {
+		scores[i].Choice = c
+		scores[i].Index = i
+	}
+	for _, s := range e.scores {
+		for c, score := range s {
+			i := getChoiceIndex(choices, c)
+			if i < 0 {
+				continue
+			}
+			scores[i].Count++
+			scores[i].Sum += score
+		}
+	}
+	for i := range scores {
+		if scores[i].Count > 0 {
+			scores[i].Average = float64(scores[i].Sum) / float64(scores[i].Count)
+		}
+	}
+	return scores, nil
+}
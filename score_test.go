@@ -0,0 +1,69 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestScoreBallot_Ballot(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+
+	r, err := schulze.Vote(schulze.NewPreferences(len(choices)), choices, schulze.ScoreBallot[string]{
+		"A": 3,
+		"B": 5,
+		"C": 3,
+	}.Ballot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"B"}, {"A", "C"}, {"D"}}); !reflect.DeepEqual(sortedRecord(r), want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+}
+
+func TestElection_AverageScores(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	for voter, s := range map[string]schulze.ScoreBallot[string]{
+		"alice": {"A": 5, "B": 2},
+		"bob":   {"A": 4, "B": 3},
+		"carol": {"B": 1},
+		"dave":  {"C": 5},
+	} {
+		if _, err := e.VoteScores(voter, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// ranked vote replaces scores
+	if _, err := e.Vote("dave", schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []schulze.ChoiceScore[string]{
+		{Choice: "A", Index: 0, Count: 2, Sum: 9, Average: 4.5},
+		{Choice: "B", Index: 1, Count: 3, Sum: 6, Average: 2},
+		{Choice: "C", Index: 2, Count: 0, Sum: 0, Average: 0},
+	}
+	got, err := e.AverageScores()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got scores %+v, want %+v", got, want)
+	}
+
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "A" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// UnsealTokenHash returns the hex encoded SHA-256 hash of the unseal token,
+// as it is expected in the ElectionConfig UnsealTokenHash field.
+func UnsealTokenHash(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// Sealed returns true if the results of the election are not available.
+func (e *Election[V, C]) Sealed() bool {
+	return e.checkSealed() != nil
+}
+
+// Unseal makes the results of a sealed election available before it is closed
+// if the token matches the configured unseal token hash. ErrInvalidUnsealToken
+// is returned otherwise.
+func (e *Election[V, C]) Unseal(token string) error {
+	want, err := hex.DecodeString(e.config.UnsealTokenHash)
+	if err != nil || len(want) == 0 {
+		return ErrInvalidUnsealToken
+	}
+	got := sha256.Sum256([]byte(token))
+	if subtle.ConstantTimeCompare(got[:], want) != 1 {
+		return ErrInvalidUnsealToken
+	}
+	e.unsealed = true
+	return nil
+}
+
+// checkSealed returns ErrSealed if the results of the election are sealed.
+func (e *Election[V, C]) checkSealed() error {
+	if !e.config.Sealed || e.unsealed {
+		return nil
+	}
+	if closes := e.config.Schedule.Closes; closes != nil && !e.now().Before(*closes) {
+		return nil
+	}
+	return ErrSealed
+}
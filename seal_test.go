@@ -0,0 +1,97 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_sealed(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:         []string{"A", "B", "C"},
+		Sealed:          true,
+		UnsealTokenHash: schulze.UnsealTokenHash("secret"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !e.Sealed() {
+		t.Error("election is not sealed")
+	}
+	if _, _, _, err := e.Compute(); !errors.Is(err, schulze.ErrSealed) {
+		t.Errorf("got compute error %v, want %v", err, schulze.ErrSealed)
+	}
+	if _, _, err := e.ComputeBy("region"); !errors.Is(err, schulze.ErrSealed) {
+		t.Errorf("got compute by error %v, want %v", err, schulze.ErrSealed)
+	}
+	if _, err := e.ResultDocument(); !errors.Is(err, schulze.ErrSealed) {
+		t.Errorf("got result document error %v, want %v", err, schulze.ErrSealed)
+	}
+	if _, err := e.ApprovalCounts(); !errors.Is(err, schulze.ErrSealed) {
+		t.Errorf("got approval counts error %v, want %v", err, schulze.ErrSealed)
+	}
+	if p := e.Preview(1); len(p.Places) != 1 || p.Places[0][0] != "A" {
+		t.Errorf("got preview %+v", p)
+	}
+
+	if err := e.Unseal("guess"); !errors.Is(err, schulze.ErrInvalidUnsealToken) {
+		t.Errorf("got unseal error %v, want %v", err, schulze.ErrInvalidUnsealToken)
+	}
+	if _, _, _, err := e.Compute(); !errors.Is(err, schulze.ErrSealed) {
+		t.Errorf("got compute error %v, want %v", err, schulze.ErrSealed)
+	}
+
+	if err := e.Unseal("secret"); err != nil {
+		t.Fatal(err)
+	}
+	if e.Sealed() {
+		t.Error("election is sealed")
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "A" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+	}
+}
+
+func TestElection_sealedUntilClosed(t *testing.T) {
+	closed := time.Now().Add(-time.Minute)
+
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:  []string{"A", "B"},
+		Sealed:   true,
+		Schedule: schulze.Schedule{Closes: &closed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Sealed() {
+		t.Error("closed election is sealed")
+	}
+	if _, _, _, err := e.Compute(); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unseal("secret"); !errors.Is(err, schulze.ErrInvalidUnsealToken) {
+		t.Errorf("got unseal error %v, want %v", err, schulze.ErrInvalidUnsealToken)
+	}
+
+	if _, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B"},
+		Sealed:  true,
+	}); !errors.Is(err, schulze.ErrInvalidElectionConfig) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidElectionConfig)
+	}
+}
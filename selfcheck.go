@@ -0,0 +1,77 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ConsistencyError is returned by the Election SelfCheck method when the
+// preferences re-derived from the Records of voters' ballots differ from the
+// live preferences of the election.
+type ConsistencyError struct {
+	// State hash of the preferences re-derived from the ballots.
+	JournalHash []byte
+	// State hash of the live preferences.
+	StateHash []byte
+}
+
+func (e *ConsistencyError) Error() string {
+	return fmt.Sprintf("schulze: election state %s diverges from the ballots journal state %s", hex.EncodeToString(e.StateHash), hex.EncodeToString(e.JournalHash))
+}
+
+// SelfCheck re-derives the preferences from the Records of all voters'
+// ballots and compares their state hash with the state hash of the live
+// preferences of the election, returning the ConsistencyError if they
+// differ. It is intended to detect corruption of the election state before
+// the results are certified.
+func (e *Election[V, C]) SelfCheck() error {
+	choices := e.voting.choices
+	journal := NewPreferences(len(choices))
+	for _, r := range e.records {
+		// ballot is constructed only from the known choices so the error is
+		// not possible
+		_, _ = Vote(journal, choices, recordBallot(r, choices))
+	}
+	journalHash := stateHash(choices, journal)
+	if h := e.voting.StateHash(); !bytes.Equal(h, journalHash) {
+		return &ConsistencyError{
+			JournalHash: journalHash,
+			StateHash:   h,
+		}
+	}
+	return nil
+}
+
+// RunSelfCheck calls the SelfCheck method every interval until the context
+// is done, calling the onError function with every returned error. As methods
+// on the Election type are not safe for concurrent calls, the self-check
+// holds the lock while it runs, which must be the same lock that guards all
+// other calls to the election. RunSelfCheck blocks and it is intended to be
+// called in a separate goroutine.
+func (e *Election[V, C]) RunSelfCheck(ctx context.Context, interval time.Duration, lock sync.Locker, onError func(err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		lock.Lock()
+		err := e.SelfCheck()
+		lock.Unlock()
+		if err != nil && onError != nil {
+			onError(err)
+		}
+	}
+}
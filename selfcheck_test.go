@@ -0,0 +1,100 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_SelfCheck(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"A": 1, "B": 2},
+		"bob":   {"C": 1},
+		"carol": {"B": 1, "A": 1},
+	} {
+		if _, err := e.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := e.Unvote("bob"); err != nil {
+		t.Fatal(err)
+	}
+	e.SetChoices([]string{"D", "A", "B"})
+	if _, err := e.Vote("dave", schulze.Ballot[string]{"D": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := e.SelfCheck(); err != nil {
+		t.Fatal(err)
+	}
+
+	// tally a ballot that is not in the journal
+	if _, err := e.Voting().Vote(schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := e.SelfCheck()
+	var cerr *schulze.ConsistencyError
+	if !errors.As(err, &cerr) {
+		t.Fatalf("got error %v, want ConsistencyError", err)
+	}
+	if !bytes.Equal(cerr.StateHash, e.StateHash()) {
+		t.Errorf("got state hash %x, want %x", cerr.StateHash, e.StateHash())
+	}
+	if bytes.Equal(cerr.JournalHash, cerr.StateHash) {
+		t.Error("got equal journal and state hashes")
+	}
+}
+
+func TestElection_RunSelfCheck(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B"})
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	mu.Lock()
+	if _, err := e.Voting().Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.RunSelfCheck(ctx, time.Millisecond, &mu, func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		})
+	}()
+
+	select {
+	case err := <-errs:
+		var cerr *schulze.ConsistencyError
+		if !errors.As(err, &cerr) {
+			t.Errorf("got error %v, want ConsistencyError", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Error("timeout waiting for the self-check error")
+	}
+
+	cancel()
+	<-done
+}
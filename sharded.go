@@ -0,0 +1,92 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ShardedPreferences holds pairwise preferences split into multiple shards,
+// each guarded by its own lock, so that Vote and Unvote methods can be called
+// concurrently from many goroutines with low lock contention. Shards are
+// merged only when preferences are read. Choices can not be changed.
+type ShardedPreferences[C comparable] struct {
+	choices []C
+	shards  []preferencesShard
+	next    atomic.Uint64
+}
+
+type preferencesShard struct {
+	mu          sync.Mutex
+	preferences []int
+}
+
+// NewShardedPreferences initializes preferences for the provided choices
+// split into the provided number of shards. If the number of shards is not
+// positive, the value of runtime.GOMAXPROCS is used.
+func NewShardedPreferences[C comparable](choices []C, shardsCount int) *ShardedPreferences[C] {
+	if shardsCount <= 0 {
+		shardsCount = runtime.GOMAXPROCS(0)
+	}
+	shards := make([]preferencesShard, shardsCount)
+	for i := range shards {
+		shards[i].preferences = NewPreferences(len(choices))
+	}
+	return &ShardedPreferences[C]{
+		choices: choices,
+		shards:  shards,
+	}
+}
+
+// Vote adds the Ballot values to one of the shards. A record of a complete and
+// normalized preferences is returned that can be used to unvote. It is safe to
+// call this method concurrently.
+func (p *ShardedPreferences[C]) Vote(b Ballot[C]) (Record[C], error) {
+	s := p.shard()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return Vote(s.preferences, p.choices, b)
+}
+
+// Unvote removes the Record values from one of the shards. As shard values are
+// summed, the shard does not have to be the one that the ballot was voted
+// into. It is safe to call this method concurrently.
+func (p *ShardedPreferences[C]) Unvote(r Record[C]) error {
+	s := p.shard()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return Unvote(s.preferences, p.choices, r)
+}
+
+// Preferences returns a new preferences slice with the merged values of all
+// shards.
+func (p *ShardedPreferences[C]) Preferences() []int {
+	preferences := NewPreferences(len(p.choices))
+	for i := range p.shards {
+		s := &p.shards[i]
+		s.mu.Lock()
+		for j, v := range s.preferences {
+			preferences[j] += v
+		}
+		s.mu.Unlock()
+	}
+	return preferences
+}
+
+// Compute calculates a sorted list of choices with the total number of wins for
+// each of them from the merged preferences of all shards. If there are
+// multiple winners, tie boolean parameter is true.
+func (p *ShardedPreferences[C]) Compute(opts ...Option[C]) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	return Compute(p.Preferences(), p.choices, opts...)
+}
+
+func (p *ShardedPreferences[C]) shard() *preferencesShard {
+	return &p.shards[(p.next.Add(1)-1)%uint64(len(p.shards))]
+}
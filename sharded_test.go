@@ -0,0 +1,90 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestShardedPreferences(t *testing.T) {
+	choices := []string{"A", "B", "C", "D", "E"}
+	ballots := randomBallots(t, choices, 1000)
+
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range ballots {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantResults, _, wantTie := schulze.Compute(preferences, choices)
+
+	p := schulze.NewShardedPreferences(choices, 4)
+
+	var wg sync.WaitGroup
+	records := make(chan schulze.Record[string], len(ballots))
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := i; j < len(ballots); j += 8 {
+				if _, err := p.Vote(ballots[j]); err != nil {
+					t.Error(err)
+				}
+				// vote and unvote an additional ballot
+				r, err := p.Vote(ballots[j])
+				if err != nil {
+					t.Error(err)
+				}
+				records <- r
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(records)
+
+	for r := range records {
+		wg.Add(1)
+		go func(r schulze.Record[string]) {
+			defer wg.Done()
+			if err := p.Unvote(r); err != nil {
+				t.Error(err)
+			}
+		}(r)
+	}
+	wg.Wait()
+
+	if got := p.Preferences(); !reflect.DeepEqual(got, preferences) {
+		t.Errorf("got preferences\n%s\nwant\n%s", sprintPreferences(choices, got), sprintPreferences(choices, preferences))
+	}
+
+	results, _, tie := p.Compute()
+	if tie != wantTie {
+		t.Errorf("got tie %v, want %v", tie, wantTie)
+	}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("got results %+v, want %+v", results, wantResults)
+	}
+}
+
+func BenchmarkShardedPreferences_Vote(b *testing.B) {
+	p := schulze.NewShardedPreferences(newChoices(1000), 0)
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := p.Vote(schulze.Ballot[string]{
+				"a": 1,
+			}); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
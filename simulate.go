@@ -0,0 +1,182 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// DefaultSimulationRuns is the number of simulated elections when the Runs
+// field of the TurnoutScenario is zero.
+const DefaultSimulationRuns = 1000
+
+// TurnoutScenario describes the voters that did not vote yet, grouped into
+// segments by the value of the tag with the Key, as set by the Election
+// VoteTagged method.
+type TurnoutScenario struct {
+	Key      string
+	Segments []TurnoutSegment
+	// Number of simulated elections. If it is zero, DefaultSimulationRuns is
+	// used.
+	Runs int
+}
+
+// TurnoutSegment describes the voters of a single segment that did not vote
+// yet.
+type TurnoutSegment struct {
+	// Value of the tag. Voters without the tag belong to the segment with the
+	// empty value.
+	Value string
+	// Number of voters of the segment that did not vote yet.
+	Remaining int
+	// Expected fraction of the remaining voters that will vote, from zero to
+	// one.
+	Turnout float64
+}
+
+// WinProbability is the estimated probability that the choice wins.
+type WinProbability[C comparable] struct {
+	Choice      C
+	Index       int
+	Probability float64
+}
+
+// SimulateTurnout extrapolates the current partial results to the turnout
+// scenario and returns the estimated probability of winning for every
+// choice, sorted from the most probable winner. In every simulated election,
+// the number of additional voters of every segment is drawn from the binomial
+// distribution of its remaining voters and turnout, and their ballots are
+// drawn from the ballots already cast by the voters of the same segment, or
+// from all ballots if nobody from the segment voted yet. All tied winners are
+// counted as winning, and suspended, disqualified and withdrawn choices do not
+// win. A choice with zero probability did not win in any
+// simulated election, which does not exclude its victory in the scenario.
+//
+// If the random source is nil, the source of the WithRandSource option or the
+// seed of the WithRandomSeed option is used. ErrInvalidTurnoutScenario is
+// returned if the scenario is not valid and ErrSealed if the results of the
+// election are sealed.
+func (e *Election[V, C]) SimulateTurnout(scenario TurnoutScenario, src rand.Source) ([]WinProbability[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, err
+	}
+	runs := scenario.Runs
+	if runs == 0 {
+		runs = DefaultSimulationRuns
+	}
+	if runs < 0 {
+		return nil, fmt.Errorf("%w: negative number of runs %v", ErrInvalidTurnoutScenario, runs)
+	}
+	for _, s := range scenario.Segments {
+		if s.Remaining < 0 {
+			return nil, fmt.Errorf("%w: segment %q: negative number of remaining voters %v", ErrInvalidTurnoutScenario, s.Value, s.Remaining)
+		}
+		if !(s.Turnout >= 0 && s.Turnout <= 1) {
+			return nil, fmt.Errorf("%w: segment %q: turnout %v", ErrInvalidTurnoutScenario, s.Value, s.Turnout)
+		}
+	}
+
+	choices := e.voting.choices
+	o := e.voting.options
+	o.progress = nil
+
+	var r *rand.Rand
+	if src != nil {
+		r = rand.New(src)
+	} else {
+		r = o.rand()
+	}
+
+	// ballots of every segment and of all voters
+	all := make([]Ballot[C], 0, len(e.records))
+	segments := make(map[string][]Ballot[C])
+	for voter, record := range e.records {
+		b := recordBallot(record, choices)
+		all = append(all, b)
+		value := e.tags[voter][scenario.Key]
+		segments[value] = append(segments[value], b)
+	}
+	// sort ballots to make simulations reproducible with the same random
+	// source as the iteration over maps is randomized
+	sortBallots(all, choices)
+	for _, ballots := range segments {
+		sortBallots(ballots, choices)
+	}
+
+	wins := make([]int, len(choices))
+	preferences := make([]int, len(e.voting.preferences))
+	// voting of the simulated preferences with the excluded choices
+	simulated := e.voting.derive(choices, preferences)
+	counts := make(map[*Ballot[C]]int)
+	for run := 0; run < runs; run++ {
+		copy(preferences, e.voting.preferences)
+		for k := range counts {
+			delete(counts, k)
+		}
+		for _, s := range scenario.Segments {
+			population := segments[s.Value]
+			if len(population) == 0 {
+				population = all
+			}
+			if len(population) == 0 {
+				continue
+			}
+			for i := 0; i < s.Remaining; i++ {
+				if r.Float64() < s.Turnout {
+					counts[&population[r.Intn(len(population))]]++
+				}
+			}
+		}
+		for b, count := range counts {
+			// ballots are constructed only from the known choices so the
+			// error is not possible
+			_, _ = voteFunc(choices, *b, func(index int) {
+				preferences[index] += count
+			})
+		}
+
+		_, results, tie := simulated.computeResults(o)
+		for _, result := range results {
+			wins[result.Index]++
+			if !tie || result.Wins != results[0].Wins {
+				break
+			}
+		}
+	}
+
+	probabilities := make([]WinProbability[C], len(choices))
+	for i, c := range choices {
+		p := 0.0
+		if runs > 0 {
+			p = float64(wins[i]) / float64(runs)
+		}
+		probabilities[i] = WinProbability[C]{
+			Choice:      c,
+			Index:       i,
+			Probability: p,
+		}
+	}
+	sort.SliceStable(probabilities, func(i, j int) bool {
+		return probabilities[i].Probability > probabilities[j].Probability
+	})
+	return probabilities, nil
+}
+
+// sortBallots sorts ballots by the ranks of the choices in the order of the
+// choices.
+func sortBallots[C comparable](ballots []Ballot[C], choices []C) {
+	sort.Slice(ballots, func(i, j int) bool {
+		for _, c := range choices {
+			ri, rj := ballots[i][c], ballots[j][c]
+			if ri != rj {
+				return ri < rj
+			}
+		}
+		return false
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_SimulateTurnout(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	for voter, v := range map[string]struct {
+		ballot schulze.Ballot[string]
+		tags   schulze.Tags
+	}{
+		"alice": {ballot: schulze.Ballot[string]{"A": 1, "B": 2}, tags: schulze.Tags{"region": "north"}},
+		"bob":   {ballot: schulze.Ballot[string]{"A": 1}, tags: schulze.Tags{"region": "north"}},
+		"carol": {ballot: schulze.Ballot[string]{"A": 1, "C": 2}, tags: schulze.Tags{"region": "north"}},
+		"dave":  {ballot: schulze.Ballot[string]{"B": 1, "A": 2}, tags: schulze.Tags{"region": "south"}},
+	} {
+		if _, err := e.VoteTagged(voter, v.ballot, v.tags); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		scenario schulze.TurnoutScenario
+		want     map[string]float64
+	}{
+		{
+			name:     "no remaining voters",
+			scenario: schulze.TurnoutScenario{Key: "region", Runs: 10},
+			want:     map[string]float64{"A": 1, "B": 0, "C": 0},
+		},
+		{
+			name: "full turnout of the south",
+			scenario: schulze.TurnoutScenario{
+				Key: "region",
+				Segments: []schulze.TurnoutSegment{
+					{Value: "south", Remaining: 10, Turnout: 1},
+					{Value: "north", Remaining: 10, Turnout: 0},
+				},
+				Runs: 10,
+			},
+			want: map[string]float64{"A": 0, "B": 1, "C": 0},
+		},
+		{
+			name: "segment without ballots",
+			scenario: schulze.TurnoutScenario{
+				Key: "region",
+				Segments: []schulze.TurnoutSegment{
+					{Value: "west", Remaining: 100, Turnout: 0.5},
+				},
+				Runs: 10,
+			},
+			want: map[string]float64{"A": 1, "B": 0, "C": 0},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			probabilities, err := e.SimulateTurnout(tc.scenario, rand.NewSource(1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]float64)
+			for _, p := range probabilities {
+				got[p.Choice] = p.Probability
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got probabilities %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	scenario := schulze.TurnoutScenario{
+		Key: "region",
+		Segments: []schulze.TurnoutSegment{
+			{Value: "south", Remaining: 4, Turnout: 0.6},
+		},
+	}
+	p1, err := e.SimulateTurnout(scenario, rand.NewSource(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := e.SimulateTurnout(scenario, rand.NewSource(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(p1, p2) {
+		t.Errorf("got different probabilities with the same source %v and %v", p1, p2)
+	}
+	if p1[0].Probability < p1[1].Probability {
+		t.Errorf("probabilities are not sorted: %v", p1)
+	}
+	if p1[0].Probability == 1 || p1[0].Probability == 0 {
+		t.Errorf("got probability %v, want an uncertain outcome", p1[0].Probability)
+	}
+
+	for _, s := range []schulze.TurnoutSegment{
+		{Value: "south", Remaining: -1},
+		{Value: "south", Remaining: 1, Turnout: 1.5},
+	} {
+		_, err := e.SimulateTurnout(schulze.TurnoutScenario{Segments: []schulze.TurnoutSegment{s}}, nil)
+		if !errors.Is(err, schulze.ErrInvalidTurnoutScenario) {
+			t.Errorf("got error %v, want %v", err, schulze.ErrInvalidTurnoutScenario)
+		}
+	}
+}
+
+func TestElection_SimulateTurnout_suspended(t *testing.T) {
+	e := newSuspendedElection(t)
+
+	probabilities, err := e.SimulateTurnout(schulze.TurnoutScenario{Key: "region", Runs: 10}, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range probabilities {
+		want := 0.0
+		if p.Choice == "B" {
+			want = 1
+		}
+		if p.Probability != want {
+			t.Errorf("got %v win probability %v, want %v", p.Choice, p.Probability, want)
+		}
+	}
+}
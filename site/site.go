@@ -0,0 +1,240 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package site generates a static HTML site with the results of an election
+// from its schulze.ResultDocument, for publishing on static hosting, such as
+// GitHub Pages or Amazon S3, with no server needed after the election is
+// closed.
+//
+// The site directory contains the following files:
+//
+//   - index.html: the ranking, pairwise preferences and strongest paths
+//     matrices, duels and charts
+//   - wins.svg: the bar chart of wins of every choice
+//   - heatmap.svg: the heatmap of pairwise margins
+//   - results.json: the result document
+package site
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/heatmap"
+)
+
+// Write generates the site of the result document in the directory, creating
+// it if it does not exist. Existing files of the site are replaced.
+func Write[C comparable](dir string, d schulze.ResultDocument[C]) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	n := len(d.Choices)
+	preferences := make([]int, 0, n*n)
+	for _, row := range d.Preferences {
+		preferences = append(preferences, row...)
+	}
+
+	for _, f := range []struct {
+		name  string
+		write func(w io.Writer) error
+	}{
+		{name: "index.html", write: func(w io.Writer) error { return writeIndex(w, d) }},
+		{name: "wins.svg", write: func(w io.Writer) error { return writeWinsChart(w, d) }},
+		{name: "heatmap.svg", write: func(w io.Writer) error { return heatmap.WriteSVG(w, preferences, d.Choices) }},
+		{name: "results.json", write: func(w io.Writer) error {
+			e := json.NewEncoder(w)
+			e.SetIndent("", "  ")
+			return e.Encode(d)
+		}},
+	} {
+		if err := writeFile(filepath.Join(dir, f.name), f.write); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+func writeFile(name string, write func(w io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := write(&buf); err != nil {
+		return err
+	}
+	return os.WriteFile(name, buf.Bytes(), 0o644)
+}
+
+type duel struct {
+	Winner, Defeated         string
+	WinnerStrength, Strength int
+	Tie                      bool
+}
+
+type page struct {
+	Name         string
+	BallotsCount int
+	Tie          bool
+	Ranking      []rankedChoice
+	Choices      []string
+	Preferences  [][]int
+	Strengths    [][]int
+	Duels        []duel
+}
+
+type rankedChoice struct {
+	Rank   int
+	Choice string
+	Wins   int
+}
+
+func writeIndex[C comparable](w io.Writer, d schulze.ResultDocument[C]) error {
+	p := page{
+		Name:         d.Name,
+		BallotsCount: d.BallotsCount,
+		Tie:          d.Tie,
+		Preferences:  d.Preferences,
+		Strengths:    d.Strengths,
+	}
+	if p.Name == "" {
+		p.Name = "Election results"
+	}
+	for _, c := range d.Choices {
+		p.Choices = append(p.Choices, fmt.Sprint(c))
+	}
+	for _, r := range d.Ranking {
+		p.Ranking = append(p.Ranking, rankedChoice{
+			Rank:   r.Rank,
+			Choice: fmt.Sprint(r.Choice),
+			Wins:   r.Wins,
+		})
+	}
+	for i := range p.Choices {
+		for j := i + 1; j < len(p.Choices); j++ {
+			sij, sji := d.Strengths[i][j], d.Strengths[j][i]
+			du := duel{
+				Winner:         p.Choices[i],
+				Defeated:       p.Choices[j],
+				WinnerStrength: sij,
+				Strength:       sji,
+				Tie:            sij == sji,
+			}
+			if sji > sij {
+				du.Winner, du.Defeated = du.Defeated, du.Winner
+				du.WinnerStrength, du.Strength = sji, sij
+			}
+			p.Duels = append(p.Duels, du)
+		}
+	}
+	return indexTemplate.Execute(w, p)
+}
+
+// writeWinsChart writes the horizontal bar chart of wins of ranked choices.
+func writeWinsChart[C comparable](w io.Writer, d schulze.ResultDocument[C]) error {
+	const (
+		barHeight  = 24
+		gap        = 8
+		labelWidth = 160
+		chartWidth = 400
+	)
+	maxWins := 1
+	for _, r := range d.Ranking {
+		if r.Wins > maxWins {
+			maxWins = r.Wins
+		}
+	}
+	height := len(d.Ranking)*(barHeight+gap) + gap
+	width := labelWidth + chartWidth + 40
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%v" height="%v" viewBox="0 0 %v %v" font-family="sans-serif" font-size="12">`+"\n", width, height, width, height)
+	for i, r := range d.Ranking {
+		y := gap + i*(barHeight+gap)
+		barWidth := r.Wins * chartWidth / maxWins
+		var label bytes.Buffer
+		template.HTMLEscape(&label, []byte(fmt.Sprint(r.Choice)))
+		fmt.Fprintf(bw, `<text x="%v" y="%v" text-anchor="end" dominant-baseline="middle">%s</text>`, labelWidth-gap, y+barHeight/2, label.String())
+		fmt.Fprintf(bw, `<rect x="%v" y="%v" width="%v" height="%v" fill="#2166ac"/>`, labelWidth, y, barWidth, barHeight)
+		fmt.Fprintf(bw, `<text x="%v" y="%v" dominant-baseline="middle">%v</text>`+"\n", labelWidth+barWidth+gap, y+barHeight/2, r.Wins)
+	}
+	bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+// matrix is the data of the matrix template.
+type matrix struct {
+	Choices []string
+	Rows    [][]int
+}
+
+var indexTemplate = template.Must(template.New("index").Funcs(template.FuncMap{
+	"matrix": func(choices []string, rows [][]int) matrix {
+		return matrix{Choices: choices, Rows: rows}
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Name}}</title>
+<style>
+body { font-family: sans-serif; max-width: 60em; margin: 2em auto; padding: 0 1em; color: #222; }
+table { border-collapse: collapse; margin: 1em 0; }
+td, th { padding: .3em .8em; border-bottom: 1px solid #ddd; text-align: left; }
+td.number { text-align: right; }
+.muted { color: #777; }
+img { max-width: 100%; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<p class="muted">{{.BallotsCount}} ballots{{if .Tie}}, tied for the first place{{end}}. Computed with the Schulze method. <a href="results.json">Result document</a></p>
+
+<h2>Ranking</h2>
+<table>
+<thead><tr><th>Rank</th><th>Choice</th><th>Wins</th></tr></thead>
+<tbody>
+{{- range .Ranking}}
+<tr><td class="number">{{.Rank}}</td><td>{{.Choice}}</td><td class="number">{{.Wins}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+<img src="wins.svg" alt="Wins of every choice">
+
+<h2>Pairwise preferences</h2>
+<p class="muted">Number of ballots that prefer the choice in the row over the choice in the column.</p>
+{{template "matrix" (matrix .Choices .Preferences)}}
+<img src="heatmap.svg" alt="Heatmap of pairwise margins">
+
+<h2>Strongest paths</h2>
+<p class="muted">Strength of the strongest path from the choice in the row to the choice in the column.</p>
+{{template "matrix" (matrix .Choices .Strengths)}}
+
+<h2>Duels</h2>
+<table>
+<tbody>
+{{- range .Duels}}
+<tr><td>{{if .Tie}}{{.Winner}} and {{.Defeated}} are tied{{else}}{{.Winner}} defeats {{.Defeated}}{{end}}</td><td class="number">{{.WinnerStrength}}–{{.Strength}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+{{define "matrix"}}
+<table>
+<thead><tr><th></th>{{range .Choices}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{- range $i, $row := .Rows}}
+<tr><th>{{index $.Choices $i}}</th>{{range $j, $v := $row}}<td class="number">{{if ne $i $j}}{{$v}}{{end}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+{{end}}
+`))
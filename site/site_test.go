@@ -0,0 +1,84 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package site_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/site"
+)
+
+func TestWrite(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:    "Board <2026>",
+		Choices: []string{"Alice", "Bob", "Carol"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"v1": {"Alice": 1, "Bob": 2},
+		"v2": {"Alice": 1},
+		"v3": {"Carol": 1, "Bob": 2},
+	} {
+		if _, err := e.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d, err := e.ResultDocument()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "site")
+	if err := site.Write(dir, d); err != nil {
+		t.Fatal(err)
+	}
+
+	index, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"<title>Board &lt;2026&gt;</title>",
+		"3 ballots",
+		`<tr><td class="number">1</td><td>Alice</td><td class="number">2</td></tr>`,
+		"Alice defeats Bob",
+		`<img src="heatmap.svg"`,
+	} {
+		if !strings.Contains(string(index), want) {
+			t.Errorf("index does not contain %q", want)
+		}
+	}
+
+	for _, name := range []string{"wins.svg", "heatmap.svg"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(data), "<svg") {
+			t.Errorf("%s is not an svg image", name)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "results.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got schulze.ResultDocument[string]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, d) {
+		t.Errorf("got result document %+v, want %+v", got, d)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slash
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"resenje.org/schulze"
+)
+
+// Discord interaction and response types.
+const (
+	discordPing               = 1
+	discordApplicationCommand = 2
+	discordPong               = 1
+	discordChannelMessage     = 4
+	discordSubcommand         = 1
+	discordEphemeral          = 1 << 6
+)
+
+// NewDiscordHandler returns a new Handler of Discord application commands
+// that verifies interactions with the public key of the Discord application.
+// Polls are created in the Manager with names of the form
+// "discord:<channel id>".
+func NewDiscordHandler(m *schulze.Manager[string, string], publicKey ed25519.PublicKey, opts ...Option) *Handler {
+	h := newHandler(m, opts)
+	h.serve = func(w http.ResponseWriter, r *http.Request, body []byte) {
+		timestamp := r.Header.Get("X-Signature-Timestamp")
+		sig, err := hex.DecodeString(r.Header.Get("X-Signature-Ed25519"))
+		if err != nil || !h.checkTimestamp(timestamp) || !ed25519.Verify(publicKey, append([]byte(timestamp), body...), sig) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		var i discordInteraction
+		if err := json.Unmarshal(body, &i); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch i.Type {
+		case discordPing:
+			writeJSON(w, discordResponse{Type: discordPong})
+			return
+		case discordApplicationCommand:
+		default:
+			http.Error(w, "unsupported interaction type", http.StatusBadRequest)
+			return
+		}
+		user := i.User
+		if i.Member != nil {
+			user = i.Member.User
+		}
+		if user == nil {
+			http.Error(w, "missing user", http.StatusBadRequest)
+			return
+		}
+		subcommand, text := i.Data.command()
+		resp := h.Command("discord:"+i.ChannelID, "discord:"+user.ID, subcommand, text)
+		data := &discordMessage{Content: resp.Text}
+		if !resp.InChannel {
+			data.Flags = discordEphemeral
+		}
+		writeJSON(w, discordResponse{
+			Type: discordChannelMessage,
+			Data: data,
+		})
+	}
+	return h
+}
+
+type discordInteraction struct {
+	Type      int    `json:"type"`
+	ChannelID string `json:"channel_id"`
+	Member    *struct {
+		User *discordUser `json:"user"`
+	} `json:"member"`
+	User *discordUser       `json:"user"`
+	Data discordCommandData `json:"data"`
+}
+
+type discordUser struct {
+	ID string `json:"id"`
+}
+
+type discordCommandData struct {
+	Name    string          `json:"name"`
+	Options []discordOption `json:"options"`
+}
+
+type discordOption struct {
+	Name    string          `json:"name"`
+	Type    int             `json:"type"`
+	Value   any             `json:"value"`
+	Options []discordOption `json:"options"`
+}
+
+// command returns the subcommand and the text of the command options.
+func (d discordCommandData) command() (subcommand, text string) {
+	if len(d.Options) == 0 {
+		return "", ""
+	}
+	o := d.Options[0]
+	if o.Type == discordSubcommand {
+		if len(o.Options) > 0 {
+			text, _ = o.Options[0].Value.(string)
+		}
+		return o.Name, strings.TrimSpace(text)
+	}
+	text, _ = o.Value.(string)
+	subcommand, text, _ = strings.Cut(strings.TrimSpace(text), " ")
+	return subcommand, strings.TrimSpace(text)
+}
+
+type discordResponse struct {
+	Type int             `json:"type"`
+	Data *discordMessage `json:"data,omitempty"`
+}
+
+type discordMessage struct {
+	Content string `json:"content"`
+	Flags   int    `json:"flags,omitempty"`
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slash
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"resenje.org/schulze"
+)
+
+// NewSlackHandler returns a new Handler of Slack slash commands that
+// verifies requests with the signing secret of the Slack app. Polls are
+// created in the Manager with names of the form "slack:<team id>:<channel
+// id>".
+func NewSlackHandler(m *schulze.Manager[string, string], signingSecret string, opts ...Option) *Handler {
+	h := newHandler(m, opts)
+	secret := []byte(signingSecret)
+	h.serve = func(w http.ResponseWriter, r *http.Request, body []byte) {
+		timestamp := r.Header.Get("X-Slack-Request-Timestamp")
+		if !h.checkTimestamp(timestamp) || !verifySlackSignature(secret, timestamp, body, r.Header.Get("X-Slack-Signature")) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		team := form.Get("team_id")
+		subcommand, text, _ := strings.Cut(strings.TrimSpace(form.Get("text")), " ")
+		resp := h.Command(
+			"slack:"+team+":"+form.Get("channel_id"),
+			"slack:"+team+":"+form.Get("user_id"),
+			subcommand,
+			strings.TrimSpace(text),
+		)
+		responseType := "ephemeral"
+		if resp.InChannel {
+			responseType = "in_channel"
+		}
+		writeJSON(w, slackResponse{
+			ResponseType: responseType,
+			Text:         resp.Text,
+		})
+	}
+	return h
+}
+
+type slackResponse struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+// verifySlackSignature verifies the version 0 signature of the Slack request.
+func verifySlackSignature(secret []byte, timestamp string, body []byte, signature string) bool {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "v0="))
+	if err != nil || !strings.HasPrefix(signature, "v0=") {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return hmac.Equal(sig, mac.Sum(nil))
+}
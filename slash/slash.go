@@ -0,0 +1,238 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package slash serves webhooks of chat slash commands on Slack and Discord,
+// so that communities can self-host ranked polls in their channels. Every
+// channel has its own election in the schulze.Manager.
+//
+// A single command, such as /poll, has the following subcommands:
+//
+//   - create A, B, C: create a poll in the channel with the listed choices
+//   - vote A > B = C: cast or replace the ballot in the text format of the
+//     schulze.ParseBallot function
+//   - unvote: remove the ballot
+//   - results: post the current results to the channel
+//   - close: post the final results to the channel and remove the poll
+//
+// On Slack, the subcommand is the first word of the command text. On
+// Discord, it is either the name of a subcommand option with an optional
+// string option for the rest of the text, or the first word of the first
+// string option.
+package slash
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+// Response is the reply to a command.
+type Response struct {
+	Text string
+	// InChannel is true if the response is visible to everyone in the
+	// channel, and not only to the user that issued the command.
+	InChannel bool
+}
+
+// Option configures the Handler.
+type Option func(*Handler)
+
+// WithVoterRegistry sets the registry that maps identities of chat users to
+// voters. Only users that are registered can vote. Identities have the form
+// "slack:<team id>:<user id>" and "discord:<user id>". By default, every
+// user can vote and the identity is the voter.
+func WithVoterRegistry(r *schulze.VoterRegistry[string]) Option {
+	return func(h *Handler) {
+		h.registry = r
+	}
+}
+
+// WithElectionConfig sets the configuration of created polls. Choices are
+// replaced by the choices of the create command.
+func WithElectionConfig(c schulze.ElectionConfig[string]) Option {
+	return func(h *Handler) {
+		h.config = c
+	}
+}
+
+// maxTimestampSkew is the maximal difference between the time of the signed
+// request and the current time.
+const maxTimestampSkew = 5 * time.Minute
+
+// Handler serves slash commands of a single chat platform. Create it with
+// the NewSlackHandler or the NewDiscordHandler function.
+type Handler struct {
+	manager  *schulze.Manager[string, string]
+	registry *schulze.VoterRegistry[string]
+	config   schulze.ElectionConfig[string]
+	now      func() time.Time
+	// serve verifies the platform request and writes the response
+	serve func(w http.ResponseWriter, r *http.Request, body []byte)
+}
+
+func newHandler(m *schulze.Manager[string, string], opts []Option) *Handler {
+	h := &Handler{
+		manager: m,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// maxBodySize is the maximal size of the request body.
+const maxBodySize = 1 << 20
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.serve(w, r, body)
+}
+
+// Command executes the subcommand with the text in the channel for the
+// identity of the user and returns the response.
+func (h *Handler) Command(channel, identity, subcommand, text string) Response {
+	switch strings.ToLower(subcommand) {
+	case "create":
+		return h.create(channel, text)
+	case "vote":
+		return h.vote(channel, identity, text)
+	case "unvote":
+		return h.unvote(channel, identity)
+	case "results":
+		return h.results(channel, false)
+	case "close":
+		return h.results(channel, true)
+	}
+	return Response{Text: "Commands: create A, B, C; vote A > B = C; unvote; results; close."}
+}
+
+func (h *Handler) create(channel, text string) Response {
+	var choices []string
+	for _, c := range strings.Split(text, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			choices = append(choices, c)
+		}
+	}
+	if len(choices) < 2 {
+		return Response{Text: "A poll needs at least two choices separated by commas."}
+	}
+	config := h.config
+	config.Choices = choices
+	if err := h.manager.Create(channel, config); err != nil {
+		var derr *schulze.DuplicateElectionError
+		if errors.As(err, &derr) {
+			return Response{Text: "There is already a poll in this channel."}
+		}
+		return errorResponse(err)
+	}
+	return Response{
+		Text:      fmt.Sprintf("New poll: %s. Rank the choices with vote, for example: vote %s", strings.Join(choices, ", "), strings.Join(choices, " > ")),
+		InChannel: true,
+	}
+}
+
+func (h *Handler) vote(channel, identity, text string) Response {
+	voter, err := h.voter(identity)
+	if err != nil {
+		return errorResponse(err)
+	}
+	b, err := schulze.ParseBallot(text)
+	if err != nil {
+		return errorResponse(err)
+	}
+	var record schulze.Record[string]
+	if err := h.manager.Do(channel, func(e *schulze.Election[string, string]) (err error) {
+		record, err = e.Vote(voter, b)
+		return err
+	}); err != nil {
+		return errorResponse(err)
+	}
+	if ranking := schulze.FormatRecord(record); ranking != "" {
+		return Response{Text: "Your ballot is recorded: " + ranking}
+	}
+	return Response{Text: "Your abstention is recorded."}
+}
+
+func (h *Handler) unvote(channel, identity string) Response {
+	voter, err := h.voter(identity)
+	if err != nil {
+		return errorResponse(err)
+	}
+	if err := h.manager.Do(channel, func(e *schulze.Election[string, string]) error {
+		return e.Unvote(voter)
+	}); err != nil {
+		return errorResponse(err)
+	}
+	return Response{Text: "Your ballot is removed."}
+}
+
+func (h *Handler) results(channel string, close bool) Response {
+	var text string
+	if err := h.manager.Do(channel, func(e *schulze.Election[string, string]) error {
+		results, duels, _, err := e.Compute()
+		if err != nil {
+			return err
+		}
+		text = fmt.Sprintf("%s\nVoters: %d", strings.TrimSpace(schulze.Explain(results, duels, schulze.ExplainText)), e.VotersCount())
+		return nil
+	}); err != nil {
+		return errorResponse(err)
+	}
+	if close {
+		h.manager.Remove(channel)
+		text = "The poll is closed.\n" + text
+	}
+	return Response{Text: text, InChannel: true}
+}
+
+func (h *Handler) voter(identity string) (string, error) {
+	if h.registry == nil {
+		return identity, nil
+	}
+	return h.registry.Voter(identity)
+}
+
+func errorResponse(err error) Response {
+	var uerr *schulze.UnknownElectionError
+	switch {
+	case errors.As(err, &uerr):
+		return Response{Text: "There is no poll in this channel. Create one with: create A, B, C"}
+	case errors.Is(err, schulze.ErrVoterNotRegistered):
+		return Response{Text: "You are not registered to vote."}
+	}
+	return Response{Text: "Error: " + strings.TrimPrefix(err.Error(), "schulze: ")}
+}
+
+// checkTimestamp returns true if the Unix timestamp in seconds is close to
+// the current time.
+func (h *Handler) checkTimestamp(timestamp string) bool {
+	var sec int64
+	if _, err := fmt.Sscan(timestamp, &sec); err != nil {
+		return false
+	}
+	d := h.now().Sub(time.Unix(sec, 0))
+	return d < maxTimestampSkew && d > -maxTimestampSkew
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slash_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/slash"
+)
+
+func TestSlackHandler(t *testing.T) {
+	const secret = "signing secret"
+
+	m := schulze.NewManager[string, string]()
+	r := schulze.NewVoterRegistry[string]()
+	r.Register("slack:T1:U1", "alice")
+	r.Register("slack:T1:U2", "bob")
+	h := slash.NewSlackHandler(m, secret, slash.WithVoterRegistry(r))
+
+	command := func(t *testing.T, user, text string) (responseType, responseText string) {
+		t.Helper()
+		body := url.Values{
+			"team_id":    {"T1"},
+			"channel_id": {"C1"},
+			"user_id":    {user},
+			"command":    {"/poll"},
+			"text":       {text},
+		}.Encode()
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte("v0:" + timestamp + ":" + body))
+
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+		req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("got status %v, want %v", w.Code, http.StatusOK)
+		}
+		var resp struct {
+			ResponseType string `json:"response_type"`
+			Text         string `json:"text"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp.ResponseType, resp.Text
+	}
+
+	if _, text := command(t, "U1", "vote A"); !strings.Contains(text, "no poll") {
+		t.Errorf("got response %q, want no poll", text)
+	}
+	if typ, text := command(t, "U1", "create Pizza, Sushi, Tacos"); typ != "in_channel" || !strings.Contains(text, "Pizza, Sushi, Tacos") {
+		t.Errorf("got response %s %q", typ, text)
+	}
+	if typ, text := command(t, "U1", "vote Sushi > Pizza > Tacos"); typ != "ephemeral" || text != "Your ballot is recorded: Sushi>Pizza>Tacos" {
+		t.Errorf("got response %s %q", typ, text)
+	}
+	if _, text := command(t, "U2", "vote Tacos"); !strings.Contains(text, "recorded") {
+		t.Errorf("got response %q", text)
+	}
+	if _, text := command(t, "U3", "vote Tacos"); !strings.Contains(text, "not registered") {
+		t.Errorf("got response %q, want not registered", text)
+	}
+	if _, text := command(t, "U2", "unvote"); text != "Your ballot is removed." {
+		t.Errorf("got response %q", text)
+	}
+	if typ, text := command(t, "U2", "close"); typ != "in_channel" || !strings.Contains(text, "Sushi is the winner") || !strings.Contains(text, "Voters: 1") {
+		t.Errorf("got response %s %q", typ, text)
+	}
+	if got := m.Names(); len(got) != 0 {
+		t.Errorf("got polls %v after close", got)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("text=results"))
+	req.Header.Set("X-Slack-Request-Timestamp", strconv.FormatInt(time.Now().Unix(), 10))
+	req.Header.Set("X-Slack-Signature", "v0=00")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("got status %v, want %v", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestDiscordHandler(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := schulze.NewManager[string, string]()
+	h := slash.NewDiscordHandler(m, pub)
+
+	interaction := func(t *testing.T, v any) (typ int, content string, flags int) {
+		t.Helper()
+		body, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		req.Header.Set("X-Signature-Timestamp", timestamp)
+		req.Header.Set("X-Signature-Ed25519", hex.EncodeToString(ed25519.Sign(key, append([]byte(timestamp), body...))))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("got status %v, want %v", w.Code, http.StatusOK)
+		}
+		var resp struct {
+			Type int `json:"type"`
+			Data struct {
+				Content string `json:"content"`
+				Flags   int    `json:"flags"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp.Type, resp.Data.Content, resp.Data.Flags
+	}
+	command := func(user, subcommand, text string) map[string]any {
+		var options []map[string]any
+		if text != "" {
+			options = []map[string]any{{"name": "text", "type": 3, "value": text}}
+		}
+		return map[string]any{
+			"type":       2,
+			"channel_id": "C1",
+			"member":     map[string]any{"user": map[string]any{"id": user}},
+			"data": map[string]any{
+				"name":    "poll",
+				"options": []map[string]any{{"name": subcommand, "type": 1, "options": options}},
+			},
+		}
+	}
+
+	if typ, _, _ := interaction(t, map[string]any{"type": 1}); typ != 1 {
+		t.Errorf("got ping response type %v, want %v", typ, 1)
+	}
+	if _, content, flags := interaction(t, command("U1", "create", "A, B")); flags != 0 || !strings.Contains(content, "New poll") {
+		t.Errorf("got response %q with flags %v", content, flags)
+	}
+	if _, content, flags := interaction(t, command("U1", "vote", "B>A")); flags != 64 || content != "Your ballot is recorded: B>A" {
+		t.Errorf("got response %q with flags %v", content, flags)
+	}
+	if _, content, _ := interaction(t, command("U1", "results", "")); !strings.Contains(content, "B is the winner") {
+		t.Errorf("got response %q", content)
+	}
+	if err := m.Do("discord:C1", func(e *schulze.Election[string, string]) error {
+		if _, ok := e.Record("discord:U1"); !ok {
+			t.Error("ballot not recorded for the discord identity")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ElectionSnapshot is the serializable state of the election that is
+// persisted and restored with the RestoreElection function, such as by the
+// store package. It can be encoded as JSON. Runtime settings, such as the
+// notifier, the policy engine, the rate limits and the token verifier, are
+// not part of the snapshot, and neither are presets, scores, commitments,
+// provisional ballots and nominations.
+type ElectionSnapshot[V, C comparable] struct {
+	// Configuration of the election with the current choices.
+	Config ElectionConfig[C] `json:"config"`
+	// Recorded ballots ordered by the time of voting.
+	Ballots []SnapshotBallot[V, C] `json:"ballots"`
+	// Voters that spoiled their ballots.
+	Spoiled []V `json:"spoiled,omitempty"`
+	// Used voting tokens mapped to voters.
+	Tokens map[string]V `json:"tokens,omitempty"`
+	Audit  []AuditEntry `json:"audit,omitempty"`
+	// Provenance of choices seeded from or to other elections.
+	Linkages []Linkage[C] `json:"linkages,omitempty"`
+	// Withdrawn choices with their withdrawal modes.
+	Withdrawn []SnapshotWithdrawal[C] `json:"withdrawn,omitempty"`
+	// Proxy appointments of principals.
+	Proxies []SnapshotProxy[V] `json:"proxies,omitempty"`
+}
+
+// SnapshotProxy is a proxy appointment in the ElectionSnapshot.
+type SnapshotProxy[V comparable] struct {
+	Principal V `json:"principal"`
+	Proxy     V `json:"proxy"`
+	// Voted is true if the ballot of the principal was cast by the proxy.
+	Voted bool `json:"voted,omitempty"`
+}
+
+// SnapshotWithdrawal is a withdrawn choice in the ElectionSnapshot.
+type SnapshotWithdrawal[C comparable] struct {
+	Choice C              `json:"choice"`
+	Mode   WithdrawalMode `json:"mode"`
+}
+
+// SnapshotBallot is a recorded ballot of a voter in the ElectionSnapshot.
+type SnapshotBallot[V, C comparable] struct {
+	Voter      V          `json:"voter"`
+	Record     Record[C]  `json:"record"`
+	Tags       Tags       `json:"tags,omitempty"`
+	Attributes Attributes `json:"attributes,omitempty"`
+	Vetoes     []C        `json:"vetoes,omitempty"`
+	Time       time.Time  `json:"time"`
+}
+
+// Snapshot returns the serializable state of the election.
+func (e *Election[V, C]) Snapshot() ElectionSnapshot[V, C] {
+	s := ElectionSnapshot[V, C]{
+		Config:  e.Config(),
+		Ballots: make([]SnapshotBallot[V, C], 0, len(e.records)),
+		Audit:   e.AuditLog(),
+	}
+	if len(e.linkages) > 0 {
+		s.Linkages = e.Linkages()
+	}
+	for _, c := range e.voting.WithdrawnChoices() {
+		s.Withdrawn = append(s.Withdrawn, SnapshotWithdrawal[C]{
+			Choice: c,
+			Mode:   e.voting.withdrawn[c],
+		})
+	}
+	for voter, r := range e.records {
+		s.Ballots = append(s.Ballots, SnapshotBallot[V, C]{
+			Voter:      voter,
+			Record:     copyRecord(r),
+			Tags:       copyTags(e.tags[voter]),
+			Attributes: Attributes(copyTags(Tags(e.attributes[voter]))),
+			Vetoes:     append([]C(nil), e.vetoes[voter]...),
+			Time:       e.voted[voter].UTC(),
+		})
+	}
+	sort.Slice(s.Ballots, func(i, j int) bool {
+		if !s.Ballots[i].Time.Equal(s.Ballots[j].Time) {
+			return s.Ballots[i].Time.Before(s.Ballots[j].Time)
+		}
+		return fmt.Sprint(s.Ballots[i].Voter) < fmt.Sprint(s.Ballots[j].Voter)
+	})
+	for voter := range e.spoiled {
+		s.Spoiled = append(s.Spoiled, voter)
+	}
+	sort.Slice(s.Spoiled, func(i, j int) bool {
+		return fmt.Sprint(s.Spoiled[i]) < fmt.Sprint(s.Spoiled[j])
+	})
+	for principal, proxy := range e.proxies {
+		_, voted := e.proxied[principal]
+		s.Proxies = append(s.Proxies, SnapshotProxy[V]{
+			Principal: principal,
+			Proxy:     proxy,
+			Voted:     voted,
+		})
+	}
+	sort.Slice(s.Proxies, func(i, j int) bool {
+		return fmt.Sprint(s.Proxies[i].Principal) < fmt.Sprint(s.Proxies[j].Principal)
+	})
+	if len(e.tokens) > 0 {
+		s.Tokens = make(map[string]V, len(e.tokens))
+		for token, voter := range e.tokens {
+			s.Tokens[token] = voter
+		}
+	}
+	return s
+}
+
+// RestoreElection constructs the election from its snapshot. Ballots are
+// tallied regardless of the election schedule and the ballot policy, as they
+// were accepted when they were cast, and choices of records that are no longer
+// in the election are ignored. Choices are withdrawn after all ballots are
+// tallied. Additional options are applied after the
+// options from the configuration.
+func RestoreElection[V, C comparable](s ElectionSnapshot[V, C], opts ...Option[C]) (*Election[V, C], error) {
+	e, err := NewElectionFromConfig[V](s.Config, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range s.Ballots {
+		if err := e.restoreBallot(b); err != nil {
+			return nil, fmt.Errorf("ballot of voter %v: %w", b.Voter, err)
+		}
+	}
+	// withdrawn choices are removed from ballots that are tallied after the
+	// withdrawal
+	for _, w := range s.Withdrawn {
+		if err := e.voting.WithdrawChoice(w.Choice, w.Mode); err != nil {
+			return nil, fmt.Errorf("withdrawn choice: %w", err)
+		}
+	}
+	e.updateDisqualified()
+	for _, voter := range s.Spoiled {
+		if _, ok := e.records[voter]; ok {
+			continue
+		}
+		e.spoiled[voter] = struct{}{}
+	}
+	for _, p := range s.Proxies {
+		if e.proxies == nil {
+			e.proxies = make(map[V]V)
+		}
+		e.proxies[p.Principal] = p.Proxy
+		if _, ok := e.records[p.Principal]; ok && p.Voted {
+			if e.proxied == nil {
+				e.proxied = make(map[V]struct{})
+			}
+			e.proxied[p.Principal] = struct{}{}
+		}
+	}
+	for token, voter := range s.Tokens {
+		e.tokens[token] = voter
+	}
+	e.audit = append(e.audit, s.Audit...)
+	for _, l := range s.Linkages {
+		e.linkages = append(e.linkages, copyLinkage(l))
+	}
+	return e, nil
+}
+
+// restoreBallot tallies the snapshot ballot without validating it, replacing
+// the previous ballot of the voter.
+func (e *Election[V, C]) restoreBallot(b SnapshotBallot[V, C]) error {
+	r, err := e.voting.Vote(e.voting.ranksOrder(recordBallot(b.Record, e.voting.choices)))
+	if err != nil {
+		return err
+	}
+	if previous, ok := e.records[b.Voter]; ok {
+		if err := e.voting.Unvote(previous); err != nil {
+			return err
+		}
+	}
+	e.records[b.Voter] = r
+	e.voted[b.Voter] = b.Time
+	if len(b.Tags) > 0 {
+		e.tags[b.Voter] = copyTags(b.Tags)
+	} else {
+		delete(e.tags, b.Voter)
+	}
+	if len(b.Attributes) > 0 {
+		e.attributes[b.Voter] = Attributes(copyTags(Tags(b.Attributes)))
+	} else {
+		delete(e.attributes, b.Voter)
+	}
+	e.removeVetoes(b.Voter)
+	var vetoes []C
+	for _, c := range b.Vetoes {
+		if getChoiceIndex(e.voting.choices, c) >= 0 {
+			vetoes = append(vetoes, c)
+		}
+	}
+	e.addVetoes(b.Voter, vetoes)
+	delete(e.spoiled, b.Voter)
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_Snapshot(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	closes := now.Add(time.Hour)
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Name:         "board",
+		Choices:      []string{"A", "B", "C"},
+		BallotPolicy: schulze.BallotPolicy{MinRanked: 3},
+		Schedule:     schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.SetNow(func() time.Time { return now })
+
+	if _, err := e.VoteTagged("alice", schulze.Ballot[string]{"A": 1, "B": 2, "C": 3}, schulze.Tags{"region": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SetAttributes("alice", schulze.Attributes{"session": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Minute)
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"C": 1, "B": 2, "A": 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Spoil("carol"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(e.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s schulze.ElectionSnapshot[string, string]
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Ballots) != 2 || s.Ballots[0].Voter != "alice" || s.Ballots[1].Voter != "bob" {
+		t.Fatalf("got ballots %+v, want alice and bob", s.Ballots)
+	}
+
+	// the election is restored after it is closed, with ballots that
+	// do not satisfy the ballot policy as records
+	now = now.Add(2 * time.Hour)
+	r, err := schulze.RestoreElection(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.VotersCount(); got != 3 {
+		t.Errorf("got voters count %v, want %v", got, 3)
+	}
+	if got := r.Stats().Spoiled; got != 1 {
+		t.Errorf("got spoiled %v, want %v", got, 1)
+	}
+	if got := r.Tags("alice"); got["region"] != "north" {
+		t.Errorf("got tags %v, want region north", got)
+	}
+	if got := r.Attributes("alice"); got["session"] != "1" {
+		t.Errorf("got attributes %v, want session 1", got)
+	}
+	if got := r.Config().Name; got != "board" {
+		t.Errorf("got name %q, want %q", got, "board")
+	}
+	if !reflect.DeepEqual(r.StateHash(), e.StateHash()) {
+		t.Error("restored state hash differs")
+	}
+	if !reflect.DeepEqual(r.Snapshot(), e.Snapshot()) {
+		t.Errorf("got snapshot %+v, want %+v", r.Snapshot(), e.Snapshot())
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// StationBundle is the setup of the election for an offline voting station,
+// such as at events with unreliable connectivity, that collects ballots with
+// the Station type and returns them to the election as a signed BallotBatch.
+type StationBundle[C comparable] struct {
+	// Identifier of the station.
+	Station string `json:"station"`
+	// Configuration of the election with the current choices.
+	Config   ElectionConfig[C] `json:"config"`
+	IssuedAt time.Time         `json:"issuedAt"`
+}
+
+// StationBundle returns the bundle of the election setup for the offline
+// voting station with the identifier. Bundles can be encoded as JSON.
+func (e *Election[V, C]) StationBundle(station string) StationBundle[C] {
+	return StationBundle[C]{
+		Station:  station,
+		Config:   e.Config(),
+		IssuedAt: e.now().UTC(),
+	}
+}
+
+// Station collects ballots at an offline voting station by the election
+// configuration from its StationBundle. Methods on the Station type are not
+// safe for concurrent calls.
+type Station[V, C comparable] struct {
+	bundle   StationBundle[C]
+	election *Election[V, C]
+}
+
+// NewStation creates a station that collects ballots by the rules of the
+// bundle, including the ballot policy and the schedule.
+func NewStation[V, C comparable](bundle StationBundle[C]) (*Station[V, C], error) {
+	e, err := NewElectionFromConfig[V](bundle.Config)
+	if err != nil {
+		return nil, err
+	}
+	return &Station[V, C]{
+		bundle:   bundle,
+		election: e,
+	}, nil
+}
+
+// Vote adds or replaces the voter's ballot in the station, as the Election
+// Vote method does.
+func (s *Station[V, C]) Vote(voter V, b Ballot[C]) (Record[C], error) {
+	return s.election.Vote(voter, b)
+}
+
+// Unvote removes the voter's ballot from the station.
+func (s *Station[V, C]) Unvote(voter V) error {
+	return s.election.Unvote(voter)
+}
+
+// VotersCount returns the number of voters that voted at the station.
+func (s *Station[V, C]) VotersCount() int {
+	return len(s.election.records)
+}
+
+// BallotBatch is a signed batch of ballots collected by an offline voting
+// station, which is imported into the election with the ImportBatch method.
+type BallotBatch struct {
+	// JSON encoded BatchContent.
+	Content json.RawMessage `json:"content"`
+	// Signature of the compacted Content JSON.
+	Signature []byte `json:"signature"`
+}
+
+// BatchContent is the signed content of the BallotBatch.
+type BatchContent[V, C comparable] struct {
+	Station string `json:"station"`
+	// Issuing time of the station bundle.
+	BundleIssuedAt time.Time             `json:"bundleIssuedAt"`
+	Ballots        []StationBallot[V, C] `json:"ballots"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
+
+// StationBallot is a ballot collected by a station.
+type StationBallot[V, C comparable] struct {
+	Voter  V         `json:"voter"`
+	Record Record[C] `json:"record"`
+	Time   time.Time `json:"time"`
+}
+
+// Batch returns all ballots collected by the station as a BallotBatch signed
+// by the signer of the station. Ed25519, ECDSA and RSA signers are
+// supported. Ballots are ordered by the time of voting.
+func (s *Station[V, C]) Batch(signer crypto.Signer) (*BallotBatch, error) {
+	e := s.election
+	ballots := make([]StationBallot[V, C], 0, len(e.records))
+	for voter, r := range e.records {
+		ballots = append(ballots, StationBallot[V, C]{
+			Voter:  voter,
+			Record: r,
+			Time:   e.voted[voter].UTC(),
+		})
+	}
+	sort.Slice(ballots, func(i, j int) bool {
+		if !ballots[i].Time.Equal(ballots[j].Time) {
+			return ballots[i].Time.Before(ballots[j].Time)
+		}
+		return fmt.Sprint(ballots[i].Voter) < fmt.Sprint(ballots[j].Voter)
+	})
+	content, err := json.Marshal(BatchContent[V, C]{
+		Station:        s.bundle.Station,
+		BundleIssuedAt: s.bundle.IssuedAt,
+		Ballots:        ballots,
+		CreatedAt:      e.now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode batch content: %w", err)
+	}
+	signature, err := sign(signer, content)
+	if err != nil {
+		return nil, err
+	}
+	return &BallotBatch{
+		Content:   content,
+		Signature: signature,
+	}, nil
+}
+
+// BatchImport reports the outcome of the ImportBatch method.
+type BatchImport[V comparable] struct {
+	Station string
+	// Number of ballots that were tallied.
+	Imported int
+	// Voters that already voted in the election, online or at another
+	// station, whose ballots from the batch are not tallied.
+	Duplicates []V
+	// Ballots from the batch that the election rejected, in the order of
+	// the batch.
+	Rejected []BatchRejection[V]
+}
+
+// BatchRejection is a ballot from the BallotBatch that is not tallied, as
+// the election rejected it, for example by a choice suspended after the
+// station bundle was exported.
+type BatchRejection[V comparable] struct {
+	Voter V
+	Err   error
+}
+
+// ImportBatch verifies the signature of the BallotBatch with the public key
+// of the station and tallies its ballots, regardless of the election
+// schedule, as stations return batches when the connectivity is restored.
+// Ballots of voters that already voted are detected as duplicates and they
+// are not tallied, so that the first ballot of a voter is kept. Choices that
+// are no longer in the election are ignored. Ballots that the election
+// rejects are reported in the BatchImport without stopping the import, as
+// the batch can be imported only once. The import is recorded in the audit
+// log. ErrInvalidBatch is returned if the signature is not valid, and
+// ErrBatchImported if the same batch was already imported. Batches are not
+// supported in elections that require voting tokens or commitments.
+func (e *Election[V, C]) ImportBatch(b *BallotBatch, pub crypto.PublicKey) (*BatchImport[V], error) {
+	if e.tokenVerifier != nil {
+		return nil, ErrTokenRequired
+	}
+	if e.config.CommitReveal {
+		return nil, ErrCommitRevealRequired
+	}
+	var content bytes.Buffer
+	if err := json.Compact(&content, b.Content); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBatch, err)
+	}
+	if err := verifySignature(pub, content.Bytes(), b.Signature); err != nil {
+		if err == errInvalidSignature {
+			err = ErrInvalidBatch
+		}
+		return nil, err
+	}
+	digest := sha256.Sum256(content.Bytes())
+	id := hex.EncodeToString(digest[:])
+	if _, ok := e.batches[id]; ok {
+		return nil, ErrBatchImported
+	}
+	var bc BatchContent[V, C]
+	if err := json.Unmarshal(content.Bytes(), &bc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBatch, err)
+	}
+
+	result := &BatchImport[V]{
+		Station: bc.Station,
+	}
+	seen := make(map[V]struct{}, len(bc.Ballots))
+	for _, sb := range bc.Ballots {
+		_, inBatch := seen[sb.Voter]
+		_, voted := e.records[sb.Voter]
+		_, spoiled := e.spoiled[sb.Voter]
+		if inBatch || voted || spoiled {
+			result.Duplicates = append(result.Duplicates, sb.Voter)
+			continue
+		}
+		seen[sb.Voter] = struct{}{}
+		if _, err := e.vote(sb.Voter, e.voting.ranksOrder(recordBallot(sb.Record, e.voting.choices)), nil); err != nil {
+			result.Rejected = append(result.Rejected, BatchRejection[V]{Voter: sb.Voter, Err: err})
+			continue
+		}
+		result.Imported++
+	}
+	e.batches[id] = struct{}{}
+	e.addAuditEntry(AuditEntry{
+		Action:       AuditImportBatch,
+		Reason:       "station " + bc.Station,
+		BallotsCount: result.Imported,
+	})
+	return result, nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_ImportBatch(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:      []string{"A", "B", "C"},
+		BallotPolicy: schulze.BallotPolicy{MaxRanked: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the bundle is transferred to the station as JSON
+	data, err := json.Marshal(e.StationBundle("hall"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bundle schulze.StationBundle[string]
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := schulze.NewStation[string](bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the station applies the ballot policy of the election
+	if _, err := s.Vote("bob", schulze.Ballot[string]{"A": 1, "B": 2, "C": 3}); !errors.Is(err, schulze.ErrBallotPolicyViolation) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrBallotPolicyViolation)
+	}
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"alice": {"C": 1},
+		"bob":   {"B": 1, "C": 2},
+		"carol": {"B": 1},
+	} {
+		if _, err := s.Vote(voter, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := s.VotersCount(); got != 3 {
+		t.Errorf("got station voters count %v, want %v", got, 3)
+	}
+
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch, err := s.Batch(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.ImportBatch(batch, otherPub); !errors.Is(err, schulze.ErrInvalidBatch) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidBatch)
+	}
+
+	result, err := e.ImportBatch(batch, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &schulze.BatchImport[string]{
+		Station:    "hall",
+		Imported:   2,
+		Duplicates: []string{"alice"},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("got import %+v, want %+v", result, want)
+	}
+
+	if _, err := e.ImportBatch(batch, pub); !errors.Is(err, schulze.ErrBatchImported) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrBatchImported)
+	}
+
+	// the first ballot of the voter is kept
+	if r, _ := e.Record("alice"); !reflect.DeepEqual(sortedRecord(r), schulze.Record[string]{{"A"}, {"B", "C"}}) {
+		t.Errorf("got record %v of the duplicate voter", r)
+	}
+	if got := e.VotersCount(); got != 3 {
+		t.Errorf("got voters count %v, want %v", got, 3)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "B")
+	}
+
+	log := e.AuditLog()
+	if len(log) != 1 || log[0].Action != schulze.AuditImportBatch || log[0].BallotsCount != 2 {
+		t.Errorf("got audit log %+v", log)
+	}
+}
+
+func TestElection_ImportBatch_rejected(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	s, err := schulze.NewStation[string](e.StationBundle("hall"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []struct {
+		voter  string
+		ballot schulze.Ballot[string]
+	}{
+		{voter: "alice", ballot: schulze.Ballot[string]{"A": 1}},
+		{voter: "bob", ballot: schulze.Ballot[string]{"B": 1}},
+		{voter: "carol", ballot: schulze.Ballot[string]{"C": 1}},
+	} {
+		if _, err := s.Vote(v.voter, v.ballot); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch, err := s.Batch(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the choice is suspended after the bundle was exported
+	if err := e.SuspendChoice("B", "appeal"); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := e.ImportBatch(batch, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Imported != 2 || len(result.Rejected) != 1 || result.Rejected[0].Voter != "bob" {
+		t.Fatalf("got import %+v", result)
+	}
+	var suspendedErr *schulze.SuspendedChoiceError[string]
+	if err := result.Rejected[0].Err; !errors.As(err, &suspendedErr) {
+		t.Errorf("got rejection error %v, want suspended choice error", err)
+	}
+	if _, ok := e.Record("bob"); ok {
+		t.Error("got record of the rejected ballot")
+	}
+	if got := e.VotersCount(); got != 2 {
+		t.Errorf("got voters count %v, want %v", got, 2)
+	}
+
+	// the batch with the rejected ballot is marked as imported
+	if _, err := e.ImportBatch(batch, pub); !errors.Is(err, schulze.ErrBatchImported) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrBatchImported)
+	}
+	var imports int
+	for _, entry := range e.AuditLog() {
+		if entry.Action == schulze.AuditImportBatch {
+			imports++
+			if entry.BallotsCount != 2 {
+				t.Errorf("got audit entry %+v", entry)
+			}
+		}
+	}
+	if imports != 1 {
+		t.Errorf("got %v import audit entries, want 1", imports)
+	}
+}
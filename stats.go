@@ -0,0 +1,80 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// ElectionStats holds the participation statistics of the election.
+type ElectionStats struct {
+	// Number of eligible voters from the election configuration, zero if it
+	// is not known.
+	Electorate int `json:"electorate,omitempty"`
+	// Number of voters that voted, including voters that abstained or spoiled
+	// their ballots.
+	Voters int `json:"voters"`
+	// Number of counted ballots that ranked at least one choice.
+	Ranked int `json:"ranked"`
+	// Number of counted empty ballots that did not rank any choice.
+	Abstentions int `json:"abstentions"`
+	// Number of spoiled ballots that were not counted.
+	Spoiled int `json:"spoiled"`
+	// Number of eligible voters that did not vote, zero if the electorate is
+	// not known.
+	Missing int `json:"missing,omitempty"`
+}
+
+// Spoil marks the voter's ballot as explicitly spoiled, removing the
+// previously cast ballot, if any. A spoiled ballot counts as participation,
+// for example for the quorum, but it is not counted in the results. Voting
+// again replaces the spoiled ballot.
+func (e *Election[V, C]) Spoil(voter V) error {
+	if e.tokenVerifier != nil {
+		return ErrTokenRequired
+	}
+	if e.config.CommitReveal {
+		return ErrCommitRevealRequired
+	}
+	if err := e.checkSchedule(); err != nil {
+		return err
+	}
+	if r, ok := e.records[voter]; ok {
+		if err := e.voting.Unvote(r); err != nil {
+			return err
+		}
+		delete(e.records, voter)
+		delete(e.voted, voter)
+		delete(e.attributes, voter)
+		delete(e.tags, voter)
+		delete(e.scores, voter)
+		e.removeVetoes(voter)
+	}
+	e.spoiled[voter] = struct{}{}
+	if err := e.updateProxies(voter); err != nil {
+		return err
+	}
+	e.updateDisqualified()
+	return nil
+}
+
+// Stats returns the participation statistics of the election, counting
+// abstaining and spoiled ballots separately from voters that did not vote.
+func (e *Election[V, C]) Stats() ElectionStats {
+	s := ElectionStats{
+		Electorate: e.config.Electorate,
+		Voters:     e.VotersCount(),
+		Spoiled:    len(e.spoiled),
+	}
+	for _, r := range e.records {
+		// the last list of the record holds unranked choices
+		if len(r) <= 1 {
+			s.Abstentions++
+		} else {
+			s.Ranked++
+		}
+	}
+	if s.Electorate > s.Voters {
+		s.Missing = s.Electorate - s.Voters
+	}
+	return s
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestElection_Stats(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:      []string{"A", "B", "C"},
+		BallotPolicy: schulze.BallotPolicy{MinRanked: 2},
+		Quorum:       5,
+		Electorate:   10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1, "B": 2}); err != nil {
+		t.Fatal(err)
+	}
+	// empty ballot is a valid abstention regardless of the ballot policy
+	if _, err := e.Vote("bob", schulze.Ballot[string]{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("carol", schulze.Ballot[string]{"A": 1, "C": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Spoil("carol"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Spoil("dave"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := e.Record("carol"); ok {
+		t.Error("got record of the spoiled ballot")
+	}
+	if e.QuorumReached() {
+		t.Error("quorum reached")
+	}
+	if _, err := e.Vote("erin", schulze.Ballot[string]{"B": 1, "C": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if !e.QuorumReached() {
+		t.Error("quorum not reached")
+	}
+
+	if got, want := e.Stats(), (schulze.ElectionStats{
+		Electorate:  10,
+		Voters:      5,
+		Ranked:      2,
+		Abstentions: 1,
+		Spoiled:     2,
+		Missing:     5,
+	}); got != want {
+		t.Errorf("got stats %+v, want %+v", got, want)
+	}
+
+	// spoiled ballot is not counted in the results
+	want := schulze.NewVoting([]string{"A", "B", "C"})
+	for _, b := range []schulze.Ballot[string]{{"A": 1, "B": 2}, {}, {"B": 1, "C": 2}} {
+		if _, err := want.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if string(e.StateHash()) != string(want.StateHash()) {
+		t.Error("spoiled ballot counted in preferences")
+	}
+
+	d, err := e.ResultDocument()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.BallotsCount != 3 || d.AbstentionsCount != 1 || d.SpoiledCount != 2 {
+		t.Errorf("got result document counts %v, %v, %v", d.BallotsCount, d.AbstentionsCount, d.SpoiledCount)
+	}
+
+	// voting replaces the spoiled ballot and unvoting removes the mark
+	if _, err := e.Vote("carol", schulze.Ballot[string]{"C": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("dave"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.Stats(), (schulze.ElectionStats{
+		Electorate:  10,
+		Voters:      4,
+		Ranked:      3,
+		Abstentions: 1,
+		Missing:     6,
+	}); got != want {
+		t.Errorf("got stats %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+// ErrNotPersisted is returned, wrapped with the cause, by all methods of the
+// Election after a change of the election could not be appended to the
+// journal, as the state of the election in memory may differ from the
+// persisted one. The Election must be opened again from the Store.
+var ErrNotPersisted = errors.New("store: election changes are not persisted")
+
+// notPersistedError matches ErrNotPersisted and wraps its cause.
+type notPersistedError struct {
+	err error
+}
+
+func (e *notPersistedError) Error() string {
+	return ErrNotPersisted.Error() + ": " + e.err.Error()
+}
+
+func (e *notPersistedError) Is(target error) bool {
+	return target == ErrNotPersisted
+}
+
+func (e *notPersistedError) Unwrap() error {
+	return e.err
+}
+
+// ErrCorrupt is returned when the persisted state of the election can not be
+// decoded.
+var ErrCorrupt = errors.New("store: corrupt election state")
+
+// Types of journal entries.
+const (
+	entryVote    = "vote"
+	entryUnvote  = "unvote"
+	entryChoices = "choices"
+)
+
+// entry is a change of the election that is appended to the journal.
+type entry[V, C comparable] struct {
+	Seq     uint64            `json:"seq"`
+	Type    string            `json:"type"`
+	Time    time.Time         `json:"time"`
+	Voter   V                 `json:"voter"`
+	Record  schulze.Record[C] `json:"record,omitempty"`
+	Tags    schulze.Tags      `json:"tags,omitempty"`
+	Choices []C               `json:"choices,omitempty"`
+}
+
+// snapshot is the persisted state of the election with the sequence number
+// of the last journal entry that it includes.
+type snapshot[V, C comparable] struct {
+	Seq      uint64                         `json:"seq"`
+	Election schulze.ElectionSnapshot[V, C] `json:"election"`
+}
+
+// Election is a schulze.Election with changes that are persisted in the
+// Store. Votes, unvotes and changes of choices are appended to the journal
+// before they are acknowledged, and the complete state is saved as a
+// snapshot by the Checkpoint and EraseVoter methods. Methods on the Election
+// type are safe for concurrent calls, but a single Election must be opened
+// for the same name in the Store at a time.
+type Election[V, C comparable] struct {
+	mu       sync.Mutex
+	election *schulze.Election[V, C]
+	store    Store
+	name     string
+	seq      uint64
+	// sequence number of the last journal entry in the saved snapshot
+	checkpointed uint64
+	err          error
+}
+
+// Open restores the election with the name from its snapshot and journal in
+// the Store. If the election is not persisted, a new one is created from the
+// configuration, named by the name if it has no name, and its initial
+// snapshot is saved, otherwise the configuration from the snapshot is used.
+// Options are passed to the schulze.RestoreElection function.
+func Open[V, C comparable](ctx context.Context, s Store, name string, config schulze.ElectionConfig[C], opts ...schulze.Option[C]) (*Election[V, C], error) {
+	data, journal, err := s.Load(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		if len(journal) > 0 {
+			return nil, fmt.Errorf("%w: journal without a snapshot", ErrCorrupt)
+		}
+		if config.Name == "" {
+			config.Name = name
+		}
+		e, err := schulze.NewElectionFromConfig[V](config, opts...)
+		if err != nil {
+			return nil, err
+		}
+		p := &Election[V, C]{
+			election: e,
+			store:    s,
+			name:     name,
+		}
+		if err := p.checkpoint(ctx); err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	e, checkpointed, seq, err := restore[V](data, journal, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Election[V, C]{
+		election:     e,
+		store:        s,
+		name:         name,
+		seq:          seq,
+		checkpointed: checkpointed,
+	}, nil
+}
+
+// Restore constructs the election from the snapshot and the journal entries
+// that are returned by the Store Load method, such as to compute the final
+// results of an archived election. Options are passed to the
+// schulze.RestoreElection function.
+func Restore[V, C comparable](snapshot []byte, journal [][]byte, opts ...schulze.Option[C]) (*schulze.Election[V, C], error) {
+	e, _, _, err := restore[V](snapshot, journal, opts...)
+	return e, err
+}
+
+// restore applies the journal entries to the snapshot, skipping the ones
+// that are already included in it, and returns the election with the
+// sequence numbers of the last entry in the snapshot and of the last entry.
+func restore[V, C comparable](data []byte, journal [][]byte, opts ...schulze.Option[C]) (e *schulze.Election[V, C], checkpointed, seq uint64, err error) {
+	var s snapshot[V, C]
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, 0, 0, fmt.Errorf("%w: snapshot: %v", ErrCorrupt, err)
+	}
+	ballots := make(map[V]schulze.SnapshotBallot[V, C], len(s.Election.Ballots))
+	for _, b := range s.Election.Ballots {
+		ballots[b.Voter] = b
+	}
+	spoiled := make(map[V]struct{}, len(s.Election.Spoiled))
+	for _, voter := range s.Election.Spoiled {
+		spoiled[voter] = struct{}{}
+	}
+	checkpointed = s.Seq
+	seq = s.Seq
+	for i, data := range journal {
+		var en entry[V, C]
+		if err := json.Unmarshal(data, &en); err != nil {
+			return nil, 0, 0, fmt.Errorf("%w: journal entry %v: %v", ErrCorrupt, i, err)
+		}
+		if en.Seq <= seq {
+			// included in the snapshot
+			continue
+		}
+		seq = en.Seq
+		switch en.Type {
+		case entryVote:
+			ballots[en.Voter] = schulze.SnapshotBallot[V, C]{
+				Voter:  en.Voter,
+				Record: en.Record,
+				Tags:   en.Tags,
+				Time:   en.Time,
+			}
+			delete(spoiled, en.Voter)
+		case entryUnvote:
+			delete(ballots, en.Voter)
+			delete(spoiled, en.Voter)
+		case entryChoices:
+			s.Election.Config.Choices = en.Choices
+		default:
+			return nil, 0, 0, fmt.Errorf("%w: journal entry %v: unknown type %q", ErrCorrupt, i, en.Type)
+		}
+	}
+	s.Election.Ballots = s.Election.Ballots[:0]
+	for _, b := range ballots {
+		s.Election.Ballots = append(s.Election.Ballots, b)
+	}
+	sort.Slice(s.Election.Ballots, func(i, j int) bool {
+		return s.Election.Ballots[i].Time.Before(s.Election.Ballots[j].Time)
+	})
+	s.Election.Spoiled = s.Election.Spoiled[:0]
+	for voter := range spoiled {
+		s.Election.Spoiled = append(s.Election.Spoiled, voter)
+	}
+	e, err = schulze.RestoreElection(s.Election, opts...)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%w: %v", ErrCorrupt, err)
+	}
+	return e, checkpointed, seq, nil
+}
+
+// Vote adds or replaces the voter's ballot, as the schulze.Election Vote
+// method does, and returns after it is appended to the journal.
+func (e *Election[V, C]) Vote(ctx context.Context, voter V, b schulze.Ballot[C]) (schulze.Record[C], error) {
+	return e.VoteTagged(ctx, voter, b, nil)
+}
+
+// VoteTagged adds or replaces the voter's ballot with tags, as the
+// schulze.Election VoteTagged method does, and returns after it is appended
+// to the journal.
+func (e *Election[V, C]) VoteTagged(ctx context.Context, voter V, b schulze.Ballot[C], tags schulze.Tags) (schulze.Record[C], error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil {
+		return nil, e.err
+	}
+	r, err := e.election.VoteTagged(voter, b, tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.append(ctx, entry[V, C]{
+		Type:   entryVote,
+		Voter:  voter,
+		Record: r,
+		Tags:   tags,
+	}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Unvote removes the voter's ballot, as the schulze.Election Unvote method
+// does, and returns after the removal is appended to the journal.
+func (e *Election[V, C]) Unvote(ctx context.Context, voter V) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil {
+		return e.err
+	}
+	if err := e.election.Unvote(voter); err != nil {
+		return err
+	}
+	return e.append(ctx, entry[V, C]{
+		Type:  entryUnvote,
+		Voter: voter,
+	})
+}
+
+// SetChoices updates the choices of the election, as the schulze.Election
+// SetChoices method does, and returns after the change is appended to the
+// journal.
+func (e *Election[V, C]) SetChoices(ctx context.Context, choices []C) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil {
+		return e.err
+	}
+	e.election.SetChoices(choices)
+	return e.append(ctx, entry[V, C]{
+		Type:    entryChoices,
+		Choices: append([]C(nil), choices...),
+	})
+}
+
+// EraseVoter removes the voter's ballot and deletes all data about the
+// voter, as the schulze.Election EraseVoter method does, and returns after
+// the snapshot of the election without the voter's data is saved. The
+// journal entries with the voter's ballots are discarded with the
+// checkpoint, so that they are not restored after a crash.
+func (e *Election[V, C]) EraseVoter(ctx context.Context, voter V, reason string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil {
+		return e.err
+	}
+	if err := e.election.EraseVoter(voter, reason); err != nil {
+		return err
+	}
+	if err := e.checkpoint(ctx); err != nil {
+		// the erasure is applied in memory, but the journal may still
+		// restore the voter's ballots
+		e.err = &notPersistedError{err: err}
+		return e.err
+	}
+	return nil
+}
+
+// Checkpoint saves the snapshot of the election, including changes that are
+// not journaled, and discards the journal.
+func (e *Election[V, C]) Checkpoint(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil {
+		return e.err
+	}
+	return e.checkpoint(ctx)
+}
+
+// RunCheckpoints calls Checkpoint in the interval, if there are journaled
+// changes since the last checkpoint, until the context is done. Errors are
+// passed to the function, if it is not nil.
+func (e *Election[V, C]) RunCheckpoints(ctx context.Context, interval time.Duration, errs func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		e.mu.Lock()
+		err := e.err
+		if err == nil && e.seq != e.checkpointed {
+			err = e.checkpoint(ctx)
+		}
+		e.mu.Unlock()
+		if err != nil && errs != nil {
+			errs(err)
+		}
+	}
+}
+
+// Do calls the function with the election, such as to compute the results.
+// Changes made by the function, other than votes, unvotes, changes of
+// choices and erasures with the methods of the Election type, are persisted
+// only by the next Checkpoint.
+func (e *Election[V, C]) Do(f func(e *schulze.Election[V, C]) error) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err != nil {
+		return e.err
+	}
+	return f(e.election)
+}
+
+func (e *Election[V, C]) append(ctx context.Context, en entry[V, C]) error {
+	en.Seq = e.seq + 1
+	en.Time = time.Now().UTC()
+	data, err := json.Marshal(en)
+	if err == nil {
+		err = e.store.Append(ctx, e.name, data)
+	}
+	if err != nil {
+		// the change is applied in memory, but it may not be persisted
+		e.err = &notPersistedError{err: err}
+		return e.err
+	}
+	e.seq = en.Seq
+	return nil
+}
+
+func (e *Election[V, C]) checkpoint(ctx context.Context) error {
+	data, err := json.Marshal(snapshot[V, C]{
+		Seq:      e.seq,
+		Election: e.election.Snapshot(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := e.store.SaveSnapshot(ctx, e.name, data); err != nil {
+		return err
+	}
+	e.checkpointed = e.seq
+	return nil
+}
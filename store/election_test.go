@@ -0,0 +1,338 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/store"
+)
+
+func TestElection(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	config := schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B", "C"},
+	}
+
+	s, err := store.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := store.Open[string](ctx, s, "board", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Checkpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteTagged(ctx, "bob", schulze.Ballot[string]{"B": 1}, schulze.Tags{"region": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "carol", schulze.Ballot[string]{"C": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote(ctx, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SetChoices(ctx, []string{"A", "B", "C", "D"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "dave", schulze.Ballot[string]{"D": 1}); err != nil {
+		t.Fatal(err)
+	}
+	var want schulze.ElectionSnapshot[string, string]
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		want = e.Snapshot()
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the process crashes without closing the store, and acknowledged
+	// votes after the checkpoint are restored from the journal
+	s, err = store.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	e, err = store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSnapshot(t, e, want)
+}
+
+func TestElection_interruptedCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := store.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	e, err := store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "alice", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	journal, err := os.ReadFile(filepath.Join(dir, "board.wal"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Checkpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+	var want schulze.ElectionSnapshot[string, string]
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		want = e.Snapshot()
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the crash after the snapshot is saved, but before the journal is
+	// truncated, leaves the journal entries that are in the snapshot
+	if err := os.WriteFile(filepath.Join(dir, "board.wal"), journal, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = store.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	e, err = store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSnapshot(t, e, want)
+}
+
+func TestElection_EraseVoter(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := store.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteTagged(ctx, "alice", schulze.Ballot[string]{"A": 1}, schulze.Tags{"region": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.EraseVoter(ctx, "alice", "deletion request"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the journal with the voter's ballot is discarded
+	journal, err := os.ReadFile(filepath.Join(dir, "board.wal"))
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	if bytes.Contains(journal, []byte("alice")) {
+		t.Error("journal contains the erased voter")
+	}
+
+	// the process crashes without closing the store, and the erased
+	// voter is not restored
+	s, err = store.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	e, err = store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		if _, ok := e.Record("alice"); ok {
+			t.Error("got record of the erased voter")
+		}
+		if _, ok := e.Record("bob"); !ok {
+			t.Error("no record of the other voter")
+		}
+		if log := e.AuditLog(); len(log) != 1 || log[0].Action != schulze.AuditErase {
+			t.Errorf("got audit log %+v", log)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestElection_appendFailure(t *testing.T) {
+	ctx := context.Background()
+
+	s := &failingStore{Store: newMemoryStore()}
+	e, err := store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errFailed := errors.New("disk failed")
+	s.err = errFailed
+	if _, err := e.Vote(ctx, "alice", schulze.Ballot[string]{"A": 1}); !errors.Is(err, store.ErrNotPersisted) {
+		t.Fatalf("got error %v, want %v", err, store.ErrNotPersisted)
+	}
+
+	// the election must be opened again after the failure
+	s.err = nil
+	if _, err := e.Vote(ctx, "bob", schulze.Ballot[string]{"A": 1}); !errors.Is(err, store.ErrNotPersisted) {
+		t.Fatalf("got error %v, want %v", err, store.ErrNotPersisted)
+	}
+	e, err = store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		if got := e.VotersCount(); got != 0 {
+			t.Errorf("got voters count %v, want %v", got, 0)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestElection_RunCheckpoints(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := newMemoryStore()
+	e, err := store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.RunCheckpoints(ctx, time.Millisecond, func(err error) {
+			t.Error(err)
+		})
+	}()
+	for {
+		var journal [][]byte
+		if err := e.Do(func(*schulze.Election[string, string]) error {
+			_, journal, err = s.Load(ctx, "board")
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if len(journal) == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+}
+
+func assertSnapshot(t *testing.T, e *store.Election[string, string], want schulze.ElectionSnapshot[string, string]) {
+	t.Helper()
+
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		got := e.Snapshot()
+		if !reflect.DeepEqual(got.Config, want.Config) {
+			t.Errorf("got config %+v, want %+v", got.Config, want.Config)
+		}
+		if len(got.Ballots) != len(want.Ballots) {
+			t.Fatalf("got ballots %+v, want %+v", got.Ballots, want.Ballots)
+		}
+		for i, b := range got.Ballots {
+			w := want.Ballots[i]
+			if b.Voter != w.Voter || !reflect.DeepEqual(b.Record[0], w.Record[0]) || !reflect.DeepEqual(b.Tags, w.Tags) {
+				t.Errorf("got ballot %+v, want %+v", b, w)
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type failingStore struct {
+	store.Store
+	err error
+}
+
+func (s *failingStore) Append(ctx context.Context, name string, entry []byte) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.Store.Append(ctx, name, entry)
+}
+
+type memoryStore struct {
+	snapshots map[string][]byte
+	journals  map[string][][]byte
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		snapshots: make(map[string][]byte),
+		journals:  make(map[string][][]byte),
+	}
+}
+
+func (s *memoryStore) Append(_ context.Context, name string, entry []byte) error {
+	s.journals[name] = append(s.journals[name], entry)
+	return nil
+}
+
+func (s *memoryStore) Load(_ context.Context, name string) ([]byte, [][]byte, error) {
+	return s.snapshots[name], s.journals[name], nil
+}
+
+func (s *memoryStore) SaveSnapshot(_ context.Context, name string, snapshot []byte) error {
+	s.snapshots[name] = snapshot
+	delete(s.journals, name)
+	return nil
+}
+
+func (s *memoryStore) Delete(_ context.Context, name string) error {
+	delete(s.snapshots, name)
+	delete(s.journals, name)
+	return nil
+}
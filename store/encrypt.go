@@ -0,0 +1,169 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrDecryption is returned when the stored data can not be decrypted, as it
+// is modified, encrypted for a different election, or encrypted with an
+// unknown key.
+var ErrDecryption = errors.New("store: decryption failed")
+
+// encryptionVersion is the first byte of the encrypted data, which is
+// followed by the length of the key identifier, the key identifier, the
+// nonce and the sealed data.
+const encryptionVersion = 1
+
+// EncryptionOption configures the EncryptedStore.
+type EncryptionOption func(*EncryptedStore)
+
+// WithDecryptionKey adds the AEAD key with the identifier that is used only
+// to decrypt the data encrypted with it, such as a previous key after the
+// key rotation.
+func WithDecryptionKey(keyID string, aead cipher.AEAD) EncryptionOption {
+	return func(s *EncryptedStore) {
+		s.keys[keyID] = aead
+	}
+}
+
+// EncryptedStore is a Store that encrypts snapshots and journal entries, with
+// the records, tags and audit attributes of ballots, before they are passed
+// to another Store, so that ballots are encrypted at rest. Every snapshot
+// and entry is authenticated together with the name of the election, so
+// that the data of one election can not be substituted with the data of
+// another one.
+//
+// Keys are rotated by creating the EncryptedStore with the new key and the
+// previous key as the decryption key. Data is encrypted with the new key
+// from then on, and once all elections are checkpointed, as the snapshot
+// includes all journal entries, the previous key is no longer needed.
+type EncryptedStore struct {
+	store Store
+	keyID string
+	aead  cipher.AEAD
+	keys  map[string]cipher.AEAD
+}
+
+// NewEncryptedStore returns the EncryptedStore that encrypts data of the
+// Store with the AEAD, such as AES-GCM, identified by the key identifier of
+// at most 255 bytes. The identifier is stored with the encrypted data to
+// select the key for decryption. Nonces are random, so that the key can be
+// shared between processes, and with AES-GCM, it should be rotated before
+// it encrypts 2^32 snapshots and journal entries in total.
+func NewEncryptedStore(s Store, keyID string, aead cipher.AEAD, opts ...EncryptionOption) (*EncryptedStore, error) {
+	e := &EncryptedStore{
+		store: s,
+		keyID: keyID,
+		aead:  aead,
+		keys:  make(map[string]cipher.AEAD),
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	e.keys[keyID] = aead
+	for id := range e.keys {
+		if id == "" || len(id) > 255 {
+			return nil, fmt.Errorf("store: invalid key identifier %q", id)
+		}
+	}
+	return e, nil
+}
+
+// Append encrypts the entry and appends it to the journal of the Store.
+func (s *EncryptedStore) Append(ctx context.Context, name string, entry []byte) error {
+	data, err := s.encrypt(entry, additionalData("journal", name))
+	if err != nil {
+		return err
+	}
+	return s.store.Append(ctx, name, data)
+}
+
+// Load decrypts the snapshot and the journal entries from the Store.
+func (s *EncryptedStore) Load(ctx context.Context, name string) (snapshot []byte, journal [][]byte, err error) {
+	data, entries, err := s.store.Load(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if data != nil {
+		if snapshot, err = s.decrypt(data, additionalData("snapshot", name)); err != nil {
+			return nil, nil, fmt.Errorf("snapshot: %w", err)
+		}
+	}
+	for i, data := range entries {
+		entry, err := s.decrypt(data, additionalData("journal", name))
+		if err != nil {
+			return nil, nil, fmt.Errorf("journal entry %v: %w", i, err)
+		}
+		journal = append(journal, entry)
+	}
+	return snapshot, journal, nil
+}
+
+// SaveSnapshot encrypts the snapshot and saves it in the Store.
+func (s *EncryptedStore) SaveSnapshot(ctx context.Context, name string, snapshot []byte) error {
+	data, err := s.encrypt(snapshot, additionalData("snapshot", name))
+	if err != nil {
+		return err
+	}
+	return s.store.SaveSnapshot(ctx, name, data)
+}
+
+// Delete removes the election from the Store.
+func (s *EncryptedStore) Delete(ctx context.Context, name string) error {
+	return s.store.Delete(ctx, name)
+}
+
+func (s *EncryptedStore) encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	header := make([]byte, 0, 2+len(s.keyID)+s.aead.NonceSize())
+	header = append(header, encryptionVersion, byte(len(s.keyID)))
+	header = append(header, s.keyID...)
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	header = append(header, nonce...)
+	// the header is authenticated together with the data
+	return s.aead.Seal(header, nonce, plaintext, append(additionalData, header...)), nil
+}
+
+func (s *EncryptedStore) decrypt(data, additionalData []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != encryptionVersion {
+		return nil, ErrDecryption
+	}
+	idEnd := 2 + int(data[1])
+	if len(data) < idEnd {
+		return nil, ErrDecryption
+	}
+	keyID := string(data[2:idEnd])
+	aead, ok := s.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrDecryption, keyID)
+	}
+	nonceEnd := idEnd + aead.NonceSize()
+	if len(data) < nonceEnd {
+		return nil, ErrDecryption
+	}
+	plaintext, err := aead.Open(nil, data[idEnd:nonceEnd], data[nonceEnd:], append(additionalData, data[:nonceEnd]...))
+	if err != nil {
+		return nil, ErrDecryption
+	}
+	return plaintext, nil
+}
+
+// additionalData binds the encrypted data to the kind of the data and the
+// election name.
+func additionalData(kind, name string) []byte {
+	b := append([]byte(kind), 0)
+	b = binary.BigEndian.AppendUint64(b, uint64(len(name)))
+	return append(b, name...)
+}
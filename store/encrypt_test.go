@@ -0,0 +1,138 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/store"
+)
+
+func TestEncryptedStore(t *testing.T) {
+	ctx := context.Background()
+	backend := newMemoryStore()
+
+	oldKey := newAESGCM(t)
+	s, err := store.NewEncryptedStore(backend, "2026-01", oldKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{
+		Choices: []string{"Alpha", "Beta"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteTagged(ctx, "alice", schulze.Ballot[string]{"Alpha": 1}, schulze.Tags{"region": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		return e.SetAttributes("alice", schulze.Attributes{"session": "secret-session"})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Checkpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "bob", schulze.Ballot[string]{"Beta": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	data := append([][]byte{backend.snapshots["board"]}, backend.journals["board"]...)
+	for _, d := range data {
+		for _, plaintext := range []string{"alice", "bob", "Alpha", "north", "secret-session"} {
+			if bytes.Contains(d, []byte(plaintext)) {
+				t.Errorf("got plaintext %q in stored data", plaintext)
+			}
+		}
+	}
+
+	// the key is rotated, and the data encrypted with the previous key
+	// is still readable
+	newKey := newAESGCM(t)
+	s, err = store.NewEncryptedStore(backend, "2026-02", newKey, store.WithDecryptionKey("2026-01", oldKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err = store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Checkpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// after the checkpoint, the previous key is no longer needed
+	s, err = store.NewEncryptedStore(backend, "2026-02", newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err = store.Open[string](ctx, s, "board", schulze.ElectionConfig[string]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		if got := e.VotersCount(); got != 2 {
+			t.Errorf("got voters count %v, want %v", got, 2)
+		}
+		if got := e.Attributes("alice")["session"]; got != "secret-session" {
+			t.Errorf("got session attribute %q, want %q", got, "secret-session")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// data of one election can not be used for another one
+	backend.snapshots["other"] = backend.snapshots["board"]
+	if _, _, err := s.Load(ctx, "other"); !errors.Is(err, store.ErrDecryption) {
+		t.Errorf("got error %v, want %v", err, store.ErrDecryption)
+	}
+
+	// the data can not be decrypted without the key
+	s, err = store.NewEncryptedStore(backend, "2026-01", oldKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.Load(ctx, "board"); !errors.Is(err, store.ErrDecryption) {
+		t.Errorf("got error %v, want %v", err, store.ErrDecryption)
+	}
+
+	// modified data is detected
+	backend.snapshots["board"][len(backend.snapshots["board"])-1] ^= 1
+	s, err = store.NewEncryptedStore(backend, "2026-02", newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.Load(ctx, "board"); !errors.Is(err, store.ErrDecryption) {
+		t.Errorf("got error %v, want %v", err, store.ErrDecryption)
+	}
+}
+
+func newAESGCM(t *testing.T) cipher.AEAD {
+	t.Helper()
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return aead
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import "time"
+
+// SetNow replaces the clock of the store for testing purposes.
+func (s *MemoryStore) SetNow(now func() time.Time) {
+	s.now = now
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrExpired is returned by the MemoryStore when changes are appended to the
+// journal of an election that expired or that has no snapshot.
+var ErrExpired = errors.New("store: election expired")
+
+// MemoryOption configures the MemoryStore.
+type MemoryOption func(*MemoryStore)
+
+// WithEvictionHandler sets the function that is called with the snapshot and
+// the journal of every election that is removed from the MemoryStore after
+// it expired, such as to archive its final results, which are computed from
+// the election constructed by the Restore function. It is not called for
+// elections that are deleted.
+func WithEvictionHandler(f func(name string, snapshot []byte, journal [][]byte)) MemoryOption {
+	return func(s *MemoryStore) {
+		s.onEvict = f
+	}
+}
+
+// MemoryStore is a Store that keeps elections in memory for the time to
+// live after their first snapshot is saved, suitable for ephemeral polls,
+// such as throwaway chat polls. Expired elections are not loaded, their
+// journal is not appended to, and they are removed by the Evict method.
+type MemoryStore struct {
+	ttl     time.Duration
+	onEvict func(name string, snapshot []byte, journal [][]byte)
+	now     func() time.Time
+
+	mu        sync.Mutex
+	elections map[string]*memoryElection
+}
+
+type memoryElection struct {
+	snapshot []byte
+	journal  [][]byte
+	expires  time.Time
+}
+
+// NewMemoryStore returns the MemoryStore with elections that expire after
+// the time to live.
+func NewMemoryStore(ttl time.Duration, opts ...MemoryOption) *MemoryStore {
+	s := &MemoryStore{
+		ttl:       ttl,
+		now:       time.Now,
+		elections: make(map[string]*memoryElection),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Append appends the entry to the journal of the election, or returns
+// ErrExpired.
+func (s *MemoryStore) Append(_ context.Context, name string, entry []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.elections[name]
+	if !ok || s.expired(e) {
+		return ErrExpired
+	}
+	e.journal = append(e.journal, append([]byte(nil), entry...))
+	return nil
+}
+
+// Load returns the snapshot and the journal of the election, or no snapshot
+// if the election does not exist or is expired.
+func (s *MemoryStore) Load(_ context.Context, name string) (snapshot []byte, journal [][]byte, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.elections[name]
+	if !ok || s.expired(e) {
+		return nil, nil, nil
+	}
+	return e.snapshot, append([][]byte(nil), e.journal...), nil
+}
+
+// SaveSnapshot replaces the snapshot of the election and discards its
+// journal. The time to live starts with the first snapshot, and
+// ErrExpired is returned for the expired election that is not yet evicted.
+func (s *MemoryStore) SaveSnapshot(_ context.Context, name string, snapshot []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot = append([]byte(nil), snapshot...)
+	e, ok := s.elections[name]
+	if !ok {
+		s.elections[name] = &memoryElection{
+			snapshot: snapshot,
+			expires:  s.now().Add(s.ttl),
+		}
+		return nil
+	}
+	if s.expired(e) {
+		return ErrExpired
+	}
+	e.snapshot = snapshot
+	e.journal = nil
+	return nil
+}
+
+// Delete removes the election without calling the eviction handler.
+func (s *MemoryStore) Delete(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.elections, name)
+	return nil
+}
+
+// Evict removes all expired elections, calling the eviction handler for
+// each of them, and returns the number of removed elections.
+func (s *MemoryStore) Evict() int {
+	s.mu.Lock()
+	evicted := make(map[string]*memoryElection)
+	for name, e := range s.elections {
+		if s.expired(e) {
+			evicted[name] = e
+			delete(s.elections, name)
+		}
+	}
+	s.mu.Unlock()
+
+	if s.onEvict != nil {
+		for name, e := range evicted {
+			s.onEvict(name, e.snapshot, e.journal)
+		}
+	}
+	return len(evicted)
+}
+
+// RunEviction calls the Evict method in the interval until the context is
+// done.
+func (s *MemoryStore) RunEviction(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		s.Evict()
+	}
+}
+
+func (s *MemoryStore) expired(e *memoryElection) bool {
+	return !s.now().Before(e.expires)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/store"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	var archived []string
+	s := store.NewMemoryStore(time.Hour, store.WithEvictionHandler(func(name string, snapshot []byte, journal [][]byte) {
+		e, err := store.Restore[string, string](snapshot, journal)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		results, _, _, err := e.Compute()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		archived = append(archived, name+": "+results[0].Choice)
+	}))
+	s.SetNow(func() time.Time { return now })
+
+	lunch, err := store.Open[string](ctx, s, "lunch", schulze.ElectionConfig[string]{
+		Choices: []string{"pizza", "sushi"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lunch.Vote(ctx, "alice", schulze.Ballot[string]{"sushi": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(30 * time.Minute)
+	if _, err := store.Open[string](ctx, s, "dinner", schulze.ElectionConfig[string]{
+		Choices: []string{"pasta", "tacos"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if n := s.Evict(); n != 0 {
+		t.Errorf("got %v evicted elections, want none", n)
+	}
+
+	now = now.Add(30 * time.Minute)
+	if _, err := lunch.Vote(ctx, "bob", schulze.Ballot[string]{"pizza": 1}); !errors.Is(err, store.ErrExpired) {
+		t.Errorf("got error %v, want %v", err, store.ErrExpired)
+	}
+	if snapshot, _, err := s.Load(ctx, "lunch"); err != nil || snapshot != nil {
+		t.Errorf("got snapshot %q and error %v of the expired election, want none", snapshot, err)
+	}
+
+	if n := s.Evict(); n != 1 {
+		t.Errorf("got %v evicted elections, want %v", n, 1)
+	}
+	if want := []string{"lunch: sushi"}; len(archived) != 1 || archived[0] != want[0] {
+		t.Errorf("got archived %q, want %q", archived, want)
+	}
+
+	// the name of the evicted election can be used again
+	if _, err := store.Open[string](ctx, s, "lunch", schulze.ElectionConfig[string]{
+		Choices: []string{"salad", "soup"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, "dinner"); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Hour)
+	if n := s.Evict(); n != 1 {
+		t.Errorf("got %v evicted elections, want %v", n, 1)
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxErrorSize is the maximal size of the error response that is read.
+const maxErrorSize = 4 << 10
+
+// DefaultRegion is the default region of the Client requests signatures.
+const DefaultRegion = "us-east-1"
+
+// Credentials are the access keys of the Client.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// Token of temporary credentials.
+	SessionToken string
+}
+
+// Error is returned by the Client when the service responds with an error.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("s3: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// ClientOption configures the Client.
+type ClientOption func(*Client)
+
+// WithRegion sets the region of request signatures, DefaultRegion by
+// default.
+func WithRegion(region string) ClientOption {
+	return func(c *Client) {
+		c.region = region
+	}
+}
+
+// WithHTTPClient sets the HTTP client of requests.
+func WithHTTPClient(h *http.Client) ClientOption {
+	return func(c *Client) {
+		c.client = h
+	}
+}
+
+// Client is a minimal Bucket client of S3 compatible services, such as AWS
+// S3, MinIO, Cloudflare R2 and Ceph, with path style requests that are
+// signed with AWS Signature Version 4.
+type Client struct {
+	endpoint    *url.URL
+	bucket      string
+	credentials Credentials
+	region      string
+	client      *http.Client
+	now         func() time.Time
+}
+
+// NewClient returns the Client of the bucket at the service endpoint, such
+// as "https://s3.eu-central-1.amazonaws.com".
+func NewClient(endpoint, bucket string, credentials Credentials, opts ...ClientOption) (*Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("s3: invalid endpoint %q", endpoint)
+	}
+	c := &Client{
+		endpoint:    u,
+		bucket:      bucket,
+		credentials: credentials,
+		region:      DefaultRegion,
+		client:      http.DefaultClient,
+		now:         time.Now,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c, nil
+}
+
+// Put uploads the object.
+func (c *Client) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := c.do(ctx, http.MethodPut, key, nil, data)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get downloads the object.
+func (c *Client) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		var e *Error
+		if errors.As(err, &e) && e.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// List lists keys of objects with the prefix with as many ListObjectsV2
+// requests as needed.
+func (c *Client) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	var token string
+	for {
+		query := url.Values{
+			"list-type": {"2"},
+			"prefix":    {prefix},
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := c.do(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Contents []struct {
+				Key string
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("s3: list objects: %w", err)
+		}
+		for _, c := range result.Contents {
+			keys = append(keys, c.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Delete deletes the object.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	resp, err := c.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		var e *Error
+		if errors.As(err, &e) && e.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// do sends the signed request and returns the response with a successful
+// status, or the Error.
+func (c *Client) do(ctx context.Context, method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+
+	r, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("X-Amz-Content-Sha256", hashHex(body))
+	if c.credentials.SessionToken != "" {
+		r.Header.Set("X-Amz-Security-Token", c.credentials.SessionToken)
+	}
+	sign(r, c.credentials, c.region, c.now())
+
+	resp, err := c.client.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	e := &Error{
+		StatusCode: resp.StatusCode,
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorSize))
+	var xmlErr struct {
+		Code    string
+		Message string
+	}
+	if xml.Unmarshal(data, &xmlErr) == nil {
+		e.Code = xmlErr.Code
+		e.Message = xmlErr.Message
+	}
+	if e.Code == "" {
+		e.Code = http.StatusText(resp.StatusCode)
+	}
+	return nil, e
+}
+
+// sign adds the AWS Signature Version 4 authorization header to the
+// request, signing the host and all headers of the request, which must have
+// the X-Amz-Content-Sha256 header.
+func sign(r *http.Request, credentials Credentials, region string, t time.Time) {
+	t = t.UTC()
+	date := t.Format("20060102")
+	amzDate := t.Format("20060102T150405Z")
+	r.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{
+		"host": r.URL.Host,
+	}
+	for k, v := range r.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		uriEncode(r.URL.Path, false),
+		canonicalQuery(r.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+credentials.AccessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+// canonicalQuery encodes the query parameters sorted by their names, as
+// required by the signature.
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for k := range query {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var parts []string
+	for _, k := range names {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes all bytes except the unreserved characters,
+// and the slash if it does not encode a query component.
+func uriEncode(s string, query bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !query:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
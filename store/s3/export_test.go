@@ -0,0 +1,9 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package s3
+
+// Sign is exported for testing purposes.
+var Sign = sign
@@ -0,0 +1,308 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package s3 provides a store.Store that keeps snapshots and journals of
+// elections in S3 compatible object storage, for deployments without local
+// disk state, such as serverless functions.
+//
+// Objects of every election are under the prefix of its path escaped name:
+//
+//	<prefix><name>/journal/<index>
+//	<prefix><name>/snapshot/<index>.gz
+//
+// Journal entries are stored as separate objects with zero padded,
+// increasing indexes. Snapshots are gzip compressed, and their index is the
+// index of the first journal entry that is not included in them. As all keys
+// sort in the order they are written, and journals and snapshots have
+// separate prefixes, bucket lifecycle rules can target them independently,
+// for example to move older snapshots to a cheaper storage class.
+package s3
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrNotFound is returned by the Bucket when the object does not exist.
+var ErrNotFound = errors.New("s3: object not found")
+
+// Bucket is the object storage of the Store. The Client implements it for
+// S3 compatible services, and it can be implemented with the AWS SDK or
+// other storage clients.
+type Bucket interface {
+	// Put creates or replaces the object with the key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the content of the object with the key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// List returns keys of all objects with the prefix in the ascending
+	// order.
+	List(ctx context.Context, prefix string) ([]string, error)
+	// Delete removes the object with the key. It is not an error to delete
+	// an object that does not exist.
+	Delete(ctx context.Context, key string) error
+}
+
+// indexDigits is the number of digits of zero padded object indexes, enough
+// for all uint64 values, so that keys sort in the order of indexes.
+const indexDigits = 20
+
+// Option configures the Store.
+type Option func(*Store)
+
+// WithPrefix sets the prefix of all object keys, such as "elections/".
+func WithPrefix(prefix string) Option {
+	return func(s *Store) {
+		s.prefix = prefix
+	}
+}
+
+// WithSnapshotRetention sets the number of the most recent snapshots that
+// are kept for every election, one by default. Older snapshots are deleted
+// when a new one is saved.
+func WithSnapshotRetention(n int) Option {
+	return func(s *Store) {
+		s.retention = n
+	}
+}
+
+// Store is a store.Store with objects in the Bucket.
+type Store struct {
+	bucket    Bucket
+	prefix    string
+	retention int
+
+	mu sync.Mutex
+	// next journal indexes of elections
+	next map[string]uint64
+}
+
+// NewStore returns the Store of elections in the Bucket.
+func NewStore(b Bucket, opts ...Option) *Store {
+	s := &Store{
+		bucket:    b,
+		retention: 1,
+		next:      make(map[string]uint64),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	if s.retention < 1 {
+		s.retention = 1
+	}
+	return s
+}
+
+// Append puts the entry as a new journal object of the election.
+func (s *Store) Append(ctx context.Context, name string, entry []byte) error {
+	index, err := s.nextIndex(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := s.bucket.Put(ctx, s.key(name, "journal", index, ""), entry); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.next[name] = index + 1
+	s.mu.Unlock()
+	return nil
+}
+
+// Load returns the latest snapshot of the election and the journal entries
+// that are not included in it.
+func (s *Store) Load(ctx context.Context, name string) (snapshot []byte, journal [][]byte, err error) {
+	snapshots, err := s.list(ctx, name, "snapshot")
+	if err != nil {
+		return nil, nil, err
+	}
+	var start uint64
+	if len(snapshots) > 0 {
+		latest := snapshots[len(snapshots)-1]
+		data, err := s.bucket.Get(ctx, latest.key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if snapshot, err = gunzip(data); err != nil {
+			return nil, nil, fmt.Errorf("snapshot %s: %w", latest.key, err)
+		}
+		start = latest.index
+	}
+	entries, err := s.list(ctx, name, "journal")
+	if err != nil {
+		return nil, nil, err
+	}
+	next := start
+	for _, o := range entries {
+		if o.index < start {
+			continue
+		}
+		data, err := s.bucket.Get(ctx, o.key)
+		if err != nil {
+			return nil, nil, err
+		}
+		journal = append(journal, data)
+		next = o.index + 1
+	}
+	s.mu.Lock()
+	s.next[name] = next
+	s.mu.Unlock()
+	return snapshot, journal, nil
+}
+
+// SaveSnapshot puts the compressed snapshot of the election, and deletes
+// the journal objects that are included in it and snapshots that are over
+// the retention.
+func (s *Store) SaveSnapshot(ctx context.Context, name string, snapshot []byte) error {
+	index, err := s.nextIndex(ctx, name)
+	if err != nil {
+		return err
+	}
+	data, err := gzipData(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := s.bucket.Put(ctx, s.key(name, "snapshot", index, ".gz"), data); err != nil {
+		return err
+	}
+
+	// objects that remain after a failed deletion are ignored by Load
+	entries, err := s.list(ctx, name, "journal")
+	if err != nil {
+		return err
+	}
+	for _, o := range entries {
+		if o.index >= index {
+			break
+		}
+		if err := s.bucket.Delete(ctx, o.key); err != nil {
+			return err
+		}
+	}
+	snapshots, err := s.list(ctx, name, "snapshot")
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(snapshots)-s.retention; i++ {
+		if err := s.bucket.Delete(ctx, snapshots[i].key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes all objects of the election.
+func (s *Store) Delete(ctx context.Context, name string) error {
+	keys, err := s.bucket.List(ctx, s.electionPrefix(name))
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := s.bucket.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	delete(s.next, name)
+	s.mu.Unlock()
+	return nil
+}
+
+// nextIndex returns the index of the next journal entry of the election,
+// listing its journal objects if the election is not loaded.
+func (s *Store) nextIndex(ctx context.Context, name string) (uint64, error) {
+	s.mu.Lock()
+	index, ok := s.next[name]
+	s.mu.Unlock()
+	if ok {
+		return index, nil
+	}
+	for _, kind := range []string{"journal", "snapshot"} {
+		objects, err := s.list(ctx, name, kind)
+		if err != nil {
+			return 0, err
+		}
+		if len(objects) == 0 {
+			continue
+		}
+		last := objects[len(objects)-1].index
+		if kind == "journal" {
+			last++
+		}
+		if last > index {
+			index = last
+		}
+	}
+	s.mu.Lock()
+	s.next[name] = index
+	s.mu.Unlock()
+	return index, nil
+}
+
+// object is a journal or snapshot object with its index.
+type object struct {
+	key   string
+	index uint64
+}
+
+// list returns journal or snapshot objects of the election in the order
+// of their indexes, ignoring objects with keys that are not written by the
+// Store.
+func (s *Store) list(ctx context.Context, name, kind string) ([]object, error) {
+	prefix := s.electionPrefix(name) + kind + "/"
+	keys, err := s.bucket.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]object, 0, len(keys))
+	for _, key := range keys {
+		base := strings.TrimSuffix(path.Base(key), ".gz")
+		if len(base) != indexDigits {
+			continue
+		}
+		index, err := strconv.ParseUint(base, 10, 64)
+		if err != nil {
+			continue
+		}
+		objects = append(objects, object{key: key, index: index})
+	}
+	return objects, nil
+}
+
+func (s *Store) electionPrefix(name string) string {
+	return s.prefix + url.PathEscape(name) + "/"
+}
+
+func (s *Store) key(name, kind string, index uint64, ext string) string {
+	return fmt.Sprintf("%s%s/%0*d%s", s.electionPrefix(name), kind, indexDigits, index, ext)
+}
+
+func gzipData(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package s3_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/store"
+	"resenje.org/schulze/store/s3"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	bucket := newMemoryBucket()
+
+	s := s3.NewStore(bucket, s3.WithPrefix("elections/"), s3.WithSnapshotRetention(2))
+	e, err := store.Open[string](ctx, s, "team/board", schulze.ElectionConfig[string]{
+		Choices: []string{"A", "B", "C"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "alice", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "bob", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Checkpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "carol", schulze.Ballot[string]{"C": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Checkpoint(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "dave", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"elections/team%2Fboard/journal/00000000000000000003",
+		"elections/team%2Fboard/snapshot/00000000000000000002.gz",
+		"elections/team%2Fboard/snapshot/00000000000000000003.gz",
+	}
+	if got := bucket.keys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got keys %q, want %q", got, want)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(bucket.objects[want[2]]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(snapshot, []byte(`"carol"`)) {
+		t.Errorf("got snapshot %s, want the ballot of carol", snapshot)
+	}
+
+	// a new process restores the election from the bucket only
+	s = s3.NewStore(bucket, s3.WithPrefix("elections/"), s3.WithSnapshotRetention(2))
+	e, err = store.Open[string](ctx, s, "team/board", schulze.ElectionConfig[string]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Do(func(e *schulze.Election[string, string]) error {
+		if got := e.VotersCount(); got != 4 {
+			t.Errorf("got voters count %v, want %v", got, 4)
+		}
+		results, _, _, err := e.Compute()
+		if err != nil {
+			return err
+		}
+		if results[0].Choice != "A" {
+			t.Errorf("got winner %v, want %v", results[0].Choice, "A")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote(ctx, "erin", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := bucket.objects["elections/team%2Fboard/journal/00000000000000000004"]; !ok {
+		t.Errorf("got keys %q, want the next journal entry", bucket.keys())
+	}
+
+	if err := s.Delete(ctx, "team/board"); err != nil {
+		t.Fatal(err)
+	}
+	if got := bucket.keys(); len(got) != 0 {
+		t.Errorf("got keys %q after delete, want none", got)
+	}
+}
+
+type memoryBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newMemoryBucket() *memoryBucket {
+	return &memoryBucket{
+		objects: make(map[string][]byte),
+	}
+}
+
+func (b *memoryBucket) Put(_ context.Context, key string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.objects[key] = append([]byte(nil), data...)
+	return nil
+}
+
+func (b *memoryBucket) Get(_ context.Context, key string) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	data, ok := b.objects[key]
+	if !ok {
+		return nil, s3.ErrNotFound
+	}
+	return data, nil
+}
+
+func (b *memoryBucket) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for _, key := range b.keys() {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (b *memoryBucket) Delete(_ context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.objects, key)
+	return nil
+}
+
+func (b *memoryBucket) keys() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package store persists elections as snapshots of their state and journals
+// of changes after the snapshots. Votes of the Election type are appended to
+// the journal before they are acknowledged, so that an acknowledged vote is
+// not lost if the process crashes, or with the file store and its default
+// sync policy, if the power is lost, before it is included in a snapshot.
+package store
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Store persists snapshots and journals of named elections. Implementations
+// must be safe for concurrent calls for different elections. Calls for the
+// same election are not concurrent when they are made by the Election type.
+type Store interface {
+	// Append durably appends the entry to the journal of the election
+	// before it returns.
+	Append(ctx context.Context, name string, entry []byte) error
+	// Load returns the last saved snapshot of the election, nil if there is
+	// none, and the journal entries in the order they were appended.
+	Load(ctx context.Context, name string) (snapshot []byte, journal [][]byte, err error)
+	// SaveSnapshot replaces the snapshot of the election and discards the
+	// journal entries appended before it, as they are included in the
+	// snapshot.
+	SaveSnapshot(ctx context.Context, name string, snapshot []byte) error
+	// Delete removes the snapshot and the journal of the election.
+	Delete(ctx context.Context, name string) error
+}
+
+// FileStore is a Store with snapshots and journals in files of a directory.
+// Journals are WAL files, and snapshots are replaced atomically.
+type FileStore struct {
+	dir  string
+	opts []WALOption
+
+	mu   sync.Mutex
+	wals map[string]*WAL
+}
+
+// NewFileStore creates the directory if it does not exist and returns the
+// FileStore of the files in it. Options are applied to all journal files.
+func NewFileStore(dir string, opts ...WALOption) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileStore{
+		dir:  dir,
+		opts: opts,
+		wals: make(map[string]*WAL),
+	}, nil
+}
+
+// Append appends the entry to the journal file of the election.
+func (s *FileStore) Append(_ context.Context, name string, entry []byte) error {
+	w, err := s.wal(name)
+	if err != nil {
+		return err
+	}
+	return w.Append(entry)
+}
+
+// Load reads the snapshot and the journal files of the election.
+func (s *FileStore) Load(_ context.Context, name string) (snapshot []byte, journal [][]byte, err error) {
+	snapshot, err = os.ReadFile(s.path(name, ".snapshot"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+	w, err := s.wal(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	journal, err = w.Entries()
+	if err != nil {
+		return nil, nil, err
+	}
+	return snapshot, journal, nil
+}
+
+// SaveSnapshot atomically replaces the snapshot file of the election and
+// truncates its journal file.
+func (s *FileStore) SaveSnapshot(_ context.Context, name string, snapshot []byte) error {
+	if err := writeFileAtomic(s.path(name, ".snapshot"), snapshot); err != nil {
+		return err
+	}
+	w, err := s.wal(name)
+	if err != nil {
+		return err
+	}
+	return w.Reset()
+}
+
+// Delete removes the snapshot and the journal files of the election.
+func (s *FileStore) Delete(_ context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w, ok := s.wals[name]; ok {
+		delete(s.wals, name)
+		if err := w.Close(); err != nil {
+			return err
+		}
+	}
+	for _, ext := range []string{".snapshot", ".wal"} {
+		if err := os.Remove(s.path(name, ext)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return syncDir(s.dir)
+}
+
+// Close closes all journal files.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var err error
+	for name, w := range s.wals {
+		if e := w.Close(); e != nil && err == nil {
+			err = e
+		}
+		delete(s.wals, name)
+	}
+	return err
+}
+
+func (s *FileStore) wal(name string) (*WAL, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w, ok := s.wals[name]; ok {
+		return w, nil
+	}
+	w, err := OpenWAL(s.path(name, ".wal"), s.opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.wals[name] = w
+	return w, nil
+}
+
+// path returns the path of the election file, with the name escaped so
+// that it is a valid file name.
+func (s *FileStore) path(name, ext string) string {
+	return filepath.Join(s.dir, url.PathEscape(name)+ext)
+}
+
+// writeFileAtomic writes the data to a temporary file and renames it to the
+// path, syncing both the file and the directory.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
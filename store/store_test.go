@@ -0,0 +1,79 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze/store"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := store.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	name := "team/board election"
+
+	snapshot, journal, err := s.Load(ctx, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot != nil || journal != nil {
+		t.Errorf("got snapshot %q and journal %q, want none", snapshot, journal)
+	}
+
+	for _, e := range []string{"1", "2"} {
+		if err := s.Append(ctx, name, []byte(e)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.SaveSnapshot(ctx, name, []byte("snapshot")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Append(ctx, name, []byte("3")); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, journal, err = s.Load(ctx, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(snapshot) != "snapshot" {
+		t.Errorf("got snapshot %q, want %q", snapshot, "snapshot")
+	}
+	if want := [][]byte{[]byte("3")}; !reflect.DeepEqual(journal, want) {
+		t.Errorf("got journal %q, want %q", journal, want)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Errorf("got files %v, want a snapshot and a journal", files)
+	}
+
+	if err := s.Delete(ctx, name); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("got %v files after delete, want none", len(entries))
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// SyncPolicy defines when the entries of the WAL are synced to stable
+// storage.
+type SyncPolicy int
+
+const (
+	// SyncAlways syncs the file after every entry, before Append returns, so
+	// that every appended entry survives a power loss.
+	SyncAlways SyncPolicy = iota
+	// SyncBatch syncs entries that are appended concurrently with a single
+	// sync of the file. Append still returns only after its entry is synced,
+	// so that the guarantee of SyncAlways is kept with a higher throughput
+	// and a higher latency of individual appends.
+	SyncBatch
+	// SyncNever leaves syncing to the operating system. Appended entries
+	// survive a crash of the process, but not a power loss.
+	SyncNever
+)
+
+// String returns the name of the sync policy.
+func (p SyncPolicy) String() string {
+	switch p {
+	case SyncAlways:
+		return "always"
+	case SyncBatch:
+		return "batch"
+	case SyncNever:
+		return "never"
+	}
+	return fmt.Sprintf("SyncPolicy(%d)", int(p))
+}
+
+// walHeaderSize is the size of the entry header with the length and the
+// CRC-32C checksum of the entry data.
+const walHeaderSize = 8
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// WAL is an append-only write-ahead log of entries in a single file. Every
+// entry is framed with its length and a checksum, so that an entry that is
+// partially written by a crash or a power loss is detected and discarded
+// when the log is opened. Methods on the WAL type are safe for concurrent
+// calls.
+type WAL struct {
+	f      *os.File
+	policy SyncPolicy
+
+	mu   sync.Mutex
+	size int64
+	// err is set when the file can not be written or synced, as the state
+	// of the file is not known after a failed sync
+	err error
+
+	syncMu sync.Mutex
+	synced int64
+}
+
+// WALOption configures the WAL.
+type WALOption func(*WAL)
+
+// WithSyncPolicy sets the sync policy of the WAL, SyncAlways by default.
+func WithSyncPolicy(p SyncPolicy) WALOption {
+	return func(w *WAL) {
+		w.policy = p
+	}
+}
+
+// OpenWAL opens the log file at the path, creating it if it does not exist.
+// Entries after the first one that is incomplete or that does not match its
+// checksum are the result of an interrupted append, and they are truncated
+// from the file.
+func OpenWAL(path string, opts ...WALOption) (*WAL, error) {
+	_, statErr := os.Stat(path)
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	w := &WAL{
+		f: f,
+	}
+	for _, o := range opts {
+		o(w)
+	}
+	if err := w.recover(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if os.IsNotExist(statErr) {
+		// the directory entry of the new file must be durable as well
+		if err := syncDir(filepath.Dir(path)); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// recover finds the end of the last complete entry and truncates the file
+// after it.
+func (w *WAL) recover() error {
+	info, err := w.f.Stat()
+	if err != nil {
+		return err
+	}
+	end, err := scanWAL(io.NewSectionReader(w.f, 0, info.Size()), nil)
+	if err != nil {
+		return err
+	}
+	if end < info.Size() {
+		if err := w.f.Truncate(end); err != nil {
+			return err
+		}
+		if err := w.f.Sync(); err != nil {
+			return err
+		}
+	}
+	w.size = end
+	w.synced = end
+	return nil
+}
+
+// Append appends the entry to the log and returns when it is synced by the
+// sync policy. Once the file can not be written or synced, all subsequent
+// appends return the same error, and the log must be reopened.
+func (w *WAL) Append(data []byte) error {
+	frame := make([]byte, walHeaderSize+len(data))
+	binary.BigEndian.PutUint32(frame, uint32(len(data)))
+	binary.BigEndian.PutUint32(frame[4:], crc32.Checksum(data, crcTable))
+	copy(frame[walHeaderSize:], data)
+
+	w.mu.Lock()
+	if w.err != nil {
+		w.mu.Unlock()
+		return w.err
+	}
+	if _, err := w.f.WriteAt(frame, w.size); err != nil {
+		w.err = err
+		w.mu.Unlock()
+		return err
+	}
+	w.size += int64(len(frame))
+	end := w.size
+	if w.policy == SyncAlways {
+		if err := w.f.Sync(); err != nil {
+			w.err = err
+			w.mu.Unlock()
+			return err
+		}
+	}
+	w.mu.Unlock()
+
+	if w.policy == SyncBatch {
+		return w.syncTo(end)
+	}
+	return nil
+}
+
+// syncTo syncs the file if the entries up to the end offset are not synced
+// by a concurrent call already.
+func (w *WAL) syncTo(end int64) error {
+	w.syncMu.Lock()
+	defer w.syncMu.Unlock()
+
+	w.mu.Lock()
+	if w.err != nil {
+		w.mu.Unlock()
+		return w.err
+	}
+	if w.synced >= end {
+		w.mu.Unlock()
+		return nil
+	}
+	size := w.size
+	w.mu.Unlock()
+
+	err := w.f.Sync()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err != nil {
+		w.err = err
+		return err
+	}
+	w.synced = size
+	return nil
+}
+
+// Entries returns all entries of the log in the order they were appended.
+func (w *WAL) Entries() ([][]byte, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var entries [][]byte
+	if _, err := scanWAL(io.NewSectionReader(w.f, 0, w.size), func(data []byte) {
+		entries = append(entries, data)
+	}); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Reset removes all entries from the log, such as after they are included
+// in a snapshot.
+func (w *WAL) Reset() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.f.Truncate(0); err != nil {
+		w.err = err
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		w.err = err
+		return err
+	}
+	w.size = 0
+	w.synced = 0
+	return nil
+}
+
+// Close syncs and closes the log file.
+func (w *WAL) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err == nil {
+		if err := w.f.Sync(); err != nil {
+			w.f.Close()
+			return err
+		}
+	}
+	return w.f.Close()
+}
+
+// scanWAL reads entries from the reader, calling the function for each of
+// them, and returns the offset of the end of the last complete entry.
+func scanWAL(r *io.SectionReader, f func(data []byte)) (int64, error) {
+	var offset int64
+	header := make([]byte, walHeaderSize)
+	for {
+		if _, err := r.ReadAt(header, offset); err != nil {
+			if errors.Is(err, io.EOF) {
+				return offset, nil
+			}
+			return 0, err
+		}
+		length := int64(binary.BigEndian.Uint32(header))
+		if length > r.Size()-offset-walHeaderSize {
+			return offset, nil
+		}
+		data := make([]byte, length)
+		if length > 0 {
+			if _, err := r.ReadAt(data, offset+walHeaderSize); err != nil {
+				return 0, err
+			}
+		}
+		if crc32.Checksum(data, crcTable) != binary.BigEndian.Uint32(header[4:]) {
+			return offset, nil
+		}
+		if f != nil {
+			f(data)
+		}
+		offset += walHeaderSize + length
+	}
+}
+
+// syncDir syncs the directory so that the changes of its entries, such as
+// created and renamed files, are durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
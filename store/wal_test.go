@@ -0,0 +1,178 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"resenje.org/schulze/store"
+)
+
+func TestWAL(t *testing.T) {
+	for _, policy := range []store.SyncPolicy{store.SyncAlways, store.SyncBatch, store.SyncNever} {
+		t.Run(policy.String(), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "journal.wal")
+
+			w, err := store.OpenWAL(path, store.WithSyncPolicy(policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					if err := w.Append([]byte(fmt.Sprint(i))); err != nil {
+						t.Error(err)
+					}
+				}(i)
+			}
+			wg.Wait()
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			w, err = store.OpenWAL(path, store.WithSyncPolicy(policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer w.Close()
+			entries, err := w.Entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 20 {
+				t.Errorf("got %v entries, want %v", len(entries), 20)
+			}
+
+			if err := w.Reset(); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Append([]byte("after reset")); err != nil {
+				t.Fatal(err)
+			}
+			entries, err = w.Entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := [][]byte{[]byte("after reset")}; !reflect.DeepEqual(entries, want) {
+				t.Errorf("got entries %q, want %q", entries, want)
+			}
+		})
+	}
+}
+
+func TestWAL_recovery(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// crash modifies the file as an interrupted append would
+		crash func(t *testing.T, path string)
+	}{
+		{
+			name: "torn header",
+			crash: func(t *testing.T, path string) {
+				appendFile(t, path, []byte{0, 0, 0})
+			},
+		},
+		{
+			name: "torn data",
+			crash: func(t *testing.T, path string) {
+				info, err := os.Stat(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Truncate(path, info.Size()-2); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		{
+			name: "garbage",
+			crash: func(t *testing.T, path string) {
+				appendFile(t, path, []byte{0, 0, 0, 4, 1, 2, 3, 4, 'v', 'o', 't', 'e'})
+			},
+		},
+		{
+			name: "unwritten length",
+			crash: func(t *testing.T, path string) {
+				appendFile(t, path, []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0})
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "journal.wal")
+
+			w, err := store.OpenWAL(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range []string{"first", "second", "third"} {
+				if err := w.Append([]byte(e)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			tc.crash(t, path)
+
+			w, err = store.OpenWAL(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			entries, err := w.Entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+			if tc.name == "torn data" {
+				want = want[:2]
+			}
+			if !reflect.DeepEqual(entries, want) {
+				t.Errorf("got entries %q, want %q", entries, want)
+			}
+
+			// appends continue after the last complete entry
+			if err := w.Append([]byte("fourth")); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			w, err = store.OpenWAL(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer w.Close()
+			entries, err = w.Entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := append(want, []byte("fourth")); !reflect.DeepEqual(entries, want) {
+				t.Errorf("got entries %q, want %q", entries, want)
+			}
+		})
+	}
+}
+
+func appendFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"runtime"
+	"sync"
+)
+
+// PathStrengthComputer calculates the strengths of the strongest paths between
+// every pair of choices from the pairwise preferences. It is the most
+// computationally expensive part of the Schulze method and implementations
+// can delegate it to accelerated hardware or external libraries for votings
+// with a very large number of choices.
+type PathStrengthComputer interface {
+	// PathStrengths returns a new slice of the same length and layout as the
+	// preferences, where the element at index i*choicesCount+j is the
+	// strength of the strongest path from the choice with index i to the
+	// choice with index j, or zero if there is no such path. Preferences
+	// must not be modified.
+	PathStrengths(preferences []int, choicesCount int) []int
+}
+
+// WithPathStrengthComputer sets the PathStrengthComputer that Compute uses
+// instead of the built-in sequential computation. Progress of computations
+// by the PathStrengthComputer is reported only when it is completed.
+func WithPathStrengthComputer[C comparable](c PathStrengthComputer) Option[C] {
+	return func(o *options[C]) {
+		o.pathStrengthComputer = c
+	}
+}
+
+// ParallelPathStrengthComputer is a reference PathStrengthComputer that
+// splits the rows of the strengths matrix between multiple goroutines in
+// every iteration of the Floyd–Warshall algorithm. It is beneficial only for
+// votings with a large number of choices.
+type ParallelPathStrengthComputer struct {
+	// Number of goroutines. If it is not positive, the value of
+	// runtime.GOMAXPROCS is used.
+	Workers int
+}
+
+// PathStrengths implements the PathStrengthComputer interface.
+func (c ParallelPathStrengthComputer) PathStrengths(preferences []int, choicesCount int) []int {
+	if choicesCount == 0 {
+		return nil
+	}
+
+	strengths := make([]int, choicesCount*choicesCount)
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			if c := preferences[i*choicesCount+j]; c > preferences[j*choicesCount+i] {
+				strengths[i*choicesCount+j] = c
+			}
+		}
+	}
+
+	workers := c.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > choicesCount {
+		workers = choicesCount
+	}
+	rowsPerWorker := (choicesCount + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for i := 0; i < choicesCount; i++ {
+		// the row i is not changed in the iteration i, as the strength
+		// from the choice to itself is zero, so that all other rows can be
+		// updated concurrently
+		ii := strengths[i*choicesCount : (i+1)*choicesCount]
+		for start := 0; start < choicesCount; start += rowsPerWorker {
+			end := start + rowsPerWorker
+			if end > choicesCount {
+				end = choicesCount
+			}
+			wg.Add(1)
+			go func(start, end int) {
+				defer wg.Done()
+				for j := start; j < end; j++ {
+					if j == i {
+						continue
+					}
+					jj := strengths[j*choicesCount : (j+1)*choicesCount]
+					ji := jj[i]
+					if ji == 0 {
+						continue
+					}
+					for k, ik := range ii {
+						if k == j {
+							continue
+						}
+						if m := min(ji, ik); m > jj[k] {
+							jj[k] = m
+						}
+					}
+				}
+			}(start, end)
+		}
+		wg.Wait()
+	}
+
+	return strengths
+}
+
+func pathStrengths[C comparable](choices []C, preferences []int, o options[C]) []int {
+	if o.strengthVariant == StrengthMargins {
+		preferences = marginsPreferences(preferences, len(choices))
+	}
+	var strengths []int
+	if o.pathStrengthComputer == nil {
+		strengths = calculatePairwiseStrengths(choices, preferences, o.strengthsLayout, o.progress)
+	} else {
+		strengths = o.pathStrengthComputer.PathStrengths(preferences, len(choices))
+		if o.progress != nil {
+			// progress of the custom computation is not known
+			o.progress(len(choices), len(choices))
+		}
+	}
+	if o.dualRun {
+		checkDualRun(preferences, strengths, len(choices), o)
+	}
+	return strengths
+}
+
+// StrengthVariant defines how the strength of a link between two choices is
+// measured in the pairwise preferences graph.
+type StrengthVariant int
+
+const (
+	// StrengthWinningVotes measures the strength of a link by the number of
+	// votes for the winner of the pairwise comparison. This is the default
+	// variant, recommended by the author of the method.
+	StrengthWinningVotes StrengthVariant = iota
+	// StrengthMargins measures the strength of a link by the difference
+	// between the number of votes for the winner and for the defeated choice
+	// of the pairwise comparison.
+	StrengthMargins
+)
+
+// WithStrengthVariant sets the variant of the link strength measure. Results
+// and duels strengths are expressed in the same measure. Incremental
+// computation is not performed for StrengthMargins.
+func WithStrengthVariant[C comparable](variant StrengthVariant) Option[C] {
+	return func(o *options[C]) {
+		o.strengthVariant = variant
+	}
+}
+
+// marginsPreferences returns new preferences where every value is the
+// positive difference between the number of votes for and against the
+// preference, so that the strengths computation measures margins.
+func marginsPreferences(preferences []int, choicesCount int) []int {
+	margins := make([]int, len(preferences))
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if m := preferences[i*choicesCount+j] - preferences[j*choicesCount+i]; m > 0 {
+				margins[i*choicesCount+j] = m
+			}
+		}
+	}
+	return margins
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestParallelPathStrengthComputer(t *testing.T) {
+	choices := newChoices(50)
+
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range randomBallots(t, choices, 200) {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wantResults, wantDuels, wantTie := schulze.Compute(preferences, choices)
+	want := collectDuels(wantDuels)
+
+	for _, workers := range []int{0, 1, 3, 50, 100} {
+		t.Run(fmt.Sprintf("workers %v", workers), func(t *testing.T) {
+			c := schulze.ParallelPathStrengthComputer{Workers: workers}
+
+			results, duels, tie := schulze.Compute(preferences, choices, schulze.WithPathStrengthComputer[string](c))
+			if tie != wantTie {
+				t.Errorf("got tie %v, want %v", tie, wantTie)
+			}
+			if !reflect.DeepEqual(results, wantResults) {
+				t.Errorf("got results %+v, want %+v", results, wantResults)
+			}
+			if got := collectDuels(duels); !reflect.DeepEqual(got, want) {
+				t.Errorf("got duels %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+type countingPathStrengthComputer struct {
+	schulze.PathStrengthComputer
+	calls int
+}
+
+func (c *countingPathStrengthComputer) PathStrengths(preferences []int, choicesCount int) []int {
+	c.calls++
+	return c.PathStrengthComputer.PathStrengths(preferences, choicesCount)
+}
+
+func TestWithPathStrengthComputer(t *testing.T) {
+	c := &countingPathStrengthComputer{PathStrengthComputer: schulze.ParallelPathStrengthComputer{}}
+
+	v := schulze.NewVoting([]string{"A", "B", "C"}, schulze.WithPathStrengthComputer[string](c))
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, _, _ := v.Compute()
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "B")
+	}
+	if c.calls != 1 {
+		t.Errorf("got %v calls, want %v", c.calls, 1)
+	}
+}
+
+func TestPairwiseStrengths(t *testing.T) {
+	choices := newChoices(10)
+
+	preferences := schulze.NewPreferences(len(choices))
+	for _, b := range randomBallots(t, choices, 50) {
+		if _, err := schulze.Vote(preferences, choices, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wantResults, wantDuels, wantTie := schulze.Compute(preferences, choices)
+
+	strengths := schulze.PairwiseStrengths(preferences, choices)
+	if len(strengths) != len(preferences) {
+		t.Fatalf("got strengths length %v, want %v", len(strengths), len(preferences))
+	}
+	results, duels, tie := schulze.RankFromStrengths(strengths, choices)
+
+	if tie != wantTie {
+		t.Errorf("got tie %v, want %v", tie, wantTie)
+	}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("got results %+v, want %+v", results, wantResults)
+	}
+	if got, want := collectDuels(duels), collectDuels(wantDuels); !reflect.DeepEqual(got, want) {
+		t.Errorf("got duels %+v, want %+v", got, want)
+	}
+}
+
+func TestRankFromStrengths_transformed(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+
+	// strengths where A and B are tied, transformed to prefer B over A
+	strengths := []int{
+		0, 2, 3,
+		2, 0, 3,
+		1, 1, 0,
+	}
+	results, _, tie := schulze.RankFromStrengths(strengths, choices)
+	if !tie {
+		t.Error("expected tie")
+	}
+
+	strengths[1*len(choices)+0]++
+	results, _, tie = schulze.RankFromStrengths(strengths, choices)
+	if tie {
+		t.Error("got tie")
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "B")
+	}
+}
+
+func BenchmarkParallelPathStrengthComputer(b *testing.B) {
+	const choicesCount = 1000
+
+	choices := newChoices(choicesCount)
+	preferences := schulze.NewPreferences(choicesCount)
+
+	for i := 0; i < 100; i++ {
+		if _, err := schulze.Vote(preferences, choices, schulze.Ballot[string]{
+			choices[i]:       1,
+			choices[i+100]:   2,
+			choices[999-i]:   3,
+			choices[500+i/2]: 4,
+		}); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	opt := schulze.WithPathStrengthComputer[string](schulze.ParallelPathStrengthComputer{})
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, _, _ = schulze.Compute(preferences, choices, opt)
+	}
+}
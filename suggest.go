@@ -0,0 +1,85 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// Suggestion is a suggested completion of a voter's partial ballot. It is
+// only a proposal to be presented to the voter, and it must not be tallied
+// unless the voter confirms it.
+type Suggestion[C comparable] struct {
+	// Ballot with the ranks of the partial ballot and the suggested ranks of
+	// the unranked choices.
+	Ballot Ballot[C] `json:"ballot"`
+	// Choices with suggested ranks in the suggested order.
+	Suggested []C `json:"suggested"`
+}
+
+// SuggestCompletion suggests the ranks of the choices that are not ranked by
+// the partial ballot, below all ranked choices, in the order of the results
+// computed from the preferences, where choices with the same number of wins
+// share the same rank. The ballot and the preferences are not changed.
+func SuggestCompletion[C comparable](b Ballot[C], preferences []int, choices []C, opts ...Option[C]) Suggestion[C] {
+	o := newOptions(opts)
+	results, tie := calculateResults(choices, pathStrengths(choices, preferences, o), o)
+	return newSuggestion(b, results, tie)
+}
+
+// SuggestCompletion suggests the completion of the partial ballot from the
+// current results, as the SuggestCompletion function does, without voting.
+// Suspended and withdrawn choices are not suggested. Ranks of the ballots
+// are descending in the Voting with the WithDescendingRanks option.
+func (v *Voting[C]) SuggestCompletion(b Ballot[C]) Suggestion[C] {
+	v.compute()
+	if !v.options.descendingRanks {
+		return newSuggestion(b, v.results, v.tie)
+	}
+	s := newSuggestion(ReverseRanks(b), v.results, v.tie)
+	s.Ballot = ReverseRanks(s.Ballot)
+	return s
+}
+
+// SuggestCompletion suggests the completion of the partial ballot from the
+// current results of the election, as the Voting SuggestCompletion method
+// does, without voting. As the suggestion reveals the order of the results,
+// ErrSealed is returned if the results of the election are sealed.
+func (e *Election[V, C]) SuggestCompletion(b Ballot[C]) (Suggestion[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return Suggestion[C]{}, err
+	}
+	return e.voting.SuggestCompletion(b), nil
+}
+
+func newSuggestion[C comparable](b Ballot[C], results []Result[C], tie bool) Suggestion[C] {
+	s := Suggestion[C]{
+		Ballot:    make(Ballot[C], len(results)),
+		Suggested: make([]C, 0),
+	}
+	var lowest int
+	for c, rank := range b {
+		s.Ballot[c] = rank
+		// suggested ranks are above the last place
+		if rank > lowest && rank != LastPlace {
+			lowest = rank
+		}
+	}
+	rank := lowest
+	place, suggestedPlace := 0, 0
+	for i, r := range results {
+		// places are grouped as in the Preview
+		if i > 0 && (r.Wins != results[i-1].Wins || (i == 1 && !tie)) {
+			place++
+		}
+		if _, ok := b[r.Choice]; ok {
+			continue
+		}
+		if len(s.Suggested) == 0 || place != suggestedPlace {
+			rank++
+			suggestedPlace = place
+		}
+		s.Ballot[r.Choice] = rank
+		s.Suggested = append(s.Suggested, r.Choice)
+	}
+	return s
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+func TestSuggestCompletion(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	v := schulze.NewVoting(choices)
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3, "D": 3},
+		{"A": 1, "B": 2},
+		{"B": 1, "A": 2},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	preferences := v.Preferences()
+
+	partial := schulze.Ballot[string]{"C": 1, "D": 1}
+	want := schulze.Suggestion[string]{
+		Ballot:    schulze.Ballot[string]{"C": 1, "D": 1, "A": 2, "B": 3},
+		Suggested: []string{"A", "B"},
+	}
+	if got := schulze.SuggestCompletion(partial, preferences, choices); !reflect.DeepEqual(got, want) {
+		t.Errorf("got suggestion %+v, want %+v", got, want)
+	}
+	if got := v.SuggestCompletion(partial); !reflect.DeepEqual(got, want) {
+		t.Errorf("got voting suggestion %+v, want %+v", got, want)
+	}
+	if !reflect.DeepEqual(partial, schulze.Ballot[string]{"C": 1, "D": 1}) {
+		t.Errorf("partial ballot changed to %v", partial)
+	}
+	if !reflect.DeepEqual(v.Preferences(), preferences) {
+		t.Error("preferences changed")
+	}
+
+	// choices with the same number of wins share the suggested rank
+	want = schulze.Suggestion[string]{
+		Ballot:    schulze.Ballot[string]{"A": 1, "B": 2, "C": 3, "D": 3},
+		Suggested: []string{"B", "C", "D"},
+	}
+	if got := v.SuggestCompletion(schulze.Ballot[string]{"A": 1}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got suggestion %+v, want %+v", got, want)
+	}
+}
+
+func TestElection_SuggestCompletion(t *testing.T) {
+	closes := time.Now().Add(time.Hour)
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:  []string{"A", "B"},
+		Sealed:   true,
+		Schedule: schulze.Schedule{Closes: &closes},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.SuggestCompletion(nil); !errors.Is(err, schulze.ErrSealed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrSealed)
+	}
+
+	e = schulze.NewElection[string]([]string{"A", "B"})
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	s, err := e.SuggestCompletion(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Ballot[string]{"B": 1, "A": 2}); !reflect.DeepEqual(s.Ballot, want) {
+		t.Errorf("got suggested ballot %v, want %v", s.Ballot, want)
+	}
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v", got)
+	}
+}
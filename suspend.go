@@ -0,0 +1,178 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// SuspendChoice excludes the choice from the results and from the strongest
+// paths, and rejects new ballots that rank it with the SuspendedChoiceError,
+// while keeping its preferences, for example for a temporary
+// disqualification pending an appeal. Previous ballots that ranked the choice
+// can still be unvoted. The choice is included again with ResumeChoice.
+// UnknownChoiceError is returned if the choice does not exist.
+func (v *Voting[C]) SuspendChoice(c C) error {
+	if getChoiceIndex(v.choices, c) < 0 {
+		return &UnknownChoiceError[C]{Choice: c}
+	}
+	if _, ok := v.suspended[c]; ok {
+		return nil
+	}
+	if v.suspended == nil {
+		v.suspended = make(map[C]struct{})
+	}
+	v.invalidate()
+	v.suspended[c] = struct{}{}
+	return nil
+}
+
+// ResumeChoice includes the suspended choice in the results and new ballots
+// again. It is not an error to resume a choice that is not suspended, and
+// withdrawn choices are not resumed.
+func (v *Voting[C]) ResumeChoice(c C) {
+	if _, ok := v.suspended[c]; !ok {
+		return
+	}
+	if _, ok := v.withdrawn[c]; ok {
+		return
+	}
+	v.invalidate()
+	delete(v.suspended, c)
+}
+
+// SuspendedChoices returns the suspended choices in the order of the voting
+// choices.
+func (v *Voting[C]) SuspendedChoices() []C {
+	var suspended []C
+	for _, c := range v.choices {
+		if _, ok := v.suspended[c]; ok {
+			suspended = append(suspended, c)
+		}
+	}
+	return suspended
+}
+
+// checkSuspended returns the SuspendedChoiceError if the ballot ranks a
+// suspended choice.
+func (v *Voting[C]) checkSuspended(b Ballot[C]) error {
+	if len(v.suspended) == 0 {
+		return nil
+	}
+	for c := range b {
+		if _, ok := v.suspended[c]; ok {
+			return &SuspendedChoiceError[C]{Choice: c}
+		}
+	}
+	return nil
+}
+
+// excluded returns true if the choice is excluded from the strongest paths,
+// as it is suspended or disqualified.
+func (v *Voting[C]) excluded(c C) bool {
+	if _, ok := v.suspended[c]; ok {
+		return true
+	}
+	_, ok := v.disqualified[c]
+	return ok
+}
+
+// hasExcluded returns true if any choice may be excluded from the strongest
+// paths.
+func (v *Voting[C]) hasExcluded() bool {
+	return len(v.suspended) > 0 || len(v.disqualified) > 0
+}
+
+// computeEligible calculates the strongest paths and results only for the
+// choices that are not suspended or disqualified, returning the strengths
+// with the layout of all choices, where strengths of excluded choices are
+// zero, and the results with indexes of all choices.
+func (v *Voting[C]) computeEligible(o options[C]) (strengths []int, results []Result[C], tie bool) {
+	eligible := make([]C, 0, len(v.choices))
+	indexes := make([]int, 0, len(v.choices))
+	for i, c := range v.choices {
+		if !v.excluded(c) {
+			eligible = append(eligible, c)
+			indexes = append(indexes, i)
+		}
+	}
+
+	eligibleStrengths := pathStrengths(eligible, SetChoices(v.preferences, v.choices, eligible), o)
+	results, tie = calculateResults(eligible, eligibleStrengths, o)
+	for i := range results {
+		results[i].Index = indexes[results[i].Index]
+	}
+
+	choicesCount := len(v.choices)
+	eligibleCount := len(eligible)
+	strengths = make([]int, choicesCount*choicesCount)
+	for i, ii := range indexes {
+		for j, jj := range indexes {
+			strengths[ii*choicesCount+jj] = eligibleStrengths[i*eligibleCount+j]
+		}
+	}
+	return strengths, results, tie
+}
+
+// duels returns the iterator over duels of the cached computation, excluding
+// the suspended, disqualified and withdrawn choices.
+func (v *Voting[C]) duels() DuelsIterator[C] {
+	duels := newDuelsIterator(v.choices, v.strengths)
+	if !v.hasExcluded() && len(v.withdrawn) == 0 {
+		return duels
+	}
+	return func() *Duel[C] {
+		for {
+			d := duels()
+			if d == nil {
+				return nil
+			}
+			left := v.excluded(d.Left.Choice)
+			right := v.excluded(d.Right.Choice)
+			_, leftWithdrawn := v.withdrawn[d.Left.Choice]
+			_, rightWithdrawn := v.withdrawn[d.Right.Choice]
+			if !left && !right && !leftWithdrawn && !rightWithdrawn {
+				return d
+			}
+		}
+	}
+}
+
+// SuspendChoice excludes the choice from the results and new ballots, as the
+// Voting SuspendChoice method does. The suspension is recorded in the audit
+// log with the reason.
+func (e *Election[V, C]) SuspendChoice(c C, reason string) error {
+	if err := e.voting.SuspendChoice(c); err != nil {
+		return err
+	}
+	e.addAuditEntry(AuditEntry{
+		Action:  AuditSuspend,
+		Reason:  reason,
+		Choices: []string{fmt.Sprint(c)},
+	})
+	return nil
+}
+
+// ResumeChoice includes the suspended choice in the results and new ballots
+// again, as the Voting ResumeChoice method does. The resumption is recorded
+// in the audit log with the reason.
+func (e *Election[V, C]) ResumeChoice(c C, reason string) {
+	if _, ok := e.voting.suspended[c]; !ok {
+		return
+	}
+	if _, ok := e.voting.withdrawn[c]; ok {
+		return
+	}
+	e.voting.ResumeChoice(c)
+	e.addAuditEntry(AuditEntry{
+		Action:  AuditResume,
+		Reason:  reason,
+		Choices: []string{fmt.Sprint(c)},
+	})
+}
+
+// SuspendedChoices returns the suspended choices of the election.
+func (e *Election[V, C]) SuspendedChoices() []C {
+	return e.voting.SuspendedChoices()
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_SuspendChoice(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	ballots := []schulze.Ballot[string]{
+		{"B": 1, "A": 2, "C": 3},
+		{"B": 1, "C": 2},
+		{"A": 1, "C": 2, "D": 3},
+		{"D": 1, "B": 2},
+	}
+
+	for _, opts := range [][]schulze.Option[string]{
+		nil,
+		{schulze.WithIncrementalCompute[string](), schulze.WithResultsCache[string](2)},
+	} {
+		v := schulze.NewVoting(choices, opts...)
+		var records []schulze.Record[string]
+		for _, b := range ballots {
+			r, err := v.Vote(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			records = append(records, r)
+		}
+		v.Compute()
+		wantResults, wantDuels, wantTie := v.Compute()
+
+		if err := v.SuspendChoice("B"); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := v.SuspendedChoices(), []string{"B"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got suspended choices %v, want %v", got, want)
+		}
+
+		results, duels, tie := v.Compute()
+		eligibleResults, eligibleDuels, eligibleTie := v.ComputeWith(func(c string) bool { return true })
+		filteredResults, filteredDuels, filteredTie := schulze.ComputeWith(v.Preferences(), choices, func(c string) bool { return c != "B" })
+		if !reflect.DeepEqual(results, filteredResults) || tie != filteredTie {
+			t.Errorf("got results %+v, want %+v", results, filteredResults)
+		}
+		if !reflect.DeepEqual(eligibleResults, filteredResults) || eligibleTie != filteredTie {
+			t.Errorf("got ComputeWith results %+v, want %+v", eligibleResults, filteredResults)
+		}
+		wantFilteredDuels := collectDuels(filteredDuels)
+		if got := collectDuels(duels); !reflect.DeepEqual(got, wantFilteredDuels) {
+			t.Errorf("got duels %+v, want %+v", got, wantFilteredDuels)
+		}
+		if got := collectDuels(eligibleDuels); !reflect.DeepEqual(got, wantFilteredDuels) {
+			t.Errorf("got ComputeWith duels %+v, want %+v", got, wantFilteredDuels)
+		}
+
+		var serr *schulze.SuspendedChoiceError[string]
+		if _, err := v.Vote(schulze.Ballot[string]{"A": 1, "B": 2}); !errors.As(err, &serr) {
+			t.Errorf("got error %v, want SuspendedChoiceError", err)
+		} else if serr.Choice != "B" {
+			t.Errorf("got suspended choice %v, want %v", serr.Choice, "B")
+		}
+
+		// ballots that ranked the suspended choice can be changed
+		if err := v.Unvote(records[0]); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := v.Vote(schulze.Ballot[string]{"A": 1, "C": 2}); err != nil {
+			t.Fatal(err)
+		}
+		if err := v.Unvote(records[len(records)-1]); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := v.Vote(ballots[len(ballots)-1]); !errors.As(err, &serr) {
+			t.Fatalf("got error %v, want SuspendedChoiceError", err)
+		}
+
+		v.ResumeChoice("B")
+		if got := v.SuspendedChoices(); got != nil {
+			t.Errorf("got suspended choices %v", got)
+		}
+		if err := v.Unvote(schulze.Record[string]{{"A"}, {"C"}, {"B", "D"}}); err != nil {
+			t.Fatal(err)
+		}
+		for _, b := range []schulze.Ballot[string]{ballots[0], ballots[len(ballots)-1]} {
+			if _, err := v.Vote(b); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		results, duels, tie = v.Compute()
+		if !reflect.DeepEqual(results, wantResults) || tie != wantTie {
+			t.Errorf("got resumed results %+v, want %+v", results, wantResults)
+		}
+		if got, want := collectDuels(duels), collectDuels(wantDuels); !reflect.DeepEqual(got, want) {
+			t.Errorf("got resumed duels %+v, want %+v", got, want)
+		}
+	}
+
+	var uerr *schulze.UnknownChoiceError[string]
+	if err := schulze.NewVoting(choices).SuspendChoice("X"); !errors.As(err, &uerr) {
+		t.Errorf("got error %v, want UnknownChoiceError", err)
+	}
+}
+
+func TestElection_SuspendChoice(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SuspendChoice("B", "appeal"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1}); err == nil {
+		t.Error("expected suspended choice error")
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if r.Choice == "B" {
+			t.Errorf("got suspended choice in results %+v", results)
+		}
+	}
+	if got, want := e.SuspendedChoices(), []string{"B"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got suspended choices %v, want %v", got, want)
+	}
+
+	e.ResumeChoice("B", "appeal granted")
+	e.ResumeChoice("B", "not suspended")
+
+	log := e.AuditLog()
+	if len(log) != 2 {
+		t.Fatalf("got %v audit entries, want %v", len(log), 2)
+	}
+	for i, want := range []struct {
+		action schulze.AuditAction
+		reason string
+	}{
+		{action: schulze.AuditSuspend, reason: "appeal"},
+		{action: schulze.AuditResume, reason: "appeal granted"},
+	} {
+		if log[i].Action != want.action || log[i].Reason != want.reason || !reflect.DeepEqual(log[i].Choices, []string{"B"}) {
+			t.Errorf("got audit entry %+v", log[i])
+		}
+	}
+}
@@ -0,0 +1,426 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package telegram runs ranked polls in Telegram chats with a bot that
+// receives updates by long polling of the Telegram Bot API.
+//
+// The /poll command with choices separated by commas, such as
+// "/poll Pizza, Sushi, Tacos", creates a poll in the chat and posts the poll
+// message with a Vote button and the live results, which are updated on
+// every vote. The Vote button starts the pairwise question mode, where the
+// voter answers which of two choices is preferred, or that they are equal,
+// by inline keyboard buttons, until the complete ranking is known. The
+// /close command posts the final results and removes the poll.
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+// DefaultAPIURL is the default URL of the Telegram Bot API.
+const DefaultAPIURL = "https://api.telegram.org"
+
+// Callback data of inline keyboard buttons.
+const (
+	callbackVote   = "vote"
+	callbackPrefix = "answer:"
+)
+
+// pollTimeout is the long polling timeout in seconds.
+const pollTimeout = 30
+
+// Option configures the Bot.
+type Option func(*Bot)
+
+// WithAPIURL sets the URL of the Telegram Bot API, DefaultAPIURL by default.
+func WithAPIURL(u string) Option {
+	return func(b *Bot) {
+		b.apiURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// WithHTTPClient sets the HTTP client of Bot API calls. It must not time out
+// before the long polling timeout of 30 seconds.
+func WithHTTPClient(c *http.Client) Option {
+	return func(b *Bot) {
+		b.client = c
+	}
+}
+
+// WithVoterRegistry sets the registry that maps identities of Telegram users,
+// of the form "telegram:<user id>", to voters. Only users that are
+// registered can vote. By default, every user can vote and the identity is
+// the voter.
+func WithVoterRegistry(r *schulze.VoterRegistry[string]) Option {
+	return func(b *Bot) {
+		b.registry = r
+	}
+}
+
+// WithElectionConfig sets the configuration of created polls. Choices are
+// replaced by the choices of the /poll command.
+func WithElectionConfig(c schulze.ElectionConfig[string]) Option {
+	return func(b *Bot) {
+		b.config = c
+	}
+}
+
+// Bot runs polls in Telegram chats. Polls are created in the Manager with
+// names of the form "telegram:<chat id>".
+type Bot struct {
+	token    string
+	manager  *schulze.Manager[string, string]
+	registry *schulze.VoterRegistry[string]
+	config   schulze.ElectionConfig[string]
+	apiURL   string
+	client   *http.Client
+
+	mu sync.Mutex
+	// poll messages by chat
+	polls map[int64]int
+	// pairwise question sessions by chat and message
+	sessions map[messageKey]*session
+}
+
+type messageKey struct {
+	chat    int64
+	message int
+}
+
+// session is a pairwise question mode of a voter.
+type session struct {
+	user    int64
+	ranking *schulze.Wizard[string]
+}
+
+// NewBot returns a new Bot with the token of the Telegram bot.
+func NewBot(token string, m *schulze.Manager[string, string], opts ...Option) *Bot {
+	b := &Bot{
+		token:    token,
+		manager:  m,
+		apiURL:   DefaultAPIURL,
+		client:   &http.Client{Timeout: 2 * pollTimeout * time.Second},
+		polls:    make(map[int64]int),
+		sessions: make(map[messageKey]*session),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Run receives and handles updates until the context is canceled. Errors of
+// handling individual updates are reported to the optional errors function
+// and Run continues, while it returns on errors of receiving updates, other
+// than the context cancellation.
+func (b *Bot) Run(ctx context.Context, errs func(error)) error {
+	var offset int
+	for {
+		var updates []update
+		if err := b.call(ctx, "getUpdates", getUpdatesRequest{
+			Offset:         offset,
+			Timeout:        pollTimeout,
+			AllowedUpdates: []string{"message", "callback_query"},
+		}, &updates); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		for _, u := range updates {
+			offset = u.UpdateID + 1
+			if err := b.handle(ctx, u); err != nil && errs != nil && ctx.Err() == nil {
+				errs(err)
+			}
+		}
+	}
+}
+
+func (b *Bot) handle(ctx context.Context, u update) error {
+	switch {
+	case u.Message != nil:
+		return b.handleMessage(ctx, u.Message)
+	case u.CallbackQuery != nil:
+		return b.handleCallback(ctx, u.CallbackQuery)
+	}
+	return nil
+}
+
+func (b *Bot) handleMessage(ctx context.Context, m *message) error {
+	command, text, _ := strings.Cut(strings.TrimSpace(m.Text), " ")
+	// commands in groups may be addressed to the bot as /poll@bot
+	command, _, _ = strings.Cut(command, "@")
+	switch command {
+	case "/poll":
+		return b.createPoll(ctx, m.Chat.ID, text)
+	case "/close":
+		return b.closePoll(ctx, m.Chat.ID)
+	}
+	return nil
+}
+
+func (b *Bot) createPoll(ctx context.Context, chatID int64, text string) error {
+	var choices []string
+	for _, c := range strings.Split(text, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			choices = append(choices, c)
+		}
+	}
+	if len(choices) < 2 {
+		return b.send(ctx, chatID, "A poll needs at least two choices separated by commas, for example: /poll Pizza, Sushi, Tacos", nil)
+	}
+	config := b.config
+	config.Choices = choices
+	if err := b.manager.Create(pollName(chatID), config); err != nil {
+		var derr *schulze.DuplicateElectionError
+		if errors.As(err, &derr) {
+			return b.send(ctx, chatID, "There is already a poll in this chat.", nil)
+		}
+		return err
+	}
+	text, err := b.pollText(chatID)
+	if err != nil {
+		return err
+	}
+	var m message
+	if err := b.call(ctx, "sendMessage", sendMessageRequest{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: voteKeyboard(),
+	}, &m); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.polls[chatID] = m.MessageID
+	b.mu.Unlock()
+	return nil
+}
+
+func (b *Bot) closePoll(ctx context.Context, chatID int64) error {
+	text, err := b.pollText(chatID)
+	if err != nil {
+		var uerr *schulze.UnknownElectionError
+		if errors.As(err, &uerr) {
+			return b.send(ctx, chatID, "There is no poll in this chat.", nil)
+		}
+		return err
+	}
+	b.manager.Remove(pollName(chatID))
+	b.mu.Lock()
+	delete(b.polls, chatID)
+	for k := range b.sessions {
+		if k.chat == chatID {
+			delete(b.sessions, k)
+		}
+	}
+	b.mu.Unlock()
+	return b.send(ctx, chatID, "The poll is closed.\n\n"+text, nil)
+}
+
+func (b *Bot) handleCallback(ctx context.Context, q *callbackQuery) error {
+	if q.Message == nil {
+		return b.answerCallback(ctx, q.ID, "")
+	}
+	chatID := q.Message.Chat.ID
+
+	if q.Data == callbackVote {
+		if _, err := b.voter(q.From.ID); err != nil {
+			return b.answerCallback(ctx, q.ID, "You are not registered to vote.")
+		}
+		var choices []string
+		if err := b.manager.Do(pollName(chatID), func(e *schulze.Election[string, string]) error {
+			choices = e.Choices()
+			return nil
+		}); err != nil {
+			return b.answerCallback(ctx, q.ID, "The poll is closed.")
+		}
+		r := schulze.NewWizard(choices)
+		first, second, _ := r.Question()
+		var m message
+		if err := b.call(ctx, "sendMessage", sendMessageRequest{
+			ChatID:      chatID,
+			Text:        questionText(q.From, first, second),
+			ReplyMarkup: questionKeyboard(first, second),
+		}, &m); err != nil {
+			return err
+		}
+		b.mu.Lock()
+		b.sessions[messageKey{chat: chatID, message: m.MessageID}] = &session{user: q.From.ID, ranking: r}
+		b.mu.Unlock()
+		return b.answerCallback(ctx, q.ID, "")
+	}
+
+	a, err := strconv.Atoi(strings.TrimPrefix(q.Data, callbackPrefix))
+	if err != nil || !strings.HasPrefix(q.Data, callbackPrefix) {
+		return b.answerCallback(ctx, q.ID, "")
+	}
+	key := messageKey{chat: chatID, message: q.Message.MessageID}
+	b.mu.Lock()
+	s, ok := b.sessions[key]
+	if !ok || s.user != q.From.ID {
+		b.mu.Unlock()
+		return b.answerCallback(ctx, q.ID, "This question is for another voter.")
+	}
+	if err := s.ranking.Answer(schulze.WizardAnswer(a)); err != nil {
+		b.mu.Unlock()
+		return b.answerCallback(ctx, q.ID, "")
+	}
+	first, second, more := s.ranking.Question()
+	if !more {
+		delete(b.sessions, key)
+	}
+	b.mu.Unlock()
+
+	if more {
+		if err := b.call(ctx, "editMessageText", editMessageTextRequest{
+			ChatID:      chatID,
+			MessageID:   key.message,
+			Text:        questionText(q.From, first, second),
+			ReplyMarkup: questionKeyboard(first, second),
+		}, nil); err != nil {
+			return err
+		}
+		return b.answerCallback(ctx, q.ID, "")
+	}
+
+	text, err := b.vote(chatID, q.From.ID, s.ranking.Ballot())
+	if err != nil {
+		text = "Error: " + strings.TrimPrefix(err.Error(), "schulze: ")
+	}
+	if err := b.call(ctx, "editMessageText", editMessageTextRequest{
+		ChatID:    chatID,
+		MessageID: key.message,
+		Text:      text,
+	}, nil); err != nil {
+		return err
+	}
+	if err := b.answerCallback(ctx, q.ID, ""); err != nil {
+		return err
+	}
+	return b.updatePoll(ctx, chatID)
+}
+
+func (b *Bot) vote(chatID, userID int64, ballot schulze.Ballot[string]) (string, error) {
+	voter, err := b.voter(userID)
+	if err != nil {
+		return "", err
+	}
+	var record schulze.Record[string]
+	if err := b.manager.Do(pollName(chatID), func(e *schulze.Election[string, string]) (err error) {
+		record, err = e.Vote(voter, ballot)
+		return err
+	}); err != nil {
+		return "", err
+	}
+	return "Your ballot is recorded: " + schulze.FormatRecord(record), nil
+}
+
+// updatePoll edits the poll message with the current results.
+func (b *Bot) updatePoll(ctx context.Context, chatID int64) error {
+	b.mu.Lock()
+	messageID, ok := b.polls[chatID]
+	b.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	text, err := b.pollText(chatID)
+	if err != nil {
+		return err
+	}
+	return b.call(ctx, "editMessageText", editMessageTextRequest{
+		ChatID:      chatID,
+		MessageID:   messageID,
+		Text:        text,
+		ReplyMarkup: voteKeyboard(),
+	}, nil)
+}
+
+// pollText returns the text of the poll message with the current results.
+func (b *Bot) pollText(chatID int64) (string, error) {
+	var text string
+	err := b.manager.Do(pollName(chatID), func(e *schulze.Election[string, string]) error {
+		text = "Poll: " + strings.Join(e.Choices(), ", ") + "\n\n"
+		results, duels, _, err := e.Compute()
+		switch {
+		case errors.Is(err, schulze.ErrSealed):
+			text += "The results are sealed."
+		case err != nil:
+			return err
+		case e.VotersCount() == 0:
+			text += "No votes yet."
+		default:
+			text += strings.TrimSpace(schulze.Explain(results, duels, schulze.ExplainText))
+		}
+		text += fmt.Sprintf("\n\nVoters: %d", e.VotersCount())
+		return nil
+	})
+	return text, err
+}
+
+func (b *Bot) voter(userID int64) (string, error) {
+	identity := "telegram:" + strconv.FormatInt(userID, 10)
+	if b.registry == nil {
+		return identity, nil
+	}
+	return b.registry.Voter(identity)
+}
+
+func (b *Bot) send(ctx context.Context, chatID int64, text string, keyboard *inlineKeyboardMarkup) error {
+	return b.call(ctx, "sendMessage", sendMessageRequest{
+		ChatID:      chatID,
+		Text:        text,
+		ReplyMarkup: keyboard,
+	}, nil)
+}
+
+func (b *Bot) answerCallback(ctx context.Context, id, text string) error {
+	return b.call(ctx, "answerCallbackQuery", answerCallbackQueryRequest{
+		CallbackQueryID: id,
+		Text:            text,
+	}, nil)
+}
+
+func pollName(chatID int64) string {
+	return "telegram:" + strconv.FormatInt(chatID, 10)
+}
+
+func questionText(u user, first, second string) string {
+	name := u.FirstName
+	if u.Username != "" {
+		name = "@" + u.Username
+	}
+	return fmt.Sprintf("%s, which do you prefer: %s or %s?", name, first, second)
+}
+
+func voteKeyboard() *inlineKeyboardMarkup {
+	return &inlineKeyboardMarkup{
+		InlineKeyboard: [][]inlineKeyboardButton{{
+			{Text: "Vote", CallbackData: callbackVote},
+		}},
+	}
+}
+
+func questionKeyboard(first, second string) *inlineKeyboardMarkup {
+	return &inlineKeyboardMarkup{
+		InlineKeyboard: [][]inlineKeyboardButton{
+			{
+				{Text: first, CallbackData: callbackPrefix + strconv.Itoa(int(schulze.PreferFirst))},
+				{Text: second, CallbackData: callbackPrefix + strconv.Itoa(int(schulze.PreferSecond))},
+			},
+			{
+				{Text: "No preference", CallbackData: callbackPrefix + strconv.Itoa(int(schulze.PreferNone))},
+			},
+		},
+	}
+}
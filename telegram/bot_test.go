@@ -0,0 +1,189 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package telegram_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/telegram"
+)
+
+type call struct {
+	method string
+	body   map[string]any
+}
+
+// fakeAPI is the Telegram Bot API that serves queued updates and records all
+// other calls.
+type fakeAPI struct {
+	updates chan map[string]any
+	calls   chan call
+
+	mu            sync.Mutex
+	nextMessageID int
+}
+
+func newFakeAPI(t *testing.T) (*fakeAPI, *httptest.Server) {
+	t.Helper()
+	api := &fakeAPI{
+		updates:       make(chan map[string]any, 10),
+		calls:         make(chan call, 100),
+		nextMessageID: 100,
+	}
+	s := httptest.NewServer(http.HandlerFunc(api.serve))
+	t.Cleanup(s.Close)
+	return api, s
+}
+
+func (a *fakeAPI) serve(w http.ResponseWriter, r *http.Request) {
+	method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	if !strings.HasPrefix(r.URL.Path, "/bottoken/") {
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": false, "error_code": 401, "description": "Unauthorized"})
+		return
+	}
+	var body map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	var result any = true
+	switch method {
+	case "getUpdates":
+		var updates []map[string]any
+		select {
+		case u := <-a.updates:
+			updates = append(updates, u)
+		case <-time.After(20 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		result = updates
+	case "sendMessage":
+		a.mu.Lock()
+		a.nextMessageID++
+		result = map[string]any{"message_id": a.nextMessageID, "chat": map[string]any{"id": body["chat_id"]}}
+		a.mu.Unlock()
+		fallthrough
+	default:
+		a.calls <- call{method: method, body: body}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "result": result})
+}
+
+// next returns the next call that is not an answer to a callback query.
+func (a *fakeAPI) next(t *testing.T) call {
+	t.Helper()
+	for {
+		select {
+		case c := <-a.calls:
+			if c.method == "answerCallbackQuery" {
+				continue
+			}
+			return c
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for a call")
+		}
+	}
+}
+
+func TestBot(t *testing.T) {
+	api, s := newFakeAPI(t)
+	m := schulze.NewManager[string, string]()
+	b := telegram.NewBot("token", m, telegram.WithAPIURL(s.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- b.Run(ctx, func(err error) { t.Error(err) }) }()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	updateID := 0
+	send := func(u map[string]any) {
+		updateID++
+		u["update_id"] = updateID
+		api.updates <- u
+	}
+	chat := map[string]any{"id": 1}
+	from := map[string]any{"id": 10, "first_name": "Alice", "username": "alice"}
+	answer := func(messageID int, data string) {
+		send(map[string]any{"callback_query": map[string]any{
+			"id":      "q",
+			"from":    from,
+			"message": map[string]any{"message_id": messageID, "chat": chat},
+			"data":    data,
+		}})
+	}
+
+	send(map[string]any{"message": map[string]any{"message_id": 1, "chat": chat, "from": from, "text": "/poll@schulze_bot A, B, C"}})
+	c := api.next(t)
+	if c.method != "sendMessage" || !strings.Contains(c.body["text"].(string), "No votes yet") {
+		t.Fatalf("got poll call %+v", c)
+	}
+	pollID := 101
+
+	answer(pollID, "vote")
+	c = api.next(t)
+	if want := "@alice, which do you prefer: B or A?"; c.method != "sendMessage" || c.body["text"] != want {
+		t.Fatalf("got question call %+v, want %q", c, want)
+	}
+	questionID := 102
+
+	// another user can not answer the question
+	send(map[string]any{"callback_query": map[string]any{
+		"id":      "q",
+		"from":    map[string]any{"id": 11},
+		"message": map[string]any{"message_id": questionID, "chat": chat},
+		"data":    "answer:0",
+	}})
+
+	answer(questionID, "answer:2")
+	c = api.next(t)
+	if want := "@alice, which do you prefer: C or A?"; c.method != "editMessageText" || c.body["text"] != want {
+		t.Fatalf("got question call %+v, want %q", c, want)
+	}
+
+	answer(questionID, "answer:0")
+	c = api.next(t)
+	// choices of the same rank are not ordered
+	if text := c.body["text"]; c.method != "editMessageText" || text != "Your ballot is recorded: C>A=B" && text != "Your ballot is recorded: C>B=A" {
+		t.Fatalf("got vote call %+v, want ballot C>A=B", c)
+	}
+	c = api.next(t)
+	if c.method != "editMessageText" || c.body["message_id"] != float64(pollID) || !strings.Contains(c.body["text"].(string), "C is the winner") {
+		t.Fatalf("got poll update call %+v", c)
+	}
+
+	send(map[string]any{"message": map[string]any{"message_id": 2, "chat": chat, "from": from, "text": "/close"}})
+	c = api.next(t)
+	if c.method != "sendMessage" || !strings.HasPrefix(c.body["text"].(string), "The poll is closed.") {
+		t.Fatalf("got close call %+v", c)
+	}
+
+	if got := m.Names(); len(got) != 0 {
+		t.Errorf("got polls %v after close", got)
+	}
+}
+
+func TestBot_Run_error(t *testing.T) {
+	_, s := newFakeAPI(t)
+	b := telegram.NewBot("invalid", schulze.NewManager[string, string](), telegram.WithAPIURL(s.URL))
+
+	err := b.Run(context.Background(), nil)
+	want := &telegram.APIError{Method: "getUpdates", Code: 401, Description: "Unauthorized"}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
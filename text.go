@@ -0,0 +1,57 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseBallot parses the ballot from the compact text format, where choices
+// are listed from the most preferred, separated by the > character, and
+// choices of the same rank are separated by the = character, as in "A>B=C>D".
+// Whitespace around choices is ignored and an empty text is an empty ballot.
+func ParseBallot(s string) (Ballot[string], error) {
+	b := make(Ballot[string])
+	if strings.TrimSpace(s) == "" {
+		return b, nil
+	}
+	for rank, group := range strings.Split(s, ">") {
+		for _, c := range strings.Split(group, "=") {
+			c = strings.TrimSpace(c)
+			if c == "" {
+				return nil, fmt.Errorf("%w: empty choice at rank %v", ErrInvalidBallotText, rank+1)
+			}
+			if _, ok := b[c]; ok {
+				return nil, fmt.Errorf("%w: duplicate choice %q", ErrInvalidBallotText, c)
+			}
+			b[c] = rank + 1
+		}
+	}
+	return b, nil
+}
+
+// FormatRecord formats the ranked choices of the Record in the text format
+// of the ParseBallot function, omitting the unranked choices. Choices are
+// formatted by the fmt package.
+func FormatRecord[C comparable](r Record[C]) string {
+	if len(r) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	for rank, group := range r[:len(r)-1] {
+		if rank > 0 {
+			b.WriteString(">")
+		}
+		for i, c := range group {
+			if i > 0 {
+				b.WriteString("=")
+			}
+			b.WriteString(fmt.Sprint(c))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestParseBallot(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		want schulze.Ballot[string]
+		err  error
+	}{
+		{text: "", want: schulze.Ballot[string]{}},
+		{text: "  ", want: schulze.Ballot[string]{}},
+		{text: "A", want: schulze.Ballot[string]{"A": 1}},
+		{text: "A>B=C>D", want: schulze.Ballot[string]{"A": 1, "B": 2, "C": 2, "D": 3}},
+		{text: " Alice Smith > Bob = Carol ", want: schulze.Ballot[string]{"Alice Smith": 1, "Bob": 2, "Carol": 2}},
+		{text: "A>>B", err: schulze.ErrInvalidBallotText},
+		{text: "A=", err: schulze.ErrInvalidBallotText},
+		{text: "A>B>A", err: schulze.ErrInvalidBallotText},
+	} {
+		t.Run(tc.text, func(t *testing.T) {
+			got, err := schulze.ParseBallot(tc.text)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("got error %v, want %v", err, tc.err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got ballot %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFormatRecord(t *testing.T) {
+	v := schulze.NewVoting([]string{"A", "B", "C", "D"})
+
+	for _, text := range []string{"", "A", "A>B=C", "D>C>B>A"} {
+		b, err := schulze.ParseBallot(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := v.Vote(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := schulze.FormatRecord(sortedRecord(r))
+		if got != text {
+			t.Errorf("got %q, want %q", got, text)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// TieBreak defines the rule that resolves the tie between multiple choices
+// with the same number of wins in the first place of the results.
+type TieBreak int
+
+const (
+	// TieBreakNone does not resolve the tie, it is reported by the tie
+	// boolean parameter of the Compute function.
+	TieBreakNone TieBreak = iota
+	// TieBreakIndex resolves the tie in favor of the tied choice that is
+	// ordered first, by the strength, collation and the index of the choice.
+	TieBreakIndex
+	// TieBreakRandom resolves the tie by a random order of the tied choices,
+	// which is reproducible with the seed set by the WithRandomSeed option or
+	// the source set by the WithRandSource option.
+	TieBreakRandom
+)
+
+// WithTieBreak sets the rule that resolves the tie for the first place in the
+// results. When the tie is resolved, the tie boolean parameter of the Compute
+// function is false and the tied choices are reordered in the results
+// according to the rule, while their wins and strengths are not changed.
+func WithTieBreak[C comparable](t TieBreak) Option[C] {
+	return func(o *options[C]) {
+		o.tieBreak = t
+	}
+}
+
+// breakTie reorders the tied choices in the first place of the sorted
+// results.
+func breakTie[C comparable](results []Result[C], o options[C]) {
+	tied := 1
+	for tied < len(results) && results[tied].Wins == results[0].Wins {
+		tied++
+	}
+	switch o.tieBreak {
+	case TieBreakRandom:
+		o.rand().Shuffle(tied, func(i, j int) {
+			results[i], results[j] = results[j], results[i]
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// TokenVerifier verifies anonymous one-time voting tokens, such as the ones
+// issued with blind signatures by the blindtoken package.
+type TokenVerifier interface {
+	VerifyToken(token, signature []byte) error
+}
+
+// SetTokenVerifier requires every ballot of the election to be cast with a
+// valid voting token by the VoteWithToken method, verified by the provided
+// verifier. Voting without a token is not possible if the verifier is set.
+func (e *Election[V, C]) SetTokenVerifier(v TokenVerifier) {
+	e.tokenVerifier = v
+}
+
+// VoteWithToken adds or replaces the voter's ballot, just as Vote does, if the
+// token and its signature are valid. Every token can be used only by a single
+// voter, which is usually a pseudonym derived from the token itself, and
+// repeated votes with the same token replace the voter's ballot.
+func (e *Election[V, C]) VoteWithToken(voter V, token, signature []byte, b Ballot[C]) (Record[C], error) {
+	if e.tokenVerifier == nil {
+		return nil, ErrNoTokenVerifier
+	}
+	if err := e.tokenVerifier.VerifyToken(token, signature); err != nil {
+		return nil, err
+	}
+	if v, ok := e.tokens[string(token)]; ok && v != voter {
+		return nil, ErrTokenUsed
+	}
+	if _, ok := e.erasedTokens[string(token)]; ok {
+		return nil, ErrTokenUsed
+	}
+	r, err := e.voteChecked(voter, b, nil)
+	if err != nil {
+		return nil, err
+	}
+	e.tokens[string(token)] = voter
+	return r, nil
+}
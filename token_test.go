@@ -0,0 +1,85 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/blindtoken"
+)
+
+var _ schulze.TokenVerifier = (*blindtoken.Verifier)(nil)
+
+func TestElection_VoteWithToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := blindtoken.NewIssuer(key)
+
+	issue := func(t *testing.T) (token, signature []byte) {
+		t.Helper()
+		token, err := blindtoken.NewToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+		blinded, unblinder, err := blindtoken.Blind(&key.PublicKey, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blindSignature, err := issuer.Sign(blinded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token, unblinder.Unblind(&key.PublicKey, blindSignature)
+	}
+
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	token, signature := issue(t)
+	if _, err := e.VoteWithToken(hex.EncodeToString(token), token, signature, schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrNoTokenVerifier) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNoTokenVerifier)
+	}
+
+	e.SetTokenVerifier(blindtoken.NewVerifier(&key.PublicKey))
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrTokenRequired) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrTokenRequired)
+	}
+
+	voter := hex.EncodeToString(token)
+	if _, err := e.VoteWithToken(voter, token, signature, schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	// change the vote with the same token
+	if _, err := e.VoteWithToken(voter, token, signature, schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.VoteWithToken("other", token, signature, schulze.Ballot[string]{"C": 1}); !errors.Is(err, schulze.ErrTokenUsed) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrTokenUsed)
+	}
+
+	forged, _ := issue(t)
+	if _, err := e.VoteWithToken(hex.EncodeToString(forged), forged, signature, schulze.Ballot[string]{"C": 1}); !errors.Is(err, blindtoken.ErrInvalidSignature) {
+		t.Errorf("got error %v, want %v", err, blindtoken.ErrInvalidSignature)
+	}
+
+	if got := e.VotersCount(); got != 1 {
+		t.Errorf("got voters count %v, want %v", got, 1)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want %v", results[0].Choice, "B")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "context"
+
+// TracerName is the instrumentation name used to obtain the Tracer from the
+// TracerProvider.
+const TracerName = "resenje.org/schulze"
+
+// TracerProvider provides Tracers for tracing operations on the Voting type.
+// It follows the shape of the OpenTelemetry trace.TracerProvider, which can
+// be adapted to it by wrapping its tracers and spans.
+type TracerProvider interface {
+	Tracer(name string) Tracer
+}
+
+// Tracer starts spans as children of the span in the context.
+type Tracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span is a single traced operation.
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Attribute is a key-value pair describing a Span. Values are of int or bool
+// types.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// WithTracerProvider enables tracing of Vote, Unvote, SetChoices and results
+// computation of the Voting with spans started by the Tracer from the
+// provider. Results that are cached since the last change of the voting are
+// returned without a computation span. Spans are started as children of the
+// span in the context set by the SetTraceContext method.
+func WithTracerProvider[C comparable](tp TracerProvider) Option[C] {
+	return func(o *options[C]) {
+		if tp == nil {
+			o.tracer = nil
+			return
+		}
+		o.tracer = tp.Tracer(TracerName)
+	}
+}
+
+// SetTraceContext sets the context with the parent span for spans of
+// subsequent operations on the voting, such as the context of the request
+// that is being handled. It has effect only if the WithTracerProvider option
+// is used.
+func (v *Voting[C]) SetTraceContext(ctx context.Context) {
+	v.traceCtx = ctx
+}
+
+// SetTraceContext sets the context with the parent span for spans of
+// subsequent operations on the election, as the Voting SetTraceContext
+// method does.
+func (e *Election[V, C]) SetTraceContext(ctx context.Context) {
+	e.voting.SetTraceContext(ctx)
+}
+
+// endNoop ends the span when tracing is disabled, declared once so that it
+// is not allocated on every call.
+func endNoop(error) {}
+
+// startSpan starts a span with the choices count attribute if the tracer is
+// set. The returned function ends the span, recording the error if it is not
+// nil.
+func (v *Voting[C]) startSpan(name string) (span Span, end func(err error)) {
+	if v.options.tracer == nil {
+		return nil, endNoop
+	}
+	ctx := v.traceCtx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, s := v.options.tracer.Start(ctx, name)
+	s.SetAttributes(Attribute{Key: "schulze.choices", Value: len(v.choices)})
+	return s, func(err error) {
+		if err != nil {
+			s.RecordError(err)
+		}
+		s.End()
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestWithTracerProvider(t *testing.T) {
+	tp := new(recordingTracerProvider)
+
+	v := schulze.NewVoting([]string{"A", "B"}, schulze.WithTracerProvider[string](tp))
+
+	if tp.name != schulze.TracerName {
+		t.Errorf("got tracer name %q, want %q", tp.name, schulze.TracerName)
+	}
+
+	v.SetTraceContext(context.WithValue(context.Background(), traceParentKey{}, "request"))
+
+	if _, err := v.Vote(schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Vote(schulze.Ballot[string]{"X": 1}); err == nil {
+		t.Fatal("expected unknown choice error")
+	}
+	v.Compute()
+	v.Compute() // cached
+	v.SetChoices([]string{"A", "B", "C"})
+	if err := v.Unvote(schulze.Record[string]{{"A"}, {"B", "C"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []recordedSpan{
+		{name: "schulze.Vote", attributes: []schulze.Attribute{{Key: "schulze.choices", Value: 2}}, ended: true},
+		{name: "schulze.Vote", attributes: []schulze.Attribute{{Key: "schulze.choices", Value: 2}}, errored: true, ended: true},
+		{name: "schulze.Compute", attributes: []schulze.Attribute{{Key: "schulze.choices", Value: 2}}, ended: true},
+		{name: "schulze.SetChoices", attributes: []schulze.Attribute{{Key: "schulze.choices", Value: 2}, {Key: "schulze.updated_choices", Value: 3}}, ended: true},
+		{name: "schulze.Unvote", attributes: []schulze.Attribute{{Key: "schulze.choices", Value: 3}}, ended: true},
+	}
+	got := make([]recordedSpan, 0, len(tp.spans))
+	for _, s := range tp.spans {
+		if s.parent != "request" {
+			t.Errorf("span %s: got parent %v, want %v", s.name, s.parent, "request")
+		}
+		s.parent = nil
+		got = append(got, *s)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got spans %+v, want %+v", got, want)
+	}
+}
+
+type traceParentKey struct{}
+
+type recordingTracerProvider struct {
+	name  string
+	spans []*recordedSpan
+}
+
+func (tp *recordingTracerProvider) Tracer(name string) schulze.Tracer {
+	tp.name = name
+	return recordingTracer{tp: tp}
+}
+
+type recordingTracer struct {
+	tp *recordingTracerProvider
+}
+
+func (t recordingTracer) Start(ctx context.Context, spanName string) (context.Context, schulze.Span) {
+	s := &recordedSpan{name: spanName, parent: ctx.Value(traceParentKey{})}
+	t.tp.spans = append(t.tp.spans, s)
+	return ctx, s
+}
+
+type recordedSpan struct {
+	name       string
+	parent     any
+	attributes []schulze.Attribute
+	errored    bool
+	ended      bool
+}
+
+func (s *recordedSpan) SetAttributes(attributes ...schulze.Attribute) {
+	s.attributes = append(s.attributes, attributes...)
+}
+
+func (s *recordedSpan) RecordError(error) {
+	s.errored = true
+}
+
+func (s *recordedSpan) End() {
+	s.ended = true
+}
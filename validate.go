@@ -0,0 +1,43 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// ValidatePreferences returns ErrNoChoices if there are no choices, as
+// computation with no choices returns no results, which looks like a
+// legitimate election without votes. ErrPreferencesLengthMismatch is
+// returned if the preferences, including the nil preferences, are not
+// initialized for the number of choices.
+func ValidatePreferences[C comparable](preferences []int, choices []C) error {
+	if len(choices) == 0 {
+		return fmt.Errorf("%w: provide the choices to vote on", ErrNoChoices)
+	}
+	return validatePreferencesCount(preferences, len(choices))
+}
+
+// Validate returns ErrNoChoices if the voting has no choices, which is the
+// case for the zero value of the Voting or when it is created by NewVoting
+// with nil or empty choices, and they are not set later with the SetChoices
+// method.
+func (v *Voting[C]) Validate() error {
+	if len(v.choices) == 0 {
+		return fmt.Errorf("%w: provide the choices to NewVoting or set them with SetChoices", ErrNoChoices)
+	}
+	return validatePreferencesCount(v.preferences, len(v.choices))
+}
+
+// validatePreferencesCount returns ErrPreferencesLengthMismatch if the
+// preferences do not have the length for the number of choices.
+func validatePreferencesCount(preferences []int, choicesCount int) error {
+	if want := choicesCount * choicesCount; len(preferences) != want {
+		if preferences == nil {
+			return fmt.Errorf("%w: nil preferences for %v choices, initialize them with NewPreferences", ErrPreferencesLengthMismatch, choicesCount)
+		}
+		return fmt.Errorf("%w: got length %v for %v choices, want %v", ErrPreferencesLengthMismatch, len(preferences), choicesCount, want)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestValidatePreferences(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		preferences []int
+		choices     []string
+		want        error
+	}{
+		{name: "valid", preferences: schulze.NewPreferences(2), choices: []string{"A", "B"}},
+		{name: "nil choices", preferences: nil, choices: nil, want: schulze.ErrNoChoices},
+		{name: "empty choices", preferences: []int{}, choices: []string{}, want: schulze.ErrNoChoices},
+		{name: "nil preferences", preferences: nil, choices: []string{"A", "B"}, want: schulze.ErrPreferencesLengthMismatch},
+		{name: "short preferences", preferences: schulze.NewPreferences(1), choices: []string{"A", "B"}, want: schulze.ErrPreferencesLengthMismatch},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := schulze.ValidatePreferences(tc.preferences, tc.choices)
+			if tc.want == nil {
+				if err != nil {
+					t.Errorf("got error %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.want) {
+				t.Errorf("got error %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestVoting_Validate(t *testing.T) {
+	var zero schulze.Voting[string]
+	if err := zero.Validate(); !errors.Is(err, schulze.ErrNoChoices) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNoChoices)
+	}
+
+	v := schulze.NewVoting[string](nil)
+	if err := v.Validate(); !errors.Is(err, schulze.ErrNoChoices) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrNoChoices)
+	}
+	// documented zero-value behavior
+	if results, _, tie := v.Compute(); len(results) != 0 || tie {
+		t.Errorf("got results %v, tie %v, want none", results, tie)
+	}
+
+	v.SetChoices([]string{"A", "B"})
+	if err := v.Validate(); err != nil {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestCompute_nilPreferences(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, schulze.ErrPreferencesLengthMismatch) {
+			t.Errorf("got panic %v, want %v", err, schulze.ErrPreferencesLengthMismatch)
+		}
+	}()
+	_, _, _ = schulze.Compute(nil, []string{"A", "B"})
+}
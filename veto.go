@@ -0,0 +1,168 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+// Disqualify excludes the choices from the results and from the strongest
+// paths, replacing the previously disqualified choices, while keeping their
+// preferences. Unlike suspended choices, disqualified choices are not
+// rejected on new ballots. Choices that do not exist are ignored, and no
+// choices are disqualified if none are passed.
+func (v *Voting[C]) Disqualify(choices ...C) {
+	disqualified := make(map[C]struct{}, len(choices))
+	for _, c := range choices {
+		if getChoiceIndex(v.choices, c) >= 0 {
+			disqualified[c] = struct{}{}
+		}
+	}
+	if len(disqualified) == len(v.disqualified) {
+		changed := false
+		for c := range disqualified {
+			if _, ok := v.disqualified[c]; !ok {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return
+		}
+	}
+	v.invalidate()
+	if len(disqualified) == 0 {
+		disqualified = nil
+	}
+	v.disqualified = disqualified
+}
+
+// DisqualifiedChoices returns the disqualified choices in the order of the
+// voting choices.
+func (v *Voting[C]) DisqualifiedChoices() []C {
+	var disqualified []C
+	for _, c := range v.choices {
+		if _, ok := v.disqualified[c]; ok {
+			disqualified = append(disqualified, c)
+		}
+	}
+	return disqualified
+}
+
+// ChoiceVetoes holds the number of ballots that vetoed a single choice.
+type ChoiceVetoes[C comparable] struct {
+	// The choice value.
+	Choice C
+	// 0-based ordinal number of the choice in the choice slice.
+	Index int
+	// Number of ballots that vetoed the choice.
+	Count int
+	// Fraction of recorded ballots that vetoed the choice.
+	Fraction float64
+	// True if the fraction exceeds the veto threshold of the election.
+	Disqualified bool
+}
+
+// VoteWithVetoes adds or replaces the voter's ballot, just as Vote does, and
+// flags the vetoed choices as unacceptable to the voter, regardless of their
+// ranks on the ballot. If the election configuration has the VetoThreshold,
+// choices that are vetoed by more than that fraction of recorded ballots are
+// disqualified before the results are computed. Vetoes of the previous
+// voter's ballot are removed. Vetoes of a proxy are counted for the ballots
+// that the proxy casts for its principals. UnknownChoiceError is returned if a vetoed
+// choice does not exist.
+func (e *Election[V, C]) VoteWithVetoes(voter V, b Ballot[C], vetoed []C) (Record[C], error) {
+	vetoes := make([]C, 0, len(vetoed))
+	for _, c := range vetoed {
+		if getChoiceIndex(e.voting.choices, c) < 0 {
+			return nil, &UnknownChoiceError[C]{Choice: c}
+		}
+		if getChoiceIndex(vetoes, c) < 0 {
+			vetoes = append(vetoes, c)
+		}
+	}
+	r, err := e.Vote(voter, b)
+	if err != nil {
+		return nil, err
+	}
+	e.addVetoes(voter, vetoes)
+	e.updateProxyVetoes(voter)
+	e.updateDisqualified()
+	return r, nil
+}
+
+// Vetoes returns the number of vetoes of every choice, in the order of
+// choices. Vetoes of choices that are no longer in the election are not
+// included. ErrSealed is returned if the results of the election are sealed.
+func (e *Election[V, C]) Vetoes() ([]ChoiceVetoes[C], error) {
+	if err := e.checkSealed(); err != nil {
+		return nil, err
+	}
+	choices := e.voting.choices
+	vetoes := make([]ChoiceVetoes[C], len(choices))
+	for i, c := range choices {
+		vetoes[i] = ChoiceVetoes[C]{
+			Choice: c,
+			Index:  i,
+			Count:  e.vetoCounts[c],
+		}
+		if len(e.records) > 0 {
+			vetoes[i].Fraction = float64(vetoes[i].Count) / float64(len(e.records))
+		}
+		vetoes[i].Disqualified = e.vetoDisqualifies(vetoes[i].Count)
+	}
+	return vetoes, nil
+}
+
+// DisqualifiedChoices returns the choices that are disqualified by vetoes,
+// in the order of choices.
+func (e *Election[V, C]) DisqualifiedChoices() []C {
+	return e.voting.DisqualifiedChoices()
+}
+
+// addVetoes records the vetoes of the voter's ballot.
+func (e *Election[V, C]) addVetoes(voter V, vetoes []C) {
+	if len(vetoes) == 0 {
+		return
+	}
+	if e.vetoes == nil {
+		e.vetoes = make(map[V][]C)
+		e.vetoCounts = make(map[C]int)
+	}
+	e.vetoes[voter] = vetoes
+	for _, c := range vetoes {
+		e.vetoCounts[c]++
+	}
+}
+
+// removeVetoes deletes the vetoes of the voter's ballot, without changing
+// the disqualified choices.
+func (e *Election[V, C]) removeVetoes(voter V) {
+	for _, c := range e.vetoes[voter] {
+		if e.vetoCounts[c]--; e.vetoCounts[c] <= 0 {
+			delete(e.vetoCounts, c)
+		}
+	}
+	delete(e.vetoes, voter)
+}
+
+// updateDisqualified disqualifies choices by the number of their vetoes and
+// the number of recorded ballots, after any of them have changed.
+func (e *Election[V, C]) updateDisqualified() {
+	if e.config.VetoThreshold == 0 && len(e.voting.disqualified) == 0 {
+		return
+	}
+	var disqualified []C
+	for c, count := range e.vetoCounts {
+		if e.vetoDisqualifies(count) {
+			disqualified = append(disqualified, c)
+		}
+	}
+	e.voting.Disqualify(disqualified...)
+}
+
+// vetoDisqualifies returns true if the number of vetoes exceeds the veto
+// threshold of recorded ballots.
+func (e *Election[V, C]) vetoDisqualifies(count int) bool {
+	t := e.config.VetoThreshold
+	return t > 0 && float64(count) > t*float64(len(e.records))
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_Disqualify(t *testing.T) {
+	choices := []string{"A", "B", "C"}
+	v := schulze.NewVoting(choices, schulze.WithIncrementalCompute[string]())
+	for _, b := range []schulze.Ballot[string]{
+		{"A": 1, "B": 2, "C": 3},
+		{"A": 1, "C": 2},
+		{"B": 1, "A": 2},
+	} {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	v.Compute()
+
+	v.Disqualify("A", "X")
+	if got, want := v.DisqualifiedChoices(), []string{"A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got disqualified choices %v, want %v", got, want)
+	}
+	results, duels, tie := v.Compute()
+	wantResults, wantDuels, wantTie := schulze.ComputeWith(v.Preferences(), choices, func(c string) bool { return c != "A" })
+	if !reflect.DeepEqual(results, wantResults) || tie != wantTie {
+		t.Errorf("got results %+v, want %+v", results, wantResults)
+	}
+	if got, want := collectDuels(duels), collectDuels(wantDuels); !reflect.DeepEqual(got, want) {
+		t.Errorf("got duels %+v, want %+v", got, want)
+	}
+
+	// disqualified choices are not rejected on new ballots
+	if _, err := v.Vote(schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	v.Disqualify()
+	if got := v.DisqualifiedChoices(); got != nil {
+		t.Errorf("got disqualified choices %v, want none", got)
+	}
+	if results, _, _ := v.Compute(); results[0].Choice != "A" {
+		t.Errorf("got winner %v, want A", results[0].Choice)
+	}
+}
+
+func TestElection_VoteWithVetoes(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:       []string{"A", "B", "C"},
+		VetoThreshold: 0.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for voter, b := range map[string]schulze.Ballot[string]{
+		"v1": {"A": 1, "B": 2},
+		"v2": {"A": 1, "C": 2},
+		"v3": {"B": 1, "C": 2},
+	} {
+		var vetoes []string
+		if voter != "v1" {
+			vetoes = []string{"A"}
+		}
+		if _, err := e.VoteWithVetoes(voter, b, vetoes); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := e.DisqualifiedChoices(), []string{"A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got disqualified choices %v, want %v", got, want)
+	}
+	vetoes, err := e.Vetoes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := vetoes[0], (schulze.ChoiceVetoes[string]{Choice: "A", Index: 0, Count: 2, Fraction: 2.0 / 3, Disqualified: true}); got != want {
+		t.Errorf("got vetoes %+v, want %+v", got, want)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Choice != "B" {
+		t.Errorf("got winner %v, want B", results[0].Choice)
+	}
+
+	// one more ballot lowers the fraction of vetoes to the threshold
+	if _, err := e.Vote("v4", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.DisqualifiedChoices(); got != nil {
+		t.Errorf("got disqualified choices %v, want none", got)
+	}
+
+	// voting again removes the previous vetoes
+	if _, err := e.Vote("v2", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	vetoes, err = e.Vetoes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vetoes[0].Count != 1 {
+		t.Errorf("got vetoes count %v, want 1", vetoes[0].Count)
+	}
+
+	snapshot := e.Snapshot()
+	if err := e.Unvote("v4"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("v1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Unvote("v2"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := e.DisqualifiedChoices(), []string{"A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got disqualified choices after unvote %v, want %v", got, want)
+	}
+
+	restored, err := schulze.RestoreElection(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := restored.Vetoes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[0].Count != 1 {
+		t.Errorf("got restored vetoes count %v, want 1", got[0].Count)
+	}
+
+	_, err = e.VoteWithVetoes("v5", nil, []string{"X"})
+	var unknownErr *schulze.UnknownChoiceError[string]
+	if !errors.As(err, &unknownErr) || unknownErr.Choice != "X" {
+		t.Errorf("got error %v, want unknown choice X", err)
+	}
+}
+
+func TestElection_VoteWithVetoes_proxy(t *testing.T) {
+	e, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+		Choices:       []string{"A", "B", "C"},
+		VetoThreshold: 0.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, principal := range []string{"alice", "bob"} {
+		if err := e.AppointProxy(principal, "carol", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := e.Vote("dave", schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+	// the vetoes of the proxy are counted for both principals
+	if _, err := e.VoteWithVetoes("carol", schulze.Ballot[string]{"B": 1}, []string{"A"}); err != nil {
+		t.Fatal(err)
+	}
+	vetoes, err := e.Vetoes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := vetoes[0], (schulze.ChoiceVetoes[string]{Choice: "A", Index: 0, Count: 3, Fraction: 3.0 / 4, Disqualified: true}); got != want {
+		t.Errorf("got vetoes %+v, want %+v", got, want)
+	}
+	if got, want := e.DisqualifiedChoices(), []string{"A"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got disqualified choices %v, want %v", got, want)
+	}
+
+	// a new appointment counts the current vetoes of the proxy
+	if err := e.AppointProxy("erin", "carol", ""); err != nil {
+		t.Fatal(err)
+	}
+	vetoes, err = e.Vetoes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vetoes[0].Count != 4 {
+		t.Errorf("got vetoes count %v, want 4", vetoes[0].Count)
+	}
+
+	// the proxy votes again without vetoes
+	if _, err := e.Vote("carol", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	vetoes, err = e.Vetoes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vetoes[0].Count != 0 {
+		t.Errorf("got vetoes count %v, want 0", vetoes[0].Count)
+	}
+	if got := e.DisqualifiedChoices(); got != nil {
+		t.Errorf("got disqualified choices %v, want none", got)
+	}
+}
+
+func TestElectionConfig_vetoThreshold(t *testing.T) {
+	for _, threshold := range []float64{-0.1, 1.5} {
+		_, err := schulze.NewElectionFromConfig[string](schulze.ElectionConfig[string]{
+			Choices:       []string{"A", "B"},
+			VetoThreshold: threshold,
+		})
+		if !errors.Is(err, schulze.ErrInvalidElectionConfig) {
+			t.Errorf("threshold %v: got error %v, want %v", threshold, err, schulze.ErrInvalidElectionConfig)
+		}
+	}
+}
@@ -5,6 +5,8 @@
 
 package schulze
 
+import "context"
+
 // Voting holds number of votes for every pair of choices. It is a convenient
 // construct to use when the preferences slice does not have to be exposed, and
 // should be kept safe from accidental mutation. Methods on the Voting type are
@@ -12,35 +14,204 @@ package schulze
 type Voting[C comparable] struct {
 	choices     []C
 	preferences []int
+	options     options[C]
+	// choices that are excluded from results and new ballots
+	suspended map[C]struct{}
+	// choices that are permanently excluded from results and new ballots
+	withdrawn map[C]WithdrawalMode
+	// choices that are excluded from results, but not from new ballots
+	disqualified map[C]struct{}
+
+	// cached computation, valid until the preferences or choices change
+	computed  bool
+	strengths []int
+	results   []Result[C]
+	tie       bool
+	stateHash []byte
+
+	// optional cache of computations of previous states
+	resultsCache *resultsCache[C]
+
+	// parent context of tracing spans
+	traceCtx context.Context
 }
 
 // NewVoting initializes a new voting state for the provided choices.
-func NewVoting[C comparable](choices []C) *Voting[C] {
-	return &Voting[C]{
-		choices:     choices,
-		preferences: NewPreferences(len(choices)),
+//
+// A voting with nil or empty choices, just as the zero value of the Voting,
+// is valid and it computes no results and no tie. The Validate method
+// returns ErrNoChoices for such votings, to detect misconfiguration.
+func NewVoting[C comparable](choices []C, opts ...Option[C]) *Voting[C] {
+	v := &Voting[C]{
+		choices: choices,
+		options: newOptions(opts),
+	}
+	v.preferences = newPreferencesWithCapacity(len(choices), v.options.choicesCapacity)
+	if size := v.options.resultsCacheSize; size > 0 {
+		v.resultsCache = &resultsCache[C]{size: size}
 	}
+	return v
 }
 
 // Vote adds a voting preferences by a single voting ballot. A record of a
 // complete and normalized preferences is returned that can be used to unvote.
-func (v *Voting[C]) Vote(b Ballot[C]) (Record[C], error) {
+func (v *Voting[C]) Vote(b Ballot[C]) (r Record[C], err error) {
+	_, end := v.startSpan("schulze.Vote")
+	defer func() { end(err) }()
+
+	if v.options.descendingRanks {
+		b = descendingBallot(b)
+	}
+	b = v.withdrawFromBallot(b)
+	if err := v.checkSuspended(b); err != nil {
+		return nil, err
+	}
+	if v.computed && v.options.incremental && v.options.strengthVariant == StrengthWinningVotes && !v.hasExcluded() && len(v.withdrawn) == 0 {
+		return v.voteIncremental(b)
+	}
+	v.invalidate()
+	return Vote(v.preferences, v.choices, b)
+}
+
+// voteRecorded adds the preferences of a ballot constructed from a Record,
+// with ranks in the ascending order, without rejecting the suspended choices
+// and without removing the withdrawn choices, as they are ranked by ballots
+// that were voted before the choices were suspended or withdrawn.
+func (v *Voting[C]) voteRecorded(b Ballot[C]) (Record[C], error) {
+	v.invalidate()
 	return Vote(v.preferences, v.choices, b)
 }
 
 // Unvote removes a voting preferences from a single voting ballot.
-func (v *Voting[C]) Unvote(r Record[C]) error {
+func (v *Voting[C]) Unvote(r Record[C]) (err error) {
+	_, end := v.startSpan("schulze.Unvote")
+	defer func() { end(err) }()
+
+	v.invalidate()
 	return Unvote(v.preferences, v.choices, r)
 }
 
 // SetChoices updates the voting accommodate the changes to the choices. It is
 // required to pass a complete updated choices.
 func (v *Voting[C]) SetChoices(updated []C) {
-	v.preferences = SetChoices(v.preferences, v.choices, updated)
+	span, end := v.startSpan("schulze.SetChoices")
+	defer end(nil)
+	if span != nil {
+		span.SetAttributes(Attribute{Key: "schulze.updated_choices", Value: len(updated)})
+	}
+
+	v.invalidate()
+	v.preferences = setChoicesWithCapacity(v.preferences, v.choices, updated, v.options.choicesCapacity > 0)
+	v.choices = updated
 }
 
 // Compute calculates a sorted list of choices with the total number of wins for
 // each of them. If there are multiple winners, tie boolean parameter is true.
+// The computation is cached until the next change of the voting.
 func (v *Voting[C]) Compute() (results []Result[C], duels DuelsIterator[C], tie bool) {
-	return Compute(v.preferences, v.choices)
+	v.compute()
+	results = make([]Result[C], len(v.results))
+	copy(results, v.results)
+	return results, v.duels(), v.tie
+}
+
+// ComputeWithProgress is the same as Compute, but it calls the progress
+// function during the calculation of the strongest paths strengths. If the
+// computation is cached, progress is called only once, reporting that it is
+// completed.
+func (v *Voting[C]) ComputeWithProgress(progress func(done, total int)) (results []Result[C], duels DuelsIterator[C], tie bool) {
+	if v.computed {
+		if progress != nil {
+			progress(len(v.choices), len(v.choices))
+		}
+	} else {
+		v.computeWithProgress(progress)
+	}
+	return v.Compute()
+}
+
+// ComputeRange returns at most limit results of the complete sorted list of
+// choices starting from the offset, together with the total number of results
+// and the tie flag of the complete computation. It is intended for paginating
+// results of votings with a large number of choices, as the computation is
+// cached until the next change of the voting and subsequent calls only copy
+// the requested range.
+func (v *Voting[C]) ComputeRange(offset, limit int) (results []Result[C], total int, tie bool) {
+	v.compute()
+	total = len(v.results)
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= total || limit <= 0 {
+		return []Result[C]{}, total, v.tie
+	}
+	end := total
+	if limit < total-offset {
+		end = offset + limit
+	}
+	results = make([]Result[C], end-offset)
+	copy(results, v.results[offset:end])
+	return results, total, v.tie
+}
+
+func (v *Voting[C]) compute() {
+	if v.computed {
+		return
+	}
+	v.computeWithProgress(nil)
+}
+
+func (v *Voting[C]) computeWithProgress(progress func(done, total int)) {
+	span, end := v.startSpan("schulze.Compute")
+	defer end(nil)
+
+	// the state hash does not include suspended, disqualified and withdrawn
+	// choices
+	if v.resultsCache != nil && !v.hasExcluded() && len(v.withdrawn) == 0 {
+		stateHash := string(v.StateHash())
+		if c, ok := v.resultsCache.get(stateHash); ok {
+			if span != nil {
+				span.SetAttributes(Attribute{Key: "schulze.results_cache_hit", Value: true})
+			}
+			if progress != nil {
+				progress(len(v.choices), len(v.choices))
+			}
+			v.strengths, v.results, v.tie = c.strengths, c.results, c.tie
+			v.computed = true
+			return
+		}
+		defer func() {
+			v.resultsCache.add(computation[C]{
+				stateHash: stateHash,
+				strengths: v.strengths,
+				results:   v.results,
+				tie:       v.tie,
+			})
+		}()
+	}
+	o := v.options
+	o.progress = progress
+	v.strengths, v.results, v.tie = v.computeResults(o)
+	v.computed = true
+}
+
+// computeResults calculates the strongest paths and results without the
+// suspended, disqualified and withdrawn choices, without caching them.
+func (v *Voting[C]) computeResults(o options[C]) (strengths []int, results []Result[C], tie bool) {
+	if v.hasExcluded() {
+		strengths, results, tie = v.computeEligible(o)
+	} else {
+		strengths = pathStrengths(v.choices, v.preferences, o)
+		results, tie = calculateResults(v.choices, strengths, v.options)
+	}
+	results, tie = v.excludeWithdrawn(results, tie)
+	return strengths, results, tie
+}
+
+func (v *Voting[C]) invalidate() {
+	v.computed = false
+	v.strengths = nil
+	v.results = nil
+	v.tie = false
+	v.stateHash = nil
 }
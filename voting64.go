@@ -0,0 +1,182 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "sort"
+
+// Voting64 holds pairwise preferences of ballots with explicitly sized int64
+// values, so that the preferences, strongest paths strengths and results are
+// identical on 32-bit platforms, such as ARM gateways, and 64-bit servers,
+// for tallies that overflow the int of 32-bit platforms. It is the opt-in
+// alternative to the Voting type, which keeps the platform-dependent int of
+// its public API until the next major version of the module. Methods on the
+// Voting64 type are not safe for concurrent calls.
+type Voting64[C comparable] struct {
+	choices     []C
+	preferences []int64
+}
+
+// Result64 represents a total number of wins for a single choice, with int64
+// strength and advantage, as described for the Result type.
+type Result64[C comparable] struct {
+	// The choice value.
+	Choice C
+	// 0-based ordinal number of the choice in the choice slice.
+	Index int
+	// Number of wins in pairwise comparisons to other choices votings.
+	Wins int
+	// Total strength of the wins, saturated at the maximal int64 value.
+	Strength int64
+	// Total difference between the strengths of the wins and strengths of
+	// the opposite directions, saturated at the maximal int64 value.
+	Advantage int64
+}
+
+// NewVoting64 initializes a new voting state with int64 preferences for the
+// provided choices.
+func NewVoting64[C comparable](choices []C) *Voting64[C] {
+	return &Voting64[C]{
+		choices:     choices,
+		preferences: make([]int64, len(choices)*len(choices)),
+	}
+}
+
+// Vote adds a voting preferences by a single voting ballot. A record of a
+// complete and normalized preferences is returned that can be used to
+// unvote. ErrTallyOverflow is returned, and the preferences are not changed,
+// if a preference would exceed the maximal int64 value.
+func (v *Voting64[C]) Vote(b Ballot[C]) (Record[C], error) {
+	// bitwise or of the incremented preferences, as in the Vote function
+	var incremented int64
+	r, err := voteFunc(v.choices, b, func(index int) {
+		v.preferences[index]++
+		incremented |= v.preferences[index]
+	})
+	if err != nil {
+		return nil, err
+	}
+	if incremented < 0 && hasWrappedPreferences64(v.preferences) {
+		if err := v.Unvote(r); err != nil {
+			return nil, err
+		}
+		return nil, ErrTallyOverflow
+	}
+	return r, nil
+}
+
+// Unvote removes a voting preferences from a single voting ballot.
+func (v *Voting64[C]) Unvote(r Record[C]) error {
+	unvoteFunc(v.choices, r, func(index int) {
+		v.preferences[index]--
+	})
+	return nil
+}
+
+// Preferences returns a copy of the pairwise preferences matrix with the same
+// layout as the preferences of the Vote function.
+func (v *Voting64[C]) Preferences() []int64 {
+	return append(make([]int64, 0, len(v.preferences)), v.preferences...)
+}
+
+// Strengths returns the strongest paths strengths matrix, with the same
+// layout as the preferences, computed with the winning votes strengths.
+func (v *Voting64[C]) Strengths() []int64 {
+	return pathStrengths64(v.preferences, len(v.choices))
+}
+
+// Compute calculates a sorted list of choices with the total number of wins
+// for each of them, just as the Voting Compute method does with the winning
+// votes strengths. If there are multiple winners, tie boolean parameter is
+// true.
+func (v *Voting64[C]) Compute() (results []Result64[C], tie bool) {
+	choicesCount := len(v.choices)
+	strengths := pathStrengths64(v.preferences, choicesCount)
+
+	results = make([]Result64[C], 0, choicesCount)
+	// exact strength sums by the choice index, as the Strength field may be
+	// saturated
+	strengthSums := make([]wideSum, choicesCount)
+	for i := 0; i < choicesCount; i++ {
+		var wins int
+		var strength wideSum
+		var advantage wideSum
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			sij := strengths[i*choicesCount+j]
+			sji := strengths[j*choicesCount+i]
+			if sij > sji {
+				wins++
+				strength.addUint64(uint64(sij))
+				advantage.addUint64(uint64(sij - sji))
+			}
+		}
+		strengthSums[i] = strength
+		results = append(results, Result64[C]{
+			Choice:    v.choices[i],
+			Index:     i,
+			Wins:      wins,
+			Strength:  strength.int64(),
+			Advantage: advantage.int64(),
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Wins != results[j].Wins {
+			return results[i].Wins > results[j].Wins
+		}
+		if c := strengthSums[results[i].Index].cmp(strengthSums[results[j].Index]); c != 0 {
+			return c > 0
+		}
+		return results[i].Index < results[j].Index
+	})
+
+	if len(results) >= 2 {
+		tie = results[0].Wins == results[1].Wins
+	}
+	return results, tie
+}
+
+// pathStrengths64 calculates the strongest paths strengths with the
+// Floyd–Warshall algorithm, just as the built-in computation of the Voting
+// does with the winning votes strengths.
+func pathStrengths64(preferences []int64, choicesCount int) []int64 {
+	strengths := make([]int64, choicesCount*choicesCount)
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			ij := i*choicesCount + j
+			if i != j && preferences[ij] > preferences[j*choicesCount+i] {
+				strengths[ij] = preferences[ij]
+			}
+		}
+	}
+	for i := 0; i < choicesCount; i++ {
+		for j := 0; j < choicesCount; j++ {
+			if i == j {
+				continue
+			}
+			ji := strengths[j*choicesCount+i]
+			if ji == 0 {
+				// strengths are not negative, so no path is widened
+				continue
+			}
+			for k := 0; k < choicesCount; k++ {
+				if i == k || j == k {
+					continue
+				}
+				s := strengths[i*choicesCount+k]
+				if ji < s {
+					s = ji
+				}
+				if s > strengths[j*choicesCount+k] {
+					strengths[j*choicesCount+k] = s
+				}
+			}
+		}
+	}
+	return strengths
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting64(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for n := 0; n < 50; n++ {
+		choices := []string{"A", "B", "C", "D", "E"}[:r.Intn(5)+1]
+
+		v := schulze.NewVoting(choices)
+		v64 := schulze.NewVoting64(choices)
+
+		for i := r.Intn(20); i >= 0; i-- {
+			b := make(schulze.Ballot[string])
+			for _, c := range choices {
+				if r.Intn(3) > 0 {
+					b[c] = r.Intn(len(choices)) + 1
+				}
+			}
+			if _, err := v.Vote(b); err != nil {
+				t.Fatal(err)
+			}
+			record, err := v64.Vote(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if i%4 == 0 {
+				if err := v.Unvote(record); err != nil {
+					t.Fatal(err)
+				}
+				if err := v64.Unvote(record); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		for i, p := range v64.Preferences() {
+			if want := v.Preferences()[i]; p != int64(want) {
+				t.Fatalf("got preference %v at %v, want %v", p, i, want)
+			}
+		}
+
+		want, _, wantTie := v.Compute()
+		got, tie := v64.Compute()
+		if tie != wantTie {
+			t.Errorf("got tie %v, want %v", tie, wantTie)
+		}
+		for i, r := range got {
+			w := want[i]
+			if r.Choice != w.Choice || r.Index != w.Index || r.Wins != w.Wins || r.Strength != int64(w.Strength) || r.Advantage != int64(w.Advantage) {
+				t.Errorf("got result %v %+v, want %+v", i, r, w)
+			}
+		}
+	}
+}
+
+func TestVoting64_largeTallies(t *testing.T) {
+	v := schulze.NewVoting64([]string{"A", "B", "C"})
+
+	// tallies above 32 bits, from a previously stored state
+	const large = 1 << 40
+	v.SetPreferences([]int64{
+		large + 5, large + 3, large + 2,
+		large, large + 5, large + 4,
+		large + 1, large + 1, large + 5,
+	})
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := v.Strengths(), []int64{
+		0, large + 3, large + 3,
+		0, 0, large + 5,
+		0, 0, 0,
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got strengths %v, want %v", got, want)
+	}
+
+	results, tie := v.Compute()
+	if tie {
+		t.Fatal("got tie")
+	}
+	if got, want := results, []schulze.Result64[string]{
+		{Choice: "A", Index: 0, Wins: 2, Strength: 2*large + 6, Advantage: 2*large + 6},
+		{Choice: "B", Index: 1, Wins: 1, Strength: large + 5, Advantage: large + 5},
+		{Choice: "C", Index: 2, Wins: 0},
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got results %+v, want %+v", got, want)
+	}
+}
+
+func TestVoting64_overflow(t *testing.T) {
+	v := schulze.NewVoting64([]string{"A", "B"})
+
+	preferences := []int64{0, math.MaxInt64, 0, 0}
+	v.SetPreferences(preferences)
+
+	if _, err := v.Vote(schulze.Ballot[string]{"A": 1}); !errors.Is(err, schulze.ErrTallyOverflow) {
+		t.Fatalf("got error %v, want %v", err, schulze.ErrTallyOverflow)
+	}
+	if got, want := v.Preferences(), []int64{0, math.MaxInt64, 0, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+
+	// ballots that do not increment the saturated preference are counted
+	if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := v.Preferences(), []int64{0, math.MaxInt64, 1, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferences %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_ComputeRange(t *testing.T) {
+	choices := []string{"A", "B", "C", "D", "E"}
+
+	v := schulze.NewVoting(choices)
+	for _, b := range randomBallots(t, choices, 100) {
+		if _, err := v.Vote(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, _, wantTie := v.Compute()
+
+	for _, tc := range []struct {
+		name   string
+		offset int
+		limit  int
+		want   []schulze.Result[string]
+	}{
+		{name: "all", offset: 0, limit: 5, want: all},
+		{name: "over limit", offset: 0, limit: 10, want: all},
+		{name: "first page", offset: 0, limit: 2, want: all[:2]},
+		{name: "second page", offset: 2, limit: 2, want: all[2:4]},
+		{name: "last page", offset: 4, limit: 2, want: all[4:]},
+		{name: "negative offset", offset: -1, limit: 1, want: all[:1]},
+		{name: "offset out of range", offset: 5, limit: 2, want: []schulze.Result[string]{}},
+		{name: "zero limit", offset: 0, limit: 0, want: []schulze.Result[string]{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			results, total, tie := v.ComputeRange(tc.offset, tc.limit)
+			if total != len(choices) {
+				t.Errorf("got total %v, want %v", total, len(choices))
+			}
+			if tie != wantTie {
+				t.Errorf("got tie %v, want %v", tie, wantTie)
+			}
+			if !reflect.DeepEqual(results, tc.want) {
+				t.Errorf("got results %+v, want %+v", results, tc.want)
+			}
+		})
+	}
+}
+
+func TestVoting_Compute_cache(t *testing.T) {
+	v := schulze.NewVoting([]string{"A", "B"})
+
+	if _, err := v.Vote(schulze.Ballot[string]{"A": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, _, _ := v.Compute()
+	if results[0].Choice != "A" {
+		t.Fatalf("got winner %v, want %v", results[0].Choice, "A")
+	}
+	// mutating returned results must not change the cached ones
+	results[0].Choice = "X"
+	if results, _, _ := v.Compute(); results[0].Choice != "A" {
+		t.Fatalf("got winner %v, want %v", results[0].Choice, "A")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := v.Vote(schulze.Ballot[string]{"B": 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if results, _, _ := v.Compute(); results[0].Choice != "B" {
+		t.Fatalf("got winner %v after vote, want %v", results[0].Choice, "B")
+	}
+
+	v.SetChoices([]string{"C", "A"})
+	if results, _, _ := v.Compute(); results[0].Choice != "A" {
+		t.Fatalf("got winner %v after set choices, want %v", results[0].Choice, "A")
+	}
+}
+
+func BenchmarkVoting_ComputeRange(b *testing.B) {
+	const choicesCount = 1000
+
+	choices := newChoices(choicesCount)
+
+	v := schulze.NewVoting(choices)
+
+	for i := 0; i < 100; i++ {
+		if _, err := v.Vote(schulze.Ballot[string]{
+			choices[i]:     1,
+			choices[i+100]: 2,
+		}); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, _, _ = v.ComputeRange(n%choicesCount, 20)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package webhook delivers events of elections, such as opening, closing,
+// winner changes and certification, as signed JSON payloads to registered
+// webhook URLs, for integration with downstream automation, such as
+// announcements or deployment gating.
+//
+// Every request has the JSON encoded schulze.Event as the body and the
+// following headers:
+//
+//   - X-Schulze-Event: the type of the event
+//   - X-Schulze-Timestamp: the Unix time of the delivery in seconds
+//   - X-Schulze-Signature: "sha256=" followed by the hex encoded HMAC-SHA256
+//     of the timestamp, a dot and the body, with the secret of the webhook
+//
+// Receivers verify requests with the Verify function.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"resenje.org/schulze"
+)
+
+// Headers of webhook requests.
+const (
+	EventHeader     = "X-Schulze-Event"
+	TimestampHeader = "X-Schulze-Timestamp"
+	SignatureHeader = "X-Schulze-Signature"
+)
+
+// DefaultMaxAttempts is the default number of delivery attempts of every
+// event to every webhook.
+const DefaultMaxAttempts = 5
+
+// ErrInvalidSignature is returned by the Verify function when the request
+// signature is not valid or the timestamp is not within the tolerance.
+var ErrInvalidSignature = errors.New("webhook: invalid signature")
+
+// Option configures the Dispatcher.
+type Option func(*Dispatcher)
+
+// WithHTTPClient sets the HTTP client of deliveries.
+func WithHTTPClient(c *http.Client) Option {
+	return func(d *Dispatcher) {
+		d.client = c
+	}
+}
+
+// WithMaxAttempts sets the number of delivery attempts before the delivery
+// is abandoned, DefaultMaxAttempts by default. Attempts are separated by
+// exponentially increasing delays, starting from one second.
+func WithMaxAttempts(n int) Option {
+	return func(d *Dispatcher) {
+		d.maxAttempts = n
+	}
+}
+
+// WithErrorHandler sets the function that is called with errors of
+// abandoned deliveries.
+func WithErrorHandler(f func(url string, err error)) Option {
+	return func(d *Dispatcher) {
+		d.errs = f
+	}
+}
+
+// Dispatcher is a schulze.Notifier that delivers events to registered
+// webhooks. Events are delivered asynchronously, so that the election is not
+// blocked by the webhook receivers. Methods on the Dispatcher type are safe
+// for concurrent calls.
+type Dispatcher struct {
+	client      *http.Client
+	maxAttempts int
+	errs        func(url string, err error)
+	retryDelay  time.Duration
+
+	mu       sync.Mutex
+	webhooks map[string][]byte
+	wg       sync.WaitGroup
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// NewDispatcher returns a new Dispatcher without webhooks.
+func NewDispatcher(opts ...Option) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		maxAttempts: DefaultMaxAttempts,
+		retryDelay:  time.Second,
+		webhooks:    make(map[string][]byte),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Register adds the webhook URL, with the secret that signs its payloads,
+// replacing the secret if the URL is already registered.
+func (d *Dispatcher) Register(url string, secret []byte) {
+	d.mu.Lock()
+	d.webhooks[url] = append([]byte(nil), secret...)
+	d.mu.Unlock()
+}
+
+// Unregister removes the webhook URL. Deliveries in progress are not
+// canceled.
+func (d *Dispatcher) Unregister(url string) {
+	d.mu.Lock()
+	delete(d.webhooks, url)
+	d.mu.Unlock()
+}
+
+// Notify implements the schulze.Notifier interface by delivering the event
+// to all registered webhooks in the background.
+func (d *Dispatcher) Notify(e schulze.Event) {
+	body, err := json.Marshal(e)
+	if err != nil {
+		if d.errs != nil {
+			d.errs("", fmt.Errorf("webhook: encode event: %w", err))
+		}
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for url, secret := range d.webhooks {
+		d.wg.Add(1)
+		go d.deliver(url, secret, e.Type, body)
+	}
+}
+
+// Close waits for the deliveries in progress, until the context is done,
+// when the remaining deliveries are canceled.
+func (d *Dispatcher) Close(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		d.cancel()
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (d *Dispatcher) deliver(url string, secret []byte, typ schulze.EventType, body []byte) {
+	defer d.wg.Done()
+
+	delay := d.retryDelay
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = d.post(url, secret, typ, body); err == nil {
+			return
+		}
+		if attempt >= d.maxAttempts {
+			break
+		}
+		select {
+		case <-d.ctx.Done():
+			err = d.ctx.Err()
+		case <-time.After(delay):
+			delay *= 2
+			continue
+		}
+		break
+	}
+	if d.errs != nil {
+		d.errs(url, err)
+	}
+}
+
+func (d *Dispatcher) post(url string, secret []byte, typ schulze.EventType, body []byte) error {
+	req, err := http.NewRequestWithContext(d.ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(typ))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(signature(secret, timestamp, body)))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook: unexpected response status %s", resp.Status)
+	}
+	return nil
+}
+
+// Verify verifies the signature of the webhook request with the secret,
+// rejecting requests with timestamps that differ from the current time by
+// more than the tolerance, and returns the request body. The event can be
+// decoded from the body into the schulze.Event, with the Data field type
+// that corresponds to the event type.
+func Verify(r *http.Request, secret []byte, tolerance time.Duration) ([]byte, error) {
+	timestamp := r.Header.Get(TimestampHeader)
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	if d := time.Since(time.Unix(sec, 0)); d > tolerance || d < -tolerance {
+		return nil, ErrInvalidSignature
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(r.Header.Get(SignatureHeader), "sha256="))
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(sig, signature(secret, timestamp, body)) {
+		return nil, ErrInvalidSignature
+	}
+	return body, nil
+}
+
+func signature(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"resenje.org/schulze"
+	"resenje.org/schulze/webhook"
+)
+
+func TestDispatcher(t *testing.T) {
+	secret := []byte("secret")
+
+	var mu sync.Mutex
+	var received []schulze.Event
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := webhook.Verify(r, secret, time.Minute)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		var e schulze.Event
+		if err := json.Unmarshal(body, &e); err != nil {
+			t.Error(err)
+		}
+		if got := r.Header.Get(webhook.EventHeader); got != string(e.Type) {
+			t.Errorf("got event header %q, want %q", got, e.Type)
+		}
+		mu.Lock()
+		received = append(received, e)
+		mu.Unlock()
+	}))
+	defer s.Close()
+
+	var errs []string
+	d := webhook.NewDispatcher(webhook.WithMaxAttempts(1), webhook.WithErrorHandler(func(url string, err error) {
+		mu.Lock()
+		errs = append(errs, url)
+		mu.Unlock()
+	}))
+	d.Register(s.URL+"/valid", secret)
+	d.Register(s.URL+"/invalid", []byte("other secret"))
+
+	e := schulze.NewElection[string]([]string{"A", "B"})
+	e.SetNotifier(d)
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"B": 1}); err != nil {
+		t.Fatal(err)
+	}
+	e.CheckLifecycle()
+
+	if err := d.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(received) != 1 {
+		t.Fatalf("got %v events, want %v", len(received), 1)
+	}
+	if received[0].Type != schulze.EventWinnerChanged {
+		t.Errorf("got event type %v, want %v", received[0].Type, schulze.EventWinnerChanged)
+	}
+	if len(errs) != 1 || errs[0] != s.URL+"/invalid" {
+		t.Errorf("got delivery errors for %v, want %v", errs, s.URL+"/invalid")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.Header.Set(webhook.TimestampHeader, "1")
+	r.Header.Set(webhook.SignatureHeader, "sha256=00")
+	if _, err := webhook.Verify(r, []byte("secret"), time.Minute); !errors.Is(err, webhook.ErrInvalidSignature) {
+		t.Errorf("got error %v, want %v", err, webhook.ErrInvalidSignature)
+	}
+}
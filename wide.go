@@ -0,0 +1,62 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import (
+	"math"
+	"math/bits"
+)
+
+// wideSum is an unsigned 128-bit sum of non-negative int values, which does
+// not overflow for any number of values that can be held in memory, on both
+// 32-bit and 64-bit platforms.
+type wideSum struct {
+	hi, lo uint64
+}
+
+// add adds the non-negative value to the sum.
+func (s *wideSum) add(v int) {
+	s.addUint64(uint64(v))
+}
+
+// addUint64 adds the value to the sum.
+func (s *wideSum) addUint64(v uint64) {
+	var carry uint64
+	s.lo, carry = bits.Add64(s.lo, v, 0)
+	s.hi += carry
+}
+
+// int returns the sum, saturated at the maximal int value.
+func (s wideSum) int() int {
+	if s.hi > 0 || s.lo > math.MaxInt {
+		return math.MaxInt
+	}
+	return int(s.lo)
+}
+
+// int64 returns the sum, saturated at the maximal int64 value.
+func (s wideSum) int64() int64 {
+	if s.hi > 0 || s.lo > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(s.lo)
+}
+
+// cmp returns -1 if the sum is less than the other sum, 1 if it is greater,
+// and 0 if they are equal.
+func (s wideSum) cmp(o wideSum) int {
+	switch {
+	case s.hi < o.hi:
+		return -1
+	case s.hi > o.hi:
+		return 1
+	case s.lo < o.lo:
+		return -1
+	case s.lo > o.lo:
+		return 1
+	}
+	return 0
+}
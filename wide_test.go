@@ -0,0 +1,40 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"math"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestRankFromStrengths_wideSums(t *testing.T) {
+	choices := []string{"B", "A", "C", "D"}
+	// strengths from the row choice to the column choice
+	strengths := []int{
+		0, 0, math.MaxInt - 1, 1,
+		0, 0, math.MaxInt - 2, math.MaxInt - 2,
+		0, 0, 0, 1,
+		0, 0, 0, 0,
+	}
+
+	results, _, tie := schulze.RankFromStrengths(strengths, choices)
+	if !tie {
+		t.Error("got no tie")
+	}
+	// the strength sum of A overflows int, but it is greater than the sum of
+	// B, which is the maximal int
+	if r := results[0]; r.Choice != "A" || r.Wins != 2 || r.Strength != math.MaxInt || r.Advantage != math.MaxInt {
+		t.Errorf("got first result %+v, want saturated A", r)
+	}
+	if r := results[1]; r.Choice != "B" || r.Wins != 2 || r.Strength != math.MaxInt || r.Advantage != math.MaxInt {
+		t.Errorf("got second result %+v, want exact B", r)
+	}
+	if r := results[2]; r.Choice != "C" || r.Wins != 1 || r.Strength != 1 {
+		t.Errorf("got third result %+v, want C", r)
+	}
+}
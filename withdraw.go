@@ -0,0 +1,166 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// WithdrawalMode defines how the preferences of a withdrawn choice are
+// counted.
+type WithdrawalMode int
+
+// Withdrawal modes.
+const (
+	// WithdrawRecompute excludes the withdrawn choice from the strongest
+	// paths, so that the results are computed as if the choice was never on
+	// the ballot. It is the default mode.
+	WithdrawRecompute WithdrawalMode = iota
+	// WithdrawKeepCounting keeps the withdrawn choice in the strongest paths,
+	// so that the wins of other choices against it are counted, and only
+	// removes it from the results and duels.
+	WithdrawKeepCounting
+)
+
+// String returns the name of the withdrawal mode.
+func (m WithdrawalMode) String() string {
+	switch m {
+	case WithdrawRecompute:
+		return "recompute"
+	case WithdrawKeepCounting:
+		return "keep-counting"
+	default:
+		return fmt.Sprintf("WithdrawalMode(%d)", int(m))
+	}
+}
+
+// WithdrawChoice permanently withdraws the choice, such as a candidate who
+// retired during the election. The withdrawn choice is removed from new
+// ballots, without rejecting them, and from the results, counted by the
+// withdrawal mode. Unlike a suspended choice, the withdrawn choice can not be
+// resumed. UnknownChoiceError is returned if the choice does not exist.
+func (v *Voting[C]) WithdrawChoice(c C, mode WithdrawalMode) error {
+	if getChoiceIndex(v.choices, c) < 0 {
+		return &UnknownChoiceError[C]{Choice: c}
+	}
+	if _, ok := v.withdrawn[c]; ok {
+		return nil
+	}
+	if v.withdrawn == nil {
+		v.withdrawn = make(map[C]WithdrawalMode)
+	}
+	v.invalidate()
+	v.withdrawn[c] = mode
+	if mode == WithdrawRecompute {
+		if v.suspended == nil {
+			v.suspended = make(map[C]struct{})
+		}
+		v.suspended[c] = struct{}{}
+	}
+	return nil
+}
+
+// WithdrawnChoices returns the withdrawn choices in the order of the voting
+// choices.
+func (v *Voting[C]) WithdrawnChoices() []C {
+	var withdrawn []C
+	for _, c := range v.choices {
+		if _, ok := v.withdrawn[c]; ok {
+			withdrawn = append(withdrawn, c)
+		}
+	}
+	return withdrawn
+}
+
+// Withdrawal returns the withdrawal mode of the choice and true if the
+// choice is withdrawn.
+func (v *Voting[C]) Withdrawal(c C) (mode WithdrawalMode, ok bool) {
+	mode, ok = v.withdrawn[c]
+	return mode, ok
+}
+
+// withdrawFromBallot returns the ballot without the withdrawn choices, or
+// the same ballot if it ranks none of them.
+func (v *Voting[C]) withdrawFromBallot(b Ballot[C]) Ballot[C] {
+	var filtered Ballot[C]
+	for c := range v.withdrawn {
+		if _, ok := b[c]; !ok {
+			continue
+		}
+		if filtered == nil {
+			// copy the ballot not to modify the one passed by the caller
+			filtered = make(Ballot[C], len(b))
+			for c, rank := range b {
+				filtered[c] = rank
+			}
+		}
+		delete(filtered, c)
+	}
+	if filtered == nil {
+		return b
+	}
+	return filtered
+}
+
+// excludeWithdrawn removes the choices that are withdrawn with the
+// WithdrawKeepCounting mode from the results, determining the tie again
+// among the remaining choices.
+func (v *Voting[C]) excludeWithdrawn(results []Result[C], tie bool) ([]Result[C], bool) {
+	if !v.countsWithdrawn() {
+		return results, tie
+	}
+	filtered := make([]Result[C], 0, len(results))
+	for _, r := range results {
+		if mode, ok := v.withdrawn[r.Choice]; ok && mode == WithdrawKeepCounting {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	tie = len(filtered) >= 2 && filtered[0].Wins == filtered[1].Wins
+	if tie && v.options.tieBreak != TieBreakNone {
+		breakTie(filtered, v.options)
+		tie = false
+	}
+	return filtered, tie
+}
+
+// countsWithdrawn returns true if there are withdrawn choices that are
+// counted in the strongest paths.
+func (v *Voting[C]) countsWithdrawn() bool {
+	for _, mode := range v.withdrawn {
+		if mode == WithdrawKeepCounting {
+			return true
+		}
+	}
+	return false
+}
+
+// WithdrawChoice permanently withdraws the choice from the election, as the
+// Voting WithdrawChoice method does. The withdrawal is recorded in the audit
+// log with the reason, and the mode is returned by the Withdrawal method.
+func (e *Election[V, C]) WithdrawChoice(c C, mode WithdrawalMode, reason string) error {
+	if _, ok := e.voting.withdrawn[c]; ok {
+		return nil
+	}
+	if err := e.voting.WithdrawChoice(c, mode); err != nil {
+		return err
+	}
+	e.addAuditEntry(AuditEntry{
+		Action:  AuditWithdraw,
+		Reason:  reason,
+		Choices: []string{fmt.Sprint(c)},
+	})
+	return nil
+}
+
+// WithdrawnChoices returns the withdrawn choices of the election.
+func (e *Election[V, C]) WithdrawnChoices() []C {
+	return e.voting.WithdrawnChoices()
+}
+
+// Withdrawal returns the withdrawal mode of the choice and true if the
+// choice is withdrawn from the election.
+func (e *Election[V, C]) Withdrawal(c C) (mode WithdrawalMode, ok bool) {
+	return e.voting.Withdrawal(c)
+}
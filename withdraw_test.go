@@ -0,0 +1,179 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestVoting_WithdrawChoice(t *testing.T) {
+	choices := []string{"A", "B", "C", "D"}
+	ballots := []schulze.Ballot[string]{
+		{"B": 1, "A": 2, "C": 3},
+		{"B": 1, "C": 2},
+		{"A": 1, "C": 2, "D": 3},
+		{"D": 1, "B": 2},
+	}
+
+	for _, opts := range [][]schulze.Option[string]{
+		nil,
+		{schulze.WithIncrementalCompute[string](), schulze.WithResultsCache[string](2)},
+	} {
+		t.Run("recompute", func(t *testing.T) {
+			v := schulze.NewVoting(choices, opts...)
+			for _, b := range ballots {
+				if _, err := v.Vote(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+			v.Compute()
+
+			if err := v.WithdrawChoice("B", schulze.WithdrawRecompute); err != nil {
+				t.Fatal(err)
+			}
+			results, duels, tie := v.Compute()
+			wantResults, wantDuels, wantTie := schulze.ComputeWith(v.Preferences(), choices, func(c string) bool { return c != "B" })
+			if !reflect.DeepEqual(results, wantResults) || tie != wantTie {
+				t.Errorf("got results %+v, want %+v", results, wantResults)
+			}
+			if got, want := collectDuels(duels), collectDuels(wantDuels); !reflect.DeepEqual(got, want) {
+				t.Errorf("got duels %+v, want %+v", got, want)
+			}
+
+			// withdrawn choices can not be resumed
+			v.ResumeChoice("B")
+			if got, want := v.SuspendedChoices(), []string{"B"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got suspended choices %v, want %v", got, want)
+			}
+		})
+
+		t.Run("keep counting", func(t *testing.T) {
+			v := schulze.NewVoting(choices, opts...)
+			for _, b := range ballots {
+				if _, err := v.Vote(b); err != nil {
+					t.Fatal(err)
+				}
+			}
+			allResults, allDuels, _ := v.Compute()
+
+			if err := v.WithdrawChoice("A", schulze.WithdrawKeepCounting); err != nil {
+				t.Fatal(err)
+			}
+			results, duels, tie := v.Compute()
+			var wantResults []schulze.Result[string]
+			for _, r := range allResults {
+				if r.Choice != "A" {
+					wantResults = append(wantResults, r)
+				}
+			}
+			if !reflect.DeepEqual(results, wantResults) {
+				t.Errorf("got results %+v, want %+v", results, wantResults)
+			}
+			if wantTie := wantResults[0].Wins == wantResults[1].Wins; tie != wantTie {
+				t.Errorf("got tie %v, want %v", tie, wantTie)
+			}
+			var wantDuels []schulze.Duel[string]
+			for _, d := range collectDuels(allDuels) {
+				if d.Left.Choice != "A" && d.Right.Choice != "A" {
+					wantDuels = append(wantDuels, d)
+				}
+			}
+			if got := collectDuels(duels); !reflect.DeepEqual(got, wantDuels) {
+				t.Errorf("got duels %+v, want %+v", got, wantDuels)
+			}
+			if got := v.SuspendedChoices(); got != nil {
+				t.Errorf("got suspended choices %v", got)
+			}
+		})
+	}
+
+	v := schulze.NewVoting(choices)
+	if err := v.WithdrawChoice("C", schulze.WithdrawKeepCounting); err != nil {
+		t.Fatal(err)
+	}
+	b := schulze.Ballot[string]{"C": 1, "A": 2}
+	r, err := v.Vote(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (schulze.Record[string]{{"A"}, {"B", "C", "D"}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("got record %v, want %v", r, want)
+	}
+	if _, ok := b["C"]; !ok {
+		t.Error("ballot modified")
+	}
+	if mode, ok := v.Withdrawal("C"); !ok || mode != schulze.WithdrawKeepCounting {
+		t.Errorf("got withdrawal mode %v %v", mode, ok)
+	}
+	if _, ok := v.Withdrawal("A"); ok {
+		t.Error("got withdrawal of choice A")
+	}
+	if got, want := v.WithdrawnChoices(), []string{"C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got withdrawn choices %v, want %v", got, want)
+	}
+
+	var uerr *schulze.UnknownChoiceError[string]
+	if err := v.WithdrawChoice("X", schulze.WithdrawRecompute); !errors.As(err, &uerr) {
+		t.Errorf("got error %v, want UnknownChoiceError", err)
+	}
+}
+
+func TestElection_WithdrawChoice(t *testing.T) {
+	e := schulze.NewElection[string]([]string{"A", "B", "C"})
+
+	if _, err := e.Vote("alice", schulze.Ballot[string]{"B": 1, "A": 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.WithdrawChoice("B", schulze.WithdrawRecompute, "retired"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.WithdrawChoice("B", schulze.WithdrawKeepCounting, "again"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Vote("bob", schulze.Ballot[string]{"B": 1, "C": 2}); err != nil {
+		t.Fatal(err)
+	}
+	results, _, _, err := e.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if r.Choice == "B" {
+			t.Errorf("got withdrawn choice in results %+v", results)
+		}
+	}
+	e.ResumeChoice("B", "not resumed")
+
+	log := e.AuditLog()
+	if len(log) != 1 {
+		t.Fatalf("got %v audit entries, want %v", len(log), 1)
+	}
+	if log[0].Action != schulze.AuditWithdraw || log[0].Reason != "retired" || !reflect.DeepEqual(log[0].Choices, []string{"B"}) {
+		t.Errorf("got audit entry %+v", log[0])
+	}
+
+	restored, err := schulze.RestoreElection(e.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := restored.WithdrawnChoices(), []string{"B"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got restored withdrawn choices %v, want %v", got, want)
+	}
+	if mode, _ := restored.Withdrawal("B"); mode != schulze.WithdrawRecompute {
+		t.Errorf("got restored withdrawal mode %v", mode)
+	}
+	restoredResults, _, _, err := restored.Compute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restoredResults, results) {
+		t.Errorf("got restored results %+v, want %+v", restoredResults, results)
+	}
+}
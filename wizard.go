@@ -0,0 +1,189 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze
+
+import "fmt"
+
+// WizardAnswer is the answer to a pairwise question of the Wizard.
+type WizardAnswer int
+
+// Answers to pairwise questions.
+const (
+	// PreferFirst answers that the first choice of the question is
+	// preferred.
+	PreferFirst WizardAnswer = iota
+	// PreferSecond answers that the second choice of the question is
+	// preferred.
+	PreferSecond
+	// PreferNone answers that both choices are equally preferred.
+	PreferNone
+)
+
+// Wizard walks a voter through a sequence of pairwise questions and
+// assembles a consistent ballot from the answers, for voters who find
+// ranking a complete list of choices difficult, such as with screen readers
+// or on small displays. Choices are inserted one by one into the ordered
+// groups of equally ranked choices with a binary search, as in a merge sort,
+// so that only about n*log(n) questions are asked for n choices and the
+// answers can not form a cycle. Methods on the Wizard type are not safe for
+// concurrent calls.
+//
+// The state of the Wizard is the list of its choices and the answers, which
+// can be kept by the server between requests of the voter as the
+// WizardState and with which the Wizard is restored by RestoreWizard.
+type Wizard[C comparable] struct {
+	choices []C
+	answers []WizardAnswer
+
+	// choices that are not yet ranked, the first one is being inserted
+	pending []C
+	groups  [][]C
+	// range of groups where the current choice is inserted
+	lo, hi int
+}
+
+// WizardState is the serializable state of the Wizard.
+type WizardState[C comparable] struct {
+	Choices []C            `json:"choices"`
+	Answers []WizardAnswer `json:"answers,omitempty"`
+}
+
+// NewWizard returns a new Wizard for the choices in the order in which they
+// are inserted into the ranking.
+func NewWizard[C comparable](choices []C) *Wizard[C] {
+	w := &Wizard[C]{
+		choices: append([]C(nil), choices...),
+	}
+	w.reset()
+	return w
+}
+
+// RestoreWizard returns the Wizard with the state. ErrInvalidWizardAnswer is
+// returned if the state has invalid answers or more answers than questions.
+func RestoreWizard[C comparable](s WizardState[C]) (*Wizard[C], error) {
+	w := NewWizard(s.Choices)
+	for _, a := range s.Answers {
+		if err := w.Answer(a); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// State returns the serializable state of the Wizard.
+func (w *Wizard[C]) State() WizardState[C] {
+	return WizardState[C]{
+		Choices: append([]C(nil), w.choices...),
+		Answers: append([]WizardAnswer(nil), w.answers...),
+	}
+}
+
+// Question returns the next pair of choices to compare, or false if the
+// ranking is complete.
+func (w *Wizard[C]) Question() (first, second C, ok bool) {
+	if len(w.pending) == 0 {
+		return first, second, false
+	}
+	return w.pending[0], w.groups[(w.lo+w.hi)/2][0], true
+}
+
+// Answer applies the answer to the current question. ErrInvalidWizardAnswer
+// is returned if the answer is not valid or if the ranking is complete.
+func (w *Wizard[C]) Answer(a WizardAnswer) error {
+	if a < PreferFirst || a > PreferNone {
+		return fmt.Errorf("%w: %v", ErrInvalidWizardAnswer, int(a))
+	}
+	if len(w.pending) == 0 {
+		return fmt.Errorf("%w: ranking is complete", ErrInvalidWizardAnswer)
+	}
+	w.answers = append(w.answers, a)
+	w.apply(a)
+	return nil
+}
+
+// Undo reverts the last answer, so that its question is asked again, and
+// returns false if there are no answers.
+func (w *Wizard[C]) Undo() bool {
+	if len(w.answers) == 0 {
+		return false
+	}
+	answers := w.answers[:len(w.answers)-1]
+	w.reset()
+	for _, a := range answers {
+		w.apply(a)
+	}
+	w.answers = answers
+	return true
+}
+
+// Done returns true if the ranking is complete.
+func (w *Wizard[C]) Done() bool {
+	return len(w.pending) == 0
+}
+
+// Progress returns the number of ranked choices and the number of all
+// choices, for example to announce the progress to the voter.
+func (w *Wizard[C]) Progress() (ranked, total int) {
+	return len(w.choices) - len(w.pending), len(w.choices)
+}
+
+// Ballot returns the ballot of the ranked choices. Choices that are not yet
+// ranked are not on the ballot.
+func (w *Wizard[C]) Ballot() Ballot[C] {
+	b := make(Ballot[C])
+	for i, g := range w.groups {
+		for _, c := range g {
+			b[c] = i + 1
+		}
+	}
+	return b
+}
+
+// Record returns the Record of the ranked choices, with the choices that are
+// not yet ranked as unranked.
+func (w *Wizard[C]) Record() Record[C] {
+	r := make(Record[C], 0, len(w.groups)+1)
+	for _, g := range w.groups {
+		r = append(r, append([]C(nil), g...))
+	}
+	return append(r, append(make([]C, 0, len(w.pending)), w.pending...))
+}
+
+func (w *Wizard[C]) apply(a WizardAnswer) {
+	mid := (w.lo + w.hi) / 2
+	switch a {
+	case PreferFirst:
+		w.hi = mid
+	case PreferSecond:
+		w.lo = mid + 1
+	default:
+		w.groups[mid] = append(w.groups[mid], w.pending[0])
+		w.next()
+		return
+	}
+	if w.lo == w.hi {
+		w.groups = append(w.groups, nil)
+		copy(w.groups[w.lo+1:], w.groups[w.lo:])
+		w.groups[w.lo] = []C{w.pending[0]}
+		w.next()
+	}
+}
+
+func (w *Wizard[C]) reset() {
+	w.answers = nil
+	w.pending = append([]C(nil), w.choices...)
+	w.groups = nil
+	if len(w.pending) > 0 {
+		w.groups = [][]C{{w.pending[0]}}
+		w.next()
+	}
+}
+
+// next starts the insertion of the next choice.
+func (w *Wizard[C]) next() {
+	w.pending = w.pending[1:]
+	w.lo, w.hi = 0, len(w.groups)
+}
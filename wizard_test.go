@@ -0,0 +1,146 @@
+// Copyright (c) 2026, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schulze_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"resenje.org/schulze"
+)
+
+func TestWizard(t *testing.T) {
+	choices := []string{"A", "B", "C", "D", "E", "F", "G"}
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		// the preferred ranking with ties
+		want := make(schulze.Ballot[string])
+		for _, c := range choices {
+			want[c] = random.Intn(4) + 1
+		}
+
+		w := schulze.NewWizard(choices)
+		var questions int
+		for {
+			first, second, ok := w.Question()
+			if !ok {
+				break
+			}
+			questions++
+			a := schulze.PreferNone
+			switch {
+			case want[first] < want[second]:
+				a = schulze.PreferFirst
+			case want[first] > want[second]:
+				a = schulze.PreferSecond
+			}
+			if err := w.Answer(a); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if !w.Done() {
+			t.Fatal("wizard is not done")
+		}
+		if ranked, total := w.Progress(); ranked != len(choices) || total != len(choices) {
+			t.Errorf("got progress %v of %v", ranked, total)
+		}
+		// n*log2(n) for 7 choices
+		if questions > 20 {
+			t.Errorf("got %v questions", questions)
+		}
+
+		wantVoting := schulze.NewVoting(choices)
+		wantRecord, err := wantVoting.Vote(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotVoting := schulze.NewVoting(choices)
+		if _, err := gotVoting.Vote(w.Ballot()); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(gotVoting.Preferences(), wantVoting.Preferences()) {
+			t.Fatalf("got ballot %v, want %v", w.Ballot(), want)
+		}
+		if got := w.Record(); len(got) != len(wantRecord) || len(got[len(got)-1]) != 0 {
+			t.Errorf("got record %v, want groups of %v", got, wantRecord)
+		}
+	}
+}
+
+func TestWizard_Undo(t *testing.T) {
+	w := schulze.NewWizard([]string{"A", "B", "C"})
+	if w.Undo() {
+		t.Error("undo without answers")
+	}
+
+	first, second, _ := w.Question()
+	if err := w.Answer(schulze.PreferSecond); err != nil {
+		t.Fatal(err)
+	}
+	if !w.Undo() {
+		t.Fatal("undo failed")
+	}
+	if f, s, _ := w.Question(); f != first || s != second {
+		t.Errorf("got question %v %v after undo, want %v %v", f, s, first, second)
+	}
+	if ranked, _ := w.Progress(); ranked != 1 {
+		t.Errorf("got %v ranked choices after undo, want %v", ranked, 1)
+	}
+
+	for !w.Done() {
+		if err := w.Answer(schulze.PreferFirst); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := (schulze.Record[string]{{"C"}, {"B"}, {"A"}, {}}); !reflect.DeepEqual(w.Record(), want) {
+		t.Errorf("got record %v, want %v", w.Record(), want)
+	}
+	if err := w.Answer(schulze.PreferFirst); !errors.Is(err, schulze.ErrInvalidWizardAnswer) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidWizardAnswer)
+	}
+}
+
+func TestRestoreWizard(t *testing.T) {
+	w := schulze.NewWizard([]string{"A", "B", "C", "D"})
+	for _, a := range []schulze.WizardAnswer{schulze.PreferSecond, schulze.PreferNone} {
+		if err := w.Answer(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := (schulze.Record[string]{{"A"}, {"B", "C"}, {"D"}}); !reflect.DeepEqual(w.Record(), want) {
+		t.Errorf("got record %v, want %v", w.Record(), want)
+	}
+
+	data, err := json.Marshal(w.State())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s schulze.WizardState[string]
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := schulze.RestoreWizard(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(restored.Record(), w.Record()) {
+		t.Errorf("got restored record %v, want %v", restored.Record(), w.Record())
+	}
+	f1, s1, _ := restored.Question()
+	f2, s2, _ := w.Question()
+	if f1 != f2 || s1 != s2 {
+		t.Errorf("got restored question %v %v, want %v %v", f1, s1, f2, s2)
+	}
+
+	s.Answers = append(s.Answers, 7)
+	if _, err := schulze.RestoreWizard(s); !errors.Is(err, schulze.ErrInvalidWizardAnswer) {
+		t.Errorf("got error %v, want %v", err, schulze.ErrInvalidWizardAnswer)
+	}
+}